@@ -0,0 +1,138 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// klinesPageLimit is the max number of klines per request
+const klinesPageLimit = 1000
+
+// FetchCandles implements core.PublicClient
+func (b *BinanceClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	if interval.Duration() == 0 {
+		return nil, core.ErrUnsupportedInterval("binance", interval)
+	}
+	return core.PageCandles(start, end, limit, klinesPageLimit, func(start, end time.Time, limit int) ([]core.Candle, error) {
+		params := map[string]interface{}{
+			"symbol":   symbol,
+			"interval": string(interval), // binance uses the same notation
+			"endTime":  end.UnixMilli(),
+			"limit":    limit,
+		}
+		if !start.IsZero() {
+			params["startTime"] = start.UnixMilli()
+		}
+		root, err := b.SendRequest(map[string]interface{}{
+			"id":     nextWSID(),
+			"method": "klines",
+			"params": params,
+		})
+		if err != nil {
+			return nil, err
+		}
+		var rows [][]interface{}
+		if err := json.Unmarshal(root["result"], &rows); err != nil {
+			return nil, fmt.Errorf("failed to decode klines: %w", err)
+		}
+		candles := make([]core.Candle, 0, len(rows))
+		for _, row := range rows {
+			c, err := parseKline(symbol, interval, row)
+			if err != nil {
+				return nil, err
+			}
+			candles = append(candles, c)
+		}
+		return candles, nil
+	})
+}
+
+// parseKline parses [openTime, open, high, low, close, volume, closeTime, quoteVolume, trades, ...]
+func parseKline(symbol string, interval core.CandleInterval, row []interface{}) (core.Candle, error) {
+	if len(row) < 9 {
+		return core.Candle{}, fmt.Errorf("invalid kline row: %v", row)
+	}
+	openTime, _ := row[0].(float64)
+	closeTime, _ := row[6].(float64)
+	trades, _ := row[8].(float64)
+	return core.Candle{
+		Symbol:      symbol,
+		Interval:    interval,
+		OpenTime:    time.UnixMilli(int64(openTime)),
+		CloseTime:   time.UnixMilli(int64(closeTime)),
+		Open:        klineDecimal(row[1]),
+		High:        klineDecimal(row[2]),
+		Low:         klineDecimal(row[3]),
+		Close:       klineDecimal(row[4]),
+		Volume:      klineDecimal(row[5]),
+		QuoteVolume: klineDecimal(row[7]),
+		TradeCount:  int64(trades),
+	}, nil
+}
+
+func klineDecimal(v interface{}) decimal.Decimal {
+	s, _ := v.(string)
+	d, _ := decimal.NewFromString(s)
+	return d
+}
+
+// SubscribeCandles implements core.PublicClient with the @kline stream, bars are pushed
+// on every update and once more with Closed set when they finalise
+func (b *BinanceClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	candleChMap := make(map[string]<-chan core.Candle)
+	if interval.Duration() == 0 {
+		return candleChMap, core.ErrUnsupportedInterval("binance", interval)
+	}
+	chans := make(map[string]chan core.Candle)
+	params := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Candle, 16)
+		candleChMap[symbol] = chans[symbol]
+		params[i] = strings.ToLower(symbol) + "@kline_" + string(interval)
+	}
+
+	stream := core.NewStream(b.streamURL, subscribeStreams(params), func(msg []byte) {
+		var ev wsKlineStream
+		if err := json.Unmarshal(msg, &ev); err != nil || ev.EventType != "kline" {
+			return // subscription ack
+		}
+		if ch, exists := chans[ev.Symbol]; exists {
+			core.SendCandle(ctx, ch, ev.candle(interval))
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return candleChMap, err
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+	return candleChMap, nil
+}
+
+func (ev *wsKlineStream) candle(interval core.CandleInterval) core.Candle {
+	k := ev.Kline
+	return core.Candle{
+		Symbol:      ev.Symbol,
+		Interval:    interval,
+		OpenTime:    time.UnixMilli(k.OpenTime),
+		CloseTime:   time.UnixMilli(k.CloseTime),
+		Open:        klineDecimal(k.Open),
+		High:        klineDecimal(k.High),
+		Low:         klineDecimal(k.Low),
+		Close:       klineDecimal(k.Close),
+		Volume:      klineDecimal(k.Volume),
+		QuoteVolume: klineDecimal(k.QuoteVolume),
+		TradeCount:  k.TradeCount,
+		Closed:      k.Closed,
+	}
+}
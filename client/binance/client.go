@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,19 +18,25 @@ import (
 )
 
 const (
-	binanceWsURL        = "wss://ws-api.binance.com:443/ws-api/v3"
-	binanceTestnetWsURL = "wss://ws-api.testnet.binance.vision/ws-api/v3"
-	wsLifetime          = 23*time.Hour + 50*time.Minute
+	binanceWsURL            = "wss://ws-api.binance.com:443/ws-api/v3"
+	binanceTestnetWsURL     = "wss://ws-api.testnet.binance.vision/ws-api/v3"
+	binanceRestURL          = "https://api.binance.com"
+	binanceStreamURL        = "wss://stream.binance.com:9443/ws"
+	binanceTestnetRestURL   = "https://testnet.binance.vision"
+	binanceTestnetStreamURL = "wss://stream.testnet.binance.vision/ws"
+	wsLifetime              = 23*time.Hour + 50*time.Minute
 )
 
 type BinanceClient struct {
 	*core.WsClient
 	balances   map[string]*core.Wallet
-	orders     map[string]*core.OrderResponse // order ID : response
+	orders     *core.OrderStore // fed by the user data stream
+	rules      *core.RuleCache  // rules orders are normalised to
 	wsReject   map[string]chan wsListStatus
 	balancesMu sync.RWMutex
-	ordersMu   sync.RWMutex
 	wsRejectMu sync.Mutex
+	restURL    string // market data REST endpoint, e.g. of the depth snapshots
+	streamURL  string // market data streams
 
 	// Real-time event subscription channels
 	orderEventCh    chan core.OrderEvent
@@ -42,11 +49,11 @@ type BinanceClient struct {
 func NewClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 	b := &BinanceClient{
 		balances:   make(map[string]*core.Wallet),
-		orders:     make(map[string]*core.OrderResponse),
 		wsReject:   make(map[string]chan wsListStatus),
 		balancesMu: sync.RWMutex{},
-		ordersMu:   sync.RWMutex{},
 		wsRejectMu: sync.Mutex{},
+		restURL:    binanceRestURL,
+		streamURL:  binanceStreamURL,
 	}
 	b.WsClient = core.NewWsClient(
 		binanceWsURL,
@@ -58,17 +65,20 @@ func NewClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 		extractErrFn(),
 		afterConnect(b),
 	)
+	b.SetRateLimiter(core.NewRateLimiter("binance", defaultRateLimits), rateCostFn(), rateUsageFn())
+	b.initOrderStore()
+	b.initRules()
 	return b
 }
 
 func NewTestClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 	b := &BinanceClient{
 		balances:   make(map[string]*core.Wallet),
-		orders:     make(map[string]*core.OrderResponse),
 		wsReject:   make(map[string]chan wsListStatus),
 		balancesMu: sync.RWMutex{},
-		ordersMu:   sync.RWMutex{},
 		wsRejectMu: sync.Mutex{},
+		restURL:    binanceTestnetRestURL,
+		streamURL:  binanceTestnetStreamURL,
 	}
 	b.WsClient = core.NewWsClient(
 		binanceTestnetWsURL,
@@ -80,9 +90,21 @@ func NewTestClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 		extractErrFn(),
 		afterConnect(b),
 	)
+	b.SetRateLimiter(core.NewRateLimiter("binance", defaultRateLimits), rateCostFn(), rateUsageFn())
+	b.initOrderStore()
+	b.initRules()
 	return b
 }
 
+// initOrderStore sets up the order store, it answers FetchOrder while the user data stream
+// is live
+func (b *BinanceClient) initOrderStore() {
+	b.orders = core.NewOrderStore(func(symbol, orderId string) (*core.OrderResponseFull, error) {
+		return b.fetchOrder(symbol, "orderId", orderId)
+	}, b.FetchOpenOrders)
+	b.orders.Live = func() bool { return b.State() == core.WsStateLive }
+}
+
 func (b *BinanceClient) handleUserDataEvent(msg []byte) {
 	var root map[string]json.RawMessage
 	if err := json.Unmarshal(msg, &root); err != nil {
@@ -127,14 +149,11 @@ func (b *BinanceClient) handleUserDataEvent(msg []byte) {
 	case "executionReport":
 		var ord wsOrderTradeUpdate
 		if err := ord.UnmarshalJSON(root["event"]); err == nil {
-			// Update internal order tracking
-			resp := b.convertOrderTradeUpdate(ord)
-			b.ordersMu.Lock()
-			b.orders[resp.OrderID] = &resp
-			b.ordersMu.Unlock()
+			event := b.convertToOrderEvent(ord)
+			b.orders.ApplyEvent(event)
 
 			// Forward order event to subscription channel
-			b.forwardOrderEvent(b.convertToOrderEvent(ord))
+			b.forwardOrderEvent(event)
 		} else {
 			fmt.Printf("json unmarshal error on handling user event: %v\n", err)
 			panic(err)
@@ -246,23 +265,50 @@ func extractIDFn() core.WsExtractIDFunc {
 	}
 }
 
-func wrapStatusCode(status int64, msg string) error {
-	switch status {
-	case 200:
+// wrapStatusCode maps a binance error into core.ExchangeError, code is the binance error code
+func wrapStatusCode(status int64, code int64, msg string) error {
+	if status == 200 {
 		return nil
-	case 429, 418:
-		return fmt.Errorf("rate limited or banned (status %d): %s", status, msg)
-	case 500, 502, 503, 504:
-		return fmt.Errorf("binance server error (status %d): %s", status, msg)
-	default:
-		if status >= 400 && status < 500 {
-			return fmt.Errorf("binance client error (status %d): %s", status, msg)
+	}
+	if status < 400 && code == 0 {
+		return nil
+	}
+	category := core.ErrCategoryUnknown
+	switch code {
+	case -1003, -1015:
+		category = core.ErrCategoryRateLimited
+	case -1002, -1022, -2014, -2015:
+		category = core.ErrCategoryAuthFailed
+	case -1007:
+		category = core.ErrCategoryExecutionUnknown
+	case -1016:
+		category = core.ErrCategoryMaintenance
+	case -1121:
+		category = core.ErrCategoryInvalidSymbol
+	case -2011, -2013:
+		category = core.ErrCategoryOrderNotFound
+	case -1013, -2010:
+		// generic filter failure and order rejects carry the reason in the message
+		switch {
+		case strings.Contains(msg, "insufficient balance"):
+			category = core.ErrCategoryInsufficientBalance
+		case strings.Contains(msg, "NOTIONAL"):
+			category = core.ErrCategoryMinNotional
+		case strings.Contains(msg, "PRICE_FILTER"), strings.Contains(msg, "PERCENT_PRICE"):
+			category = core.ErrCategoryPriceFilter
+		case strings.Contains(msg, "immediately match and take"):
+			category = core.ErrCategoryPostOnlyRejected
 		}
-		if status >= 500 {
-			return fmt.Errorf("binance server error (status %d): %s", status, msg)
+	default:
+		switch {
+		case status == 429 || status == 418:
+			category = core.ErrCategoryRateLimited
+		case status >= 500:
+			// the execution status of a 5xx is unknown, the request may have succeeded
+			category = core.ErrCategoryExecutionUnknown
 		}
 	}
-	return nil
+	return core.NewExchangeError("binance", category, strconv.FormatInt(code, 10), fmt.Sprintf("%s (status %d)", msg, status)).WithStatus(int(status))
 }
 
 func extractErrFn() core.WsExtractErrFunc {
@@ -272,8 +318,9 @@ func extractErrFn() core.WsExtractErrFunc {
 			if err := json.Unmarshal(errRaw, &errMsgObj); err == nil {
 				// Optionally inject status handler/callback here too
 				status := core.IntFromRawMap(root, "status")
+				code := int64(core.IntFromMap(errMsgObj, "code"))
 				errMsg := core.StringFromMap(errMsgObj, "msg")
-				return wrapStatusCode(status, errMsg)
+				return wrapStatusCode(status, code, errMsg)
 			}
 		}
 		return nil
@@ -282,6 +329,9 @@ func extractErrFn() core.WsExtractErrFunc {
 
 func afterConnect(b *BinanceClient) core.WsAfterConnectFunc {
 	return func(c *core.WsClient) error {
+		// order events may have been missed until the stream is subscribed again
+		b.orders.Invalidate()
+
 		// 1. Subscribe user data stream
 		reqSub := map[string]interface{}{
 			"method": "userDataStream.subscribe",
@@ -324,6 +374,18 @@ func afterConnect(b *BinanceClient) core.WsAfterConnectFunc {
 			b.balances[asset] = wal
 		}
 		b.balancesMu.Unlock()
+
+		// 3. Catch up with the orders in the background, the requests need the live
+		// connection. A failure leaves FetchOrder querying the exchange.
+		session := c.Ctx
+		go func() {
+			if err := c.Wait(session); err != nil {
+				return
+			}
+			if err := b.orders.Reconcile(); err != nil {
+				c.ReportError(err)
+			}
+		}()
 		return nil
 	}
 }
@@ -446,26 +508,19 @@ func (b *BinanceClient) forwardBalanceEvent(event core.BalanceEvent) {
 
 // convertToOrderEvent converts wsOrderTradeUpdate to core.OrderEvent
 func (b *BinanceClient) convertToOrderEvent(ord wsOrderTradeUpdate) core.OrderEvent {
-	var status core.OrderStatus
-	switch ord.Status {
-	case "NEW":
-		status = core.OrderStatusOpen
-	case "FILLED":
-		status = core.OrderStatusFilled
-	case "CANCELED":
-		status = core.OrderStatusCanceled
-	case "REJECTED", "EXPIRED":
-		status = core.OrderStatusError
-	case "PARTIALLY_FILLED":
-		status = core.OrderStatusOpen // Treat as open since it's still active
-	default:
-		status = core.OrderStatusOpen
+	reason := statusReason(ord.Status)
+	if ord.RejectReason != "" && ord.RejectReason != "NONE" {
+		reason = ord.RejectReason
 	}
 
 	price, _ := decimal.NewFromString(ord.Price)
 	quantity, _ := decimal.NewFromString(ord.OrigQty)
 	executedQty, _ := decimal.NewFromString(ord.ExecutedQty)
-	lastFilledPrice, _ := decimal.NewFromString(ord.LastFilledPrice)
+	cumQuote, _ := decimal.NewFromString(ord.CummulativeQuote)
+	avgPrice := decimal.Zero
+	if executedQty.IsPositive() {
+		avgPrice = cumQuote.Div(executedQty)
+	}
 
 	// Convert string side to core.OrderSide
 	var side core.OrderSide
@@ -475,57 +530,42 @@ func (b *BinanceClient) convertToOrderEvent(ord wsOrderTradeUpdate) core.OrderEv
 		side = core.OrderSideSell
 	}
 
-	return core.OrderEvent{
-		OrderID:     strconv.FormatInt(ord.OrderID, 10),
-		Symbol:      ord.Symbol,
-		Side:        side,
-		OrderType:   ord.OrderType,
-		Status:      status,
-		Price:       price,
-		Quantity:    quantity,
-		ExecutedQty: executedQty,
-		AvgPrice:    lastFilledPrice, // Approximate - Binance doesn't provide true average
-		UpdateTime:  time.Unix(0, ord.LastFilledTime*int64(time.Millisecond)),
-		TradeID:     strconv.FormatInt(ord.TradeID, 10),
-	}
-}
-
-// convertOrderTradeUpdate converts wsOrderTradeUpdate to core.OrderResponse for internal storage
-func (b *BinanceClient) convertOrderTradeUpdate(ord wsOrderTradeUpdate) core.OrderResponse {
-	var status core.OrderStatus
-	switch ord.Status {
-	case "NEW":
-		status = core.OrderStatusOpen
-	case "FILLED":
-		status = core.OrderStatusFilled
-	case "CANCELED":
-		status = core.OrderStatusCanceled
-	case "REJECTED", "EXPIRED":
-		status = core.OrderStatusError
-	case "PARTIALLY_FILLED":
-		status = core.OrderStatusOpen // Treat as open since it's still active
-	default:
-		status = core.OrderStatusOpen
+	// the last fill is only set on trade reports
+	var lastQty, lastPrice, lastCommission decimal.Decimal
+	tradeID := ""
+	if ord.ExecutionType == "TRADE" {
+		lastQty, _ = decimal.NewFromString(ord.LastFilledQty)
+		lastPrice, _ = decimal.NewFromString(ord.LastFilledPrice)
+		lastCommission, _ = decimal.NewFromString(ord.Commission)
+		tradeID = strconv.FormatInt(ord.TradeID, 10)
 	}
 
-	price, _ := decimal.NewFromString(ord.Price)
-	quantity, _ := decimal.NewFromString(ord.OrigQty)
-
-	// Convert string side to core.OrderSide
-	var side core.OrderSide
-	if ord.Side == "BUY" {
-		side = core.OrderSideBuy
-	} else if ord.Side == "SELL" {
-		side = core.OrderSideSell
+	clientOrderID := ord.ClientOrderID
+	if ord.OrigClientID != "" {
+		clientOrderID = ord.OrigClientID
 	}
 
-	return core.OrderResponse{
-		OrderID:    strconv.FormatInt(ord.OrderID, 10),
-		Symbol:     ord.Symbol,
-		Side:       side,
-		Status:     status,
-		Price:      price,
-		Quantity:   quantity,
-		CreateTime: time.Unix(0, ord.EventTime*int64(time.Millisecond)),
+	return core.OrderEvent{
+		OrderID:         strconv.FormatInt(ord.OrderID, 10),
+		ClientOrderID:   clientOrderID,
+		Symbol:          ord.Symbol,
+		Side:            side,
+		OrderType:       ord.OrderType,
+		Status:          parseOrderStatus(ord.Status),
+		Price:           price,
+		Quantity:        quantity,
+		ExecutedQty:     executedQty,
+		AvgPrice:        avgPrice,
+		CommissionAsset: ord.CommissionAsset,
+		UpdateTime:      time.Unix(0, ord.LastFilledTime*int64(time.Millisecond)),
+		TradeID:         tradeID,
+		IsMaker:         ord.IsMaker,
+		Reason:          reason,
+		LastQty:         lastQty,
+		LastPrice:       lastPrice,
+		LastCommission:  lastCommission,
+		TimeInForce:     core.TimeInForce(ord.TimeInForce),
+		CreateTime:      time.UnixMilli(ord.CreateTime),
 	}
 }
+
@@ -0,0 +1,59 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// PlaceConditional implements core.ConditionalOrderClient. Every kind is native but only
+// on the last price, spot has no mark or index price.
+func (b *BinanceClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Source() != core.TriggerSourceLast {
+		return nil, core.ErrUnsupportedTriggerSource("binance", req.Source())
+	}
+	opts := &OrderOptions{Quantity: req.Quantity, StopPrice: req.TriggerPrice}
+	limit := req.LimitPrice.IsPositive()
+	if limit {
+		opts.Price = req.LimitPrice
+		opts.TimeInForce = string(req.TimeInForce)
+		if opts.TimeInForce == "" {
+			opts.TimeInForce = string(core.TimeInForceGTC)
+		}
+	}
+
+	var orderType string
+	switch req.Type {
+	case core.ConditionalStopLoss:
+		orderType = "STOP_LOSS"
+	case core.ConditionalTakeProfit:
+		orderType = "TAKE_PROFIT"
+	case core.ConditionalTrailingStop:
+		// trailingDelta is in BIPS
+		bips := req.TrailingDelta.Mul(decimal.NewFromInt(100))
+		if !bips.IsInteger() || bips.LessThan(decimal.NewFromInt(1)) {
+			return nil, fmt.Errorf("binance trailing delta must be a whole number of 0.01%%, got %s%%", req.TrailingDelta)
+		}
+		opts.TrailingDelta = int(bips.IntPart())
+		// a trailing stop with an activation price is a take-profit, one tracking right
+		// away is a stop-loss
+		orderType = "STOP_LOSS"
+		if !req.TriggerPrice.IsZero() {
+			orderType = "TAKE_PROFIT"
+		}
+	}
+	if limit {
+		orderType += "_LIMIT"
+	}
+	return b.placeOrder(req.Symbol, string(req.Side), orderType, opts)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (b *BinanceClient) CancelConditional(symbol, orderId string) error {
+	_, err := b.CancelOrder(symbol, orderId)
+	return err
+}
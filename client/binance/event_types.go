@@ -39,6 +39,7 @@ type wsOrderTradeUpdate struct {
 	Status           string `json:"X"`
 	OrderID          int64  `json:"i"`
 	ClientOrderID    string `json:"c"`
+	OrigClientID     string `json:"C"` // client order id of a canceled order, c is the cancel's
 	OrigQty          string `json:"q"`
 	Price            string `json:"p"`
 	ExecutedQty      string `json:"z"`
@@ -47,6 +48,13 @@ type wsOrderTradeUpdate struct {
 	LastFilledPrice  string `json:"L"`
 	LastFilledQty    string `json:"l"`
 	LastFilledTime   int64  `json:"T"`
+	ExecutionType    string `json:"x"`
+	Commission       string `json:"n"` // of the last fill
+	CommissionAsset  string `json:"N"`
+	IsMaker          bool   `json:"m"`
+	RejectReason     string `json:"r"` // NONE unless rejected
+	TimeInForce      string `json:"f"`
+	CreateTime       int64  `json:"O"`
 }
 
 //easyjson:json
@@ -62,6 +70,52 @@ type wsTickerStream struct {
 	BestAskQty      string `json:"A"`
 }
 
+type wsDepthStream struct {
+	EventType     string     `json:"e"`
+	EventTime     int64      `json:"E"`
+	Symbol        string     `json:"s"`
+	FirstUpdateID int64      `json:"U"`
+	FinalUpdateID int64      `json:"u"`
+	Bids          [][]string `json:"b"`
+	Asks          [][]string `json:"a"`
+}
+
+type wsTradeStream struct {
+	EventType    string `json:"e"`
+	EventTime    int64  `json:"E"`
+	Symbol       string `json:"s"`
+	TradeID      int64  `json:"t"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	TradeTime    int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+}
+
+type wsKlineStream struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+	Kline     struct {
+		OpenTime    int64  `json:"t"`
+		CloseTime   int64  `json:"T"`
+		Interval    string `json:"i"`
+		Open        string `json:"o"`
+		Close       string `json:"c"`
+		High        string `json:"h"`
+		Low         string `json:"l"`
+		Volume      string `json:"v"`
+		TradeCount  int64  `json:"n"`
+		Closed      bool   `json:"x"`
+		QuoteVolume string `json:"q"`
+	} `json:"k"`
+}
+
+type depthSnapshot struct {
+	LastUpdateID int64      `json:"lastUpdateId"`
+	Bids         [][]string `json:"bids"`
+	Asks         [][]string `json:"asks"`
+}
+
 type wsSubscribeRequest struct {
 	Method string   `json:"method"`
 	Params []string `json:"params"`
@@ -211,12 +265,20 @@ type wsSymbolInfo struct {
 }
 
 type wsFilter struct {
-	FilterType string `json:"filterType"`
-	MinPrice   string `json:"minPrice,omitempty"`
-	MaxPrice   string `json:"maxPrice,omitempty"`
-	TickSize   string `json:"tickSize,omitempty"`
-	MinQty     string `json:"minQty,omitempty"`
-	MaxQty     string `json:"maxQty,omitempty"`
-	StepSize   string `json:"stepSize,omitempty"`
+	FilterType        string `json:"filterType"`
+	MinPrice          string `json:"minPrice,omitempty"`
+	MaxPrice          string `json:"maxPrice,omitempty"`
+	TickSize          string `json:"tickSize,omitempty"`
+	MinQty            string `json:"minQty,omitempty"`
+	MaxQty            string `json:"maxQty,omitempty"`
+	StepSize          string `json:"stepSize,omitempty"`
+	MinNotional       string `json:"minNotional,omitempty"`
+	MaxNotional       string `json:"maxNotional,omitempty"`
+	MultiplierUp      string `json:"multiplierUp,omitempty"`
+	MultiplierDown    string `json:"multiplierDown,omitempty"`
+	BidMultiplierUp   string `json:"bidMultiplierUp,omitempty"`
+	BidMultiplierDown string `json:"bidMultiplierDown,omitempty"`
+	AskMultiplierUp   string `json:"askMultiplierUp,omitempty"`
+	AskMultiplierDown string `json:"askMultiplierDown,omitempty"`
 	// Add other filter fields as needed
 }
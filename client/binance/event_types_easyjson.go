@@ -979,6 +979,8 @@ func easyjsonDc809a28DecodeGithubComLjm2yaQuickexGoSpotBinance7(in *jlexer.Lexer
 			out.OrderID = int64(in.Int64())
 		case "c":
 			out.ClientOrderID = string(in.String())
+		case "C":
+			out.OrigClientID = string(in.String())
 		case "q":
 			out.OrigQty = string(in.String())
 		case "p":
@@ -995,6 +997,20 @@ func easyjsonDc809a28DecodeGithubComLjm2yaQuickexGoSpotBinance7(in *jlexer.Lexer
 			out.LastFilledQty = string(in.String())
 		case "T":
 			out.LastFilledTime = int64(in.Int64())
+		case "x":
+			out.ExecutionType = string(in.String())
+		case "n":
+			out.Commission = string(in.String())
+		case "N":
+			out.CommissionAsset = string(in.String())
+		case "m":
+			out.IsMaker = bool(in.Bool())
+		case "r":
+			out.RejectReason = string(in.String())
+		case "f":
+			out.TimeInForce = string(in.String())
+		case "O":
+			out.CreateTime = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1049,6 +1065,11 @@ func easyjsonDc809a28EncodeGithubComLjm2yaQuickexGoSpotBinance7(out *jwriter.Wri
 		out.RawString(prefix)
 		out.String(string(in.ClientOrderID))
 	}
+	{
+		const prefix string = ",\"C\":"
+		out.RawString(prefix)
+		out.String(string(in.OrigClientID))
+	}
 	{
 		const prefix string = ",\"q\":"
 		out.RawString(prefix)
@@ -1089,6 +1110,41 @@ func easyjsonDc809a28EncodeGithubComLjm2yaQuickexGoSpotBinance7(out *jwriter.Wri
 		out.RawString(prefix)
 		out.Int64(int64(in.LastFilledTime))
 	}
+	{
+		const prefix string = ",\"x\":"
+		out.RawString(prefix)
+		out.String(string(in.ExecutionType))
+	}
+	{
+		const prefix string = ",\"n\":"
+		out.RawString(prefix)
+		out.String(string(in.Commission))
+	}
+	{
+		const prefix string = ",\"N\":"
+		out.RawString(prefix)
+		out.String(string(in.CommissionAsset))
+	}
+	{
+		const prefix string = ",\"m\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsMaker))
+	}
+	{
+		const prefix string = ",\"r\":"
+		out.RawString(prefix)
+		out.String(string(in.RejectReason))
+	}
+	{
+		const prefix string = ",\"f\":"
+		out.RawString(prefix)
+		out.String(string(in.TimeInForce))
+	}
+	{
+		const prefix string = ",\"O\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.CreateTime))
+	}
 	out.RawByte('}')
 }
 
@@ -1396,6 +1452,22 @@ func easyjsonDc809a28DecodeGithubComLjm2yaQuickexGoSpotBinance10(in *jlexer.Lexe
 			out.MaxQty = string(in.String())
 		case "stepSize":
 			out.StepSize = string(in.String())
+		case "minNotional":
+			out.MinNotional = string(in.String())
+		case "maxNotional":
+			out.MaxNotional = string(in.String())
+		case "multiplierUp":
+			out.MultiplierUp = string(in.String())
+		case "multiplierDown":
+			out.MultiplierDown = string(in.String())
+		case "bidMultiplierUp":
+			out.BidMultiplierUp = string(in.String())
+		case "bidMultiplierDown":
+			out.BidMultiplierDown = string(in.String())
+		case "askMultiplierUp":
+			out.AskMultiplierUp = string(in.String())
+		case "askMultiplierDown":
+			out.AskMultiplierDown = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1445,6 +1517,46 @@ func easyjsonDc809a28EncodeGithubComLjm2yaQuickexGoSpotBinance10(out *jwriter.Wr
 		out.RawString(prefix)
 		out.String(string(in.StepSize))
 	}
+	if in.MinNotional != "" {
+		const prefix string = ",\"minNotional\":"
+		out.RawString(prefix)
+		out.String(string(in.MinNotional))
+	}
+	if in.MaxNotional != "" {
+		const prefix string = ",\"maxNotional\":"
+		out.RawString(prefix)
+		out.String(string(in.MaxNotional))
+	}
+	if in.MultiplierUp != "" {
+		const prefix string = ",\"multiplierUp\":"
+		out.RawString(prefix)
+		out.String(string(in.MultiplierUp))
+	}
+	if in.MultiplierDown != "" {
+		const prefix string = ",\"multiplierDown\":"
+		out.RawString(prefix)
+		out.String(string(in.MultiplierDown))
+	}
+	if in.BidMultiplierUp != "" {
+		const prefix string = ",\"bidMultiplierUp\":"
+		out.RawString(prefix)
+		out.String(string(in.BidMultiplierUp))
+	}
+	if in.BidMultiplierDown != "" {
+		const prefix string = ",\"bidMultiplierDown\":"
+		out.RawString(prefix)
+		out.String(string(in.BidMultiplierDown))
+	}
+	if in.AskMultiplierUp != "" {
+		const prefix string = ",\"askMultiplierUp\":"
+		out.RawString(prefix)
+		out.String(string(in.AskMultiplierUp))
+	}
+	if in.AskMultiplierDown != "" {
+		const prefix string = ",\"askMultiplierDown\":"
+		out.RawString(prefix)
+		out.String(string(in.AskMultiplierDown))
+	}
 	out.RawByte('}')
 }
 
@@ -24,17 +24,17 @@ func (b *BinanceClient) GetCachedBalance(asset string, includeLocked bool) (floa
 	}
 }
 
-func (b *BinanceClient) GetPositionAmount(asset string) (float64, error) {
+func (b *BinanceClient) GetPositionAmount(asset string) (decimal.Decimal, error) {
 	acct, err := b.GetAccount()
 	if err != nil {
-		return 0, fmt.Errorf("Get account err: %v", err)
+		return decimal.Zero, fmt.Errorf("Get account err: %v", err)
 	}
 	for _, position := range acct.Positions {
 		if position.Symbol == asset+"USDT" {
 			return position.Amount, nil
 		}
 	}
-	return 0, nil
+	return decimal.Zero, nil
 }
 
 func (b *BinanceClient) GetBalance(asset string, includeLocked bool) (float64, error) {
@@ -83,20 +83,20 @@ func (b *BinanceClient) GetAccount() (*core.Account, error) {
 	}
 
 	acct := &core.Account{
-		CrossBalance:   core.ParseStringFloat(info.TotalCrossWalletBalance),
-		CrossUrlProfit: core.ParseStringFloat(info.TotalCrossUnPnl),
+		CrossBalance:   core.ParseStringDecimal(info.TotalCrossWalletBalance),
+		CrossUrlProfit: core.ParseStringDecimal(info.TotalCrossUnPnl),
 		Assets:         make(map[string]*core.Wallet),
 		Positions:      make(map[string]*core.Position),
 	}
 	// Assets
 	for _, a := range info.Assets {
-		wb := core.ParseStringFloat(a.CrossWalletBalance)
-		cwb := core.ParseStringFloat(a.AvailableBalance)
+		wb := core.ParseStringDecimal(a.CrossWalletBalance)
+		cwb := core.ParseStringDecimal(a.AvailableBalance)
 		acct.Assets[a.Asset] = &core.Wallet{
 			Asset:  a.Asset,
-			Free:   decimal.NewFromFloat(cwb),
-			Locked: decimal.NewFromFloat(wb - cwb),
-			Total:  decimal.NewFromFloat(wb),
+			Free:   cwb,
+			Locked: wb.Sub(cwb),
+			Total:  wb,
 		}
 	}
 
@@ -104,13 +104,13 @@ func (b *BinanceClient) GetAccount() (*core.Account, error) {
 		acct.Positions[p.Symbol] = &core.Position{
 			Symbol:         p.Symbol,
 			Side:           toPositionSide(p.PositionSide),
-			Amount:         core.ParseStringFloat(p.PositionAmt),
-			UrlProfit:      core.ParseStringFloat(p.UnrealizedProfit),
-			IsolatedMargin: core.ParseStringFloat(p.IsolatedMargin),
-			Notional:       core.ParseStringFloat(p.Notional),
-			IsolatedWallet: core.ParseStringFloat(p.IsolatedWallet),
-			InitialMargin:  core.ParseStringFloat(p.InitialMargin),
-			MaintMargin:    core.ParseStringFloat(p.MaintMargin),
+			Amount:         core.ParseStringDecimal(p.PositionAmt),
+			UrlProfit:      core.ParseStringDecimal(p.UnrealizedProfit),
+			IsolatedMargin: core.ParseStringDecimal(p.IsolatedMargin),
+			Notional:       core.ParseStringDecimal(p.Notional),
+			IsolatedWallet: core.ParseStringDecimal(p.IsolatedWallet),
+			InitialMargin:  core.ParseStringDecimal(p.InitialMargin),
+			MaintMargin:    core.ParseStringDecimal(p.MaintMargin),
 			UpdatedTime:    time.UnixMilli(p.UpdateTime),
 		}
 	}
@@ -135,7 +135,7 @@ func (b *BinanceClient) FetchBalance(asset string, includeLocked bool, futuresPo
 		if err != nil {
 			return decimal.Zero, err
 		}
-		return decimal.NewFromFloat(pos), nil
+		return pos, nil
 	}
 	balance, err := b.GetBalance(asset, includeLocked)
 	if err != nil {
@@ -0,0 +1,185 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// klinesPageLimit is the max number of klines per request
+const klinesPageLimit = 1500
+
+// FetchCandles implements core.PublicClient
+func (b *BinanceClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	if interval.Duration() == 0 {
+		return nil, core.ErrUnsupportedInterval("binance", interval)
+	}
+	return core.PageCandles(start, end, limit, klinesPageLimit, func(start, end time.Time, limit int) ([]core.Candle, error) {
+		params := url.Values{}
+		params.Set("symbol", symbol)
+		params.Set("interval", string(interval)) // binance uses the same notation
+		params.Set("endTime", strconv.FormatInt(end.UnixMilli(), 10))
+		params.Set("limit", strconv.Itoa(limit))
+		if !start.IsZero() {
+			params.Set("startTime", strconv.FormatInt(start.UnixMilli(), 10))
+		}
+
+		limiter := b.RateLimiter()
+		if limiter != nil {
+			if err := limiter.Wait(context.Background(), core.RateCost{Category: core.RateLimitRequest, Weight: klinesWeight(limit)}); err != nil {
+				return nil, err
+			}
+		}
+		resp, err := restHTTPClient.Get(b.baseURL + "/fapi/v1/klines?" + params.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch klines: %w", err)
+		}
+		defer resp.Body.Close()
+		if limiter != nil {
+			limiter.ObserveHeader(resp.Header)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			var apiErr struct {
+				Code int64  `json:"code"`
+				Msg  string `json:"msg"`
+			}
+			if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Code != 0 {
+				return nil, wrapStatusCode(int64(resp.StatusCode), apiErr.Code, apiErr.Msg)
+			}
+			return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+		}
+
+		var rows [][]interface{}
+		if err := json.Unmarshal(body, &rows); err != nil {
+			return nil, fmt.Errorf("failed to decode klines: %w", err)
+		}
+		candles := make([]core.Candle, 0, len(rows))
+		for _, row := range rows {
+			c, err := parseKline(symbol, interval, row)
+			if err != nil {
+				return nil, err
+			}
+			candles = append(candles, c)
+		}
+		return candles, nil
+	})
+}
+
+// klinesWeight is the weight of a klines request by its limit
+func klinesWeight(limit int) int64 {
+	switch {
+	case limit < 100:
+		return 1
+	case limit < 500:
+		return 2
+	case limit <= 1000:
+		return 5
+	default:
+		return 10
+	}
+}
+
+// parseKline parses [openTime, open, high, low, close, volume, closeTime, quoteVolume, trades, ...]
+func parseKline(symbol string, interval core.CandleInterval, row []interface{}) (core.Candle, error) {
+	if len(row) < 9 {
+		return core.Candle{}, fmt.Errorf("invalid kline row: %v", row)
+	}
+	openTime, _ := row[0].(float64)
+	closeTime, _ := row[6].(float64)
+	trades, _ := row[8].(float64)
+	return core.Candle{
+		Symbol:      symbol,
+		Interval:    interval,
+		OpenTime:    time.UnixMilli(int64(openTime)),
+		CloseTime:   time.UnixMilli(int64(closeTime)),
+		Open:        klineDecimal(row[1]),
+		High:        klineDecimal(row[2]),
+		Low:         klineDecimal(row[3]),
+		Close:       klineDecimal(row[4]),
+		Volume:      klineDecimal(row[5]),
+		QuoteVolume: klineDecimal(row[7]),
+		TradeCount:  int64(trades),
+	}, nil
+}
+
+func klineDecimal(v interface{}) decimal.Decimal {
+	s, _ := v.(string)
+	d, _ := decimal.NewFromString(s)
+	return d
+}
+
+// SubscribeCandles implements core.PublicClient with the @kline stream, bars are pushed
+// on every update and once more with Closed set when they finalise
+func (b *BinanceClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	candleChMap := make(map[string]<-chan core.Candle)
+	if interval.Duration() == 0 {
+		return candleChMap, core.ErrUnsupportedInterval("binance", interval)
+	}
+	chans := make(map[string]chan core.Candle)
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Candle, 16)
+		candleChMap[symbol] = chans[symbol]
+		streams[i] = strings.ToLower(symbol) + "@kline_" + string(interval)
+	}
+	streamURL := fmt.Sprintf("%s/stream?streams=%s", b.streamURL, strings.Join(streams, "/"))
+
+	// streams are part of the URL, so nothing to replay on reconnect
+	stream := core.NewStream(streamURL, nil, func(msg []byte) {
+		var combinedMsg struct {
+			Stream string        `json:"stream"`
+			Data   wsKlineStream `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &combinedMsg); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
+			}
+			return
+		}
+		if ch, exists := chans[combinedMsg.Data.Symbol]; exists {
+			core.SendCandle(ctx, ch, combinedMsg.Data.candle(interval))
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return candleChMap, err
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+	return candleChMap, nil
+}
+
+func (ev *wsKlineStream) candle(interval core.CandleInterval) core.Candle {
+	k := ev.Kline
+	return core.Candle{
+		Symbol:      ev.Symbol,
+		Interval:    interval,
+		OpenTime:    time.UnixMilli(k.OpenTime),
+		CloseTime:   time.UnixMilli(k.CloseTime),
+		Open:        klineDecimal(k.Open),
+		High:        klineDecimal(k.High),
+		Low:         klineDecimal(k.Low),
+		Close:       klineDecimal(k.Close),
+		Volume:      klineDecimal(k.Volume),
+		QuoteVolume: klineDecimal(k.QuoteVolume),
+		TradeCount:  k.TradeCount,
+		Closed:      k.Closed,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -17,16 +18,19 @@ import (
 )
 
 const (
-	binanceWsURL        = "wss://ws-fapi.binance.com/ws-fapi/v1"
-	binanceTestnetWsURL = "wss://testnet.binancefuture.com/ws-fapi/v1"
-	wsLifetime          = 23*time.Hour + 50*time.Minute
-	commisionRate       = 0.0005
+	binanceWsURL            = "wss://ws-fapi.binance.com/ws-fapi/v1"
+	binanceTestnetWsURL     = "wss://testnet.binancefuture.com/ws-fapi/v1"
+	binanceStreamURL        = "wss://fstream.binance.com"
+	binanceTestnetStreamURL = "wss://stream.binancefuture.com"
+	wsLifetime              = 23*time.Hour + 50*time.Minute
+	commisionRate           = 0.0005
 )
 
 type BinanceClient struct {
 	*core.WsClient
 	balances   map[string]*core.Wallet
 	orders     map[string]*core.OrderResponse // order ID : response
+	rules      *core.RuleCache                // rules orders are normalised to
 	wsReject   map[string]chan wsListStatus
 	balancesMu sync.RWMutex
 	ordersMu   sync.RWMutex
@@ -34,6 +38,7 @@ type BinanceClient struct {
 	apiKey     string
 	privateKey ed25519.PrivateKey
 	baseURL    string
+	streamURL  string // market data streams
 	hedgeMode  bool
 	isTestnet  bool
 
@@ -61,6 +66,7 @@ func NewClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 		apiKey:     apiKey,
 		privateKey: prvKey,
 		baseURL:    "https://fapi.binance.com",
+		streamURL:  binanceStreamURL,
 		isTestnet:  false,
 	}
 	b.WsClient = core.NewWsClient(
@@ -73,8 +79,10 @@ func NewClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 		extractErrFn(),
 		afterConnect(b),
 	)
+	b.SetRateLimiter(core.NewRateLimiter("binance", defaultRateLimits), rateCostFn(), rateUsageFn())
 	// Initialize user data stream
 	b.userDataStream = NewBinanceUserDataStream(b, apiKey, prvKey, false)
+	b.initRules()
 	return b
 }
 
@@ -89,6 +97,7 @@ func NewTestClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 		apiKey:     apiKey,
 		privateKey: prvKey,
 		baseURL:    "https://testnet.binancefuture.com",
+		streamURL:  binanceTestnetStreamURL,
 		isTestnet:  true,
 	}
 	b.WsClient = core.NewWsClient(
@@ -101,8 +110,10 @@ func NewTestClient(apiKey string, prvKey ed25519.PrivateKey) *BinanceClient {
 		extractErrFn(),
 		afterConnect(b),
 	)
+	b.SetRateLimiter(core.NewRateLimiter("binance", defaultRateLimits), rateCostFn(), rateUsageFn())
 	// Initialize user data stream for testnet
 	b.userDataStream = NewBinanceUserDataStream(b, apiKey, prvKey, true)
+	// no rule cache, FetchMarketRules reads the mainnet filters
 	return b
 }
 
@@ -276,23 +287,58 @@ func extractIDFn() core.WsExtractIDFunc {
 	}
 }
 
-func wrapStatusCode(status int64, msg string) error {
-	switch status {
-	case 200:
+// wrapStatusCode maps a binance error into core.ExchangeError, code is the binance error code
+func wrapStatusCode(status int64, code int64, msg string) error {
+	if status == 200 {
 		return nil
-	case 429, 418:
-		return fmt.Errorf("rate limited or banned (status %d): %s", status, msg)
-	case 500, 502, 503, 504:
-		return fmt.Errorf("binance server error (status %d): %s", status, msg)
-	default:
-		if status >= 400 && status < 500 {
-			return fmt.Errorf("binance client error (status %d): %s", status, msg)
+	}
+	if status < 400 && code == 0 {
+		return nil
+	}
+	category := core.ErrCategoryUnknown
+	switch code {
+	case -1003, -1015:
+		category = core.ErrCategoryRateLimited
+	case -1002, -1022, -2014, -2015:
+		category = core.ErrCategoryAuthFailed
+	case -1007:
+		category = core.ErrCategoryExecutionUnknown
+	case -1016:
+		category = core.ErrCategoryMaintenance
+	case -1121:
+		category = core.ErrCategoryInvalidSymbol
+	case -2011, -2013:
+		category = core.ErrCategoryOrderNotFound
+	case -2018, -2019:
+		category = core.ErrCategoryInsufficientBalance
+	case -4164:
+		category = core.ErrCategoryMinNotional
+	case -4014, -4016, -4024:
+		category = core.ErrCategoryPriceFilter
+	case -5022:
+		category = core.ErrCategoryPostOnlyRejected
+	case -1013, -2010:
+		// generic filter failure and order rejects carry the reason in the message
+		switch {
+		case strings.Contains(msg, "insufficient balance"):
+			category = core.ErrCategoryInsufficientBalance
+		case strings.Contains(msg, "NOTIONAL"):
+			category = core.ErrCategoryMinNotional
+		case strings.Contains(msg, "PRICE_FILTER"), strings.Contains(msg, "PERCENT_PRICE"):
+			category = core.ErrCategoryPriceFilter
+		case strings.Contains(msg, "immediately match and take"):
+			category = core.ErrCategoryPostOnlyRejected
 		}
-		if status >= 500 {
-			return fmt.Errorf("binance server error (status %d): %s", status, msg)
+	default:
+		switch {
+		case status == 429 || status == 418:
+			category = core.ErrCategoryRateLimited
+		case status >= 500:
+			// the execution status of a 5xx is unknown, the request may have succeeded
+			category = core.ErrCategoryExecutionUnknown
 		}
 	}
-	return nil
+	return core.NewExchangeError("binance", category, strconv.FormatInt(code, 10), fmt.Sprintf("%s (status %d)", msg, status)).WithStatus(int(status))
 }
 
 func extractErrFn() core.WsExtractErrFunc {
@@ -302,8 +348,9 @@ func extractErrFn() core.WsExtractErrFunc {
 			if err := json.Unmarshal(errRaw, &errMsgObj); err == nil {
 				// Optionally inject status handler/callback here too
 				status := core.IntFromRawMap(root, "status")
+				code := int64(core.IntFromMap(errMsgObj, "code"))
 				errMsg := core.StringFromMap(errMsgObj, "msg")
-				return wrapStatusCode(status, errMsg)
+				return wrapStatusCode(status, code, errMsg)
 			}
 		}
 		return nil
@@ -312,7 +359,8 @@ func extractErrFn() core.WsExtractErrFunc {
 
 func afterConnect(b *BinanceClient) core.WsAfterConnectFunc {
 	return func(c *core.WsClient) error {
-		err := b.userDataStream.Connect(b.Ctx)
+		// the user data stream is not bound to a single ws-api session
+		err := b.userDataStream.Connect(c.Context())
 		if err != nil {
 			return fmt.Errorf("User data stream connect: %v", err)
 		}
@@ -330,10 +378,11 @@ func afterConnect(b *BinanceClient) core.WsAfterConnectFunc {
 			}
 		}
 		b.balancesMu.Unlock()
+		ctx := c.Ctx // stop with this session, a reconnect starts a new loop
 		go func() {
 			for {
 				select {
-				case <-c.Ctx.Done():
+				case <-ctx.Done():
 					return
 				default:
 				}
@@ -543,22 +592,6 @@ func (b *BinanceClient) forwardBalanceEvents(errHandler func(err error)) {
 
 // convertToOrderEvent converts wsOrderTradeUpdate to core.OrderEvent
 func (b *BinanceClient) convertToOrderEvent(ord wsOrderTradeUpdate) core.OrderEvent {
-	var status core.OrderStatus
-	switch ord.Status {
-	case "NEW":
-		status = core.OrderStatusOpen
-	case "FILLED":
-		status = core.OrderStatusFilled
-	case "CANCELED":
-		status = core.OrderStatusCanceled
-	case "REJECTED", "EXPIRED":
-		status = core.OrderStatusError
-	case "PARTIALLY_FILLED":
-		status = core.OrderStatusOpen // Treat as open since it's still active
-	default:
-		status = core.OrderStatusOpen
-	}
-
 	price, _ := decimal.NewFromString(ord.Price)
 	quantity, _ := decimal.NewFromString(ord.OrigQty)
 	executedQty, _ := decimal.NewFromString(ord.ExecutedQty)
@@ -573,17 +606,19 @@ func (b *BinanceClient) convertToOrderEvent(ord wsOrderTradeUpdate) core.OrderEv
 	}
 
 	return core.OrderEvent{
-		OrderID:     strconv.FormatInt(ord.OrderID, 10),
-		Symbol:      ord.Symbol,
-		Side:        side,
-		OrderType:   ord.OrderType,
-		Status:      status,
-		Price:       price,
-		Quantity:    quantity,
-		ExecutedQty: executedQty,
-		AvgPrice:    lastFilledPrice, // Approximate - Binance doesn't provide true average
-		UpdateTime:  time.Unix(0, ord.LastFilledTime*int64(time.Millisecond)),
-		TradeID:     strconv.FormatInt(ord.TradeID, 10),
+		OrderID:       strconv.FormatInt(ord.OrderID, 10),
+		ClientOrderID: ord.ClientOrderID,
+		Symbol:        ord.Symbol,
+		Side:          side,
+		OrderType:     ord.OrderType,
+		Status:        parseOrderStatus(ord.Status),
+		Reason:        statusReason(ord.Status),
+		Price:         price,
+		Quantity:      quantity,
+		ExecutedQty:   executedQty,
+		AvgPrice:      lastFilledPrice, // Approximate - Binance doesn't provide true average
+		UpdateTime:    time.Unix(0, ord.LastFilledTime*int64(time.Millisecond)),
+		TradeID:       strconv.FormatInt(ord.TradeID, 10),
 	}
 }
 
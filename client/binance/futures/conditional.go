@@ -0,0 +1,67 @@
+package binance
+
+import (
+	"fmt"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// workingTypes maps trigger sources to binance futures workingType, there is no index trigger
+var workingTypes = map[core.TriggerSource]string{
+	core.TriggerSourceLast: "CONTRACT_PRICE",
+	core.TriggerSourceMark: "MARK_PRICE",
+}
+
+// PlaceConditional implements core.ConditionalOrderClient, every kind is native
+func (b *BinanceClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	workingType, ok := workingTypes[req.Source()]
+	if !ok {
+		return nil, core.ErrUnsupportedTriggerSource("binance futures", req.Source())
+	}
+	opts := &OrderOptions{
+		Quantity:    req.Quantity,
+		ReduceOnly:  req.ReduceOnly,
+		WorkingType: workingType,
+	}
+	limit := req.LimitPrice.IsPositive()
+	if limit {
+		opts.Price = req.LimitPrice
+		opts.TimeInForce = string(req.TimeInForce)
+		if opts.TimeInForce == "" {
+			opts.TimeInForce = string(core.TimeInForceGTC)
+		}
+	}
+
+	var orderType string
+	switch req.Type {
+	case core.ConditionalStopLoss:
+		orderType = "STOP_MARKET"
+		if limit {
+			orderType = "STOP"
+		}
+		opts.StopPrice = req.TriggerPrice
+	case core.ConditionalTakeProfit:
+		orderType = "TAKE_PROFIT_MARKET"
+		if limit {
+			orderType = "TAKE_PROFIT"
+		}
+		opts.StopPrice = req.TriggerPrice
+	case core.ConditionalTrailingStop:
+		if limit {
+			return nil, fmt.Errorf("binance futures trailing stops are market orders only")
+		}
+		orderType = "TRAILING_STOP_MARKET"
+		opts.CallbackRate = req.TrailingDelta
+		opts.ActivationPrice = req.TriggerPrice
+	}
+	return b.placeOrder(req.Symbol, string(req.Side), orderType, opts)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (b *BinanceClient) CancelConditional(symbol, orderId string) error {
+	_, err := b.CancelOrder(symbol, orderId)
+	return err
+}
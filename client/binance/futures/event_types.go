@@ -262,13 +262,16 @@ type WsfapiExchangeInfo struct {
 		PricePrecision    int    `json:"pricePrecision"`
 		QuantityPrecision int    `json:"quantityPrecision"`
 		Filters           []struct {
-			FilterType string `json:"filterType"`
-			MinPrice   string `json:"minPrice,omitempty"`
-			MaxPrice   string `json:"maxPrice,omitempty"`
-			TickSize   string `json:"tickSize,omitempty"`
-			MinQty     string `json:"minQty,omitempty"`
-			MaxQty     string `json:"maxQty,omitempty"`
-			StepSize   string `json:"stepSize,omitempty"`
+			FilterType     string `json:"filterType"`
+			MinPrice       string `json:"minPrice,omitempty"`
+			MaxPrice       string `json:"maxPrice,omitempty"`
+			TickSize       string `json:"tickSize,omitempty"`
+			MinQty         string `json:"minQty,omitempty"`
+			MaxQty         string `json:"maxQty,omitempty"`
+			StepSize       string `json:"stepSize,omitempty"`
+			Notional       string `json:"notional,omitempty"`
+			MultiplierUp   string `json:"multiplierUp,omitempty"`
+			MultiplierDown string `json:"multiplierDown,omitempty"`
 		} `json:"filters"`
 		OrderType []string `json:"OrderType"`
 	} `json:"symbols"`
@@ -286,6 +289,54 @@ type wsTickerStream struct {
 	BestAskQty      string `json:"A"`
 }
 
+type wsDepthStream struct {
+	EventType       string     `json:"e"`
+	EventTime       int64      `json:"E"`
+	TransactionTime int64      `json:"T"`
+	Symbol          string     `json:"s"`
+	FirstUpdateID   int64      `json:"U"`
+	FinalUpdateID   int64      `json:"u"`
+	PrevUpdateID    int64      `json:"pu"`
+	Bids            [][]string `json:"b"`
+	Asks            [][]string `json:"a"`
+}
+
+type wsAggTradeStream struct {
+	EventType    string `json:"e"`
+	EventTime    int64  `json:"E"`
+	Symbol       string `json:"s"`
+	AggTradeID   int64  `json:"a"`
+	Price        string `json:"p"`
+	Quantity     string `json:"q"`
+	TradeTime    int64  `json:"T"`
+	IsBuyerMaker bool   `json:"m"`
+}
+
+type wsKlineStream struct {
+	EventType string `json:"e"`
+	EventTime int64  `json:"E"`
+	Symbol    string `json:"s"`
+	Kline     struct {
+		OpenTime    int64  `json:"t"`
+		CloseTime   int64  `json:"T"`
+		Interval    string `json:"i"`
+		Open        string `json:"o"`
+		Close       string `json:"c"`
+		High        string `json:"h"`
+		Low         string `json:"l"`
+		Volume      string `json:"v"`
+		TradeCount  int64  `json:"n"`
+		Closed      bool   `json:"x"`
+		QuoteVolume string `json:"q"`
+	} `json:"k"`
+}
+
+type depthSnapshot struct {
+	LastUpdateID int64      `json:"lastUpdateId"`
+	Bids         [][]string `json:"bids"`
+	Asks         [][]string `json:"asks"`
+}
+
 type wsSubscribeRequest struct {
 	Method string   `json:"method"`
 	Params []string `json:"params"`
@@ -2425,13 +2425,16 @@ func easyjsonDc809a28DecodeGithubComLjm2yaQuickexGoFuturesBinance19(in *jlexer.L
 							PricePrecision    int    `json:"pricePrecision"`
 							QuantityPrecision int    `json:"quantityPrecision"`
 							Filters           []struct {
-								FilterType string `json:"filterType"`
-								MinPrice   string `json:"minPrice,omitempty"`
-								MaxPrice   string `json:"maxPrice,omitempty"`
-								TickSize   string `json:"tickSize,omitempty"`
-								MinQty     string `json:"minQty,omitempty"`
-								MaxQty     string `json:"maxQty,omitempty"`
-								StepSize   string `json:"stepSize,omitempty"`
+								FilterType     string `json:"filterType"`
+								MinPrice       string `json:"minPrice,omitempty"`
+								MaxPrice       string `json:"maxPrice,omitempty"`
+								TickSize       string `json:"tickSize,omitempty"`
+								MinQty         string `json:"minQty,omitempty"`
+								MaxQty         string `json:"maxQty,omitempty"`
+								StepSize       string `json:"stepSize,omitempty"`
+								Notional       string `json:"notional,omitempty"`
+								MultiplierUp   string `json:"multiplierUp,omitempty"`
+								MultiplierDown string `json:"multiplierDown,omitempty"`
 							} `json:"filters"`
 							OrderType []string `json:"OrderType"`
 						}, 0, 0)
@@ -2444,13 +2447,16 @@ func easyjsonDc809a28DecodeGithubComLjm2yaQuickexGoFuturesBinance19(in *jlexer.L
 							PricePrecision    int    `json:"pricePrecision"`
 							QuantityPrecision int    `json:"quantityPrecision"`
 							Filters           []struct {
-								FilterType string `json:"filterType"`
-								MinPrice   string `json:"minPrice,omitempty"`
-								MaxPrice   string `json:"maxPrice,omitempty"`
-								TickSize   string `json:"tickSize,omitempty"`
-								MinQty     string `json:"minQty,omitempty"`
-								MaxQty     string `json:"maxQty,omitempty"`
-								StepSize   string `json:"stepSize,omitempty"`
+								FilterType     string `json:"filterType"`
+								MinPrice       string `json:"minPrice,omitempty"`
+								MaxPrice       string `json:"maxPrice,omitempty"`
+								TickSize       string `json:"tickSize,omitempty"`
+								MinQty         string `json:"minQty,omitempty"`
+								MaxQty         string `json:"maxQty,omitempty"`
+								StepSize       string `json:"stepSize,omitempty"`
+								Notional       string `json:"notional,omitempty"`
+								MultiplierUp   string `json:"multiplierUp,omitempty"`
+								MultiplierDown string `json:"multiplierDown,omitempty"`
 							} `json:"filters"`
 							OrderType []string `json:"OrderType"`
 						}{}
@@ -2467,13 +2473,16 @@ func easyjsonDc809a28DecodeGithubComLjm2yaQuickexGoFuturesBinance19(in *jlexer.L
 						PricePrecision    int    `json:"pricePrecision"`
 						QuantityPrecision int    `json:"quantityPrecision"`
 						Filters           []struct {
-							FilterType string `json:"filterType"`
-							MinPrice   string `json:"minPrice,omitempty"`
-							MaxPrice   string `json:"maxPrice,omitempty"`
-							TickSize   string `json:"tickSize,omitempty"`
-							MinQty     string `json:"minQty,omitempty"`
-							MaxQty     string `json:"maxQty,omitempty"`
-							StepSize   string `json:"stepSize,omitempty"`
+							FilterType     string `json:"filterType"`
+							MinPrice       string `json:"minPrice,omitempty"`
+							MaxPrice       string `json:"maxPrice,omitempty"`
+							TickSize       string `json:"tickSize,omitempty"`
+							MinQty         string `json:"minQty,omitempty"`
+							MaxQty         string `json:"maxQty,omitempty"`
+							StepSize       string `json:"stepSize,omitempty"`
+							Notional       string `json:"notional,omitempty"`
+							MultiplierUp   string `json:"multiplierUp,omitempty"`
+							MultiplierDown string `json:"multiplierDown,omitempty"`
 						} `json:"filters"`
 						OrderType []string `json:"OrderType"`
 					}
@@ -2563,13 +2572,16 @@ func easyjsonDc809a28Decode2(in *jlexer.Lexer, out *struct {
 	PricePrecision    int    `json:"pricePrecision"`
 	QuantityPrecision int    `json:"quantityPrecision"`
 	Filters           []struct {
-		FilterType string `json:"filterType"`
-		MinPrice   string `json:"minPrice,omitempty"`
-		MaxPrice   string `json:"maxPrice,omitempty"`
-		TickSize   string `json:"tickSize,omitempty"`
-		MinQty     string `json:"minQty,omitempty"`
-		MaxQty     string `json:"maxQty,omitempty"`
-		StepSize   string `json:"stepSize,omitempty"`
+		FilterType     string `json:"filterType"`
+		MinPrice       string `json:"minPrice,omitempty"`
+		MaxPrice       string `json:"maxPrice,omitempty"`
+		TickSize       string `json:"tickSize,omitempty"`
+		MinQty         string `json:"minQty,omitempty"`
+		MaxQty         string `json:"maxQty,omitempty"`
+		StepSize       string `json:"stepSize,omitempty"`
+		Notional       string `json:"notional,omitempty"`
+		MultiplierUp   string `json:"multiplierUp,omitempty"`
+		MultiplierDown string `json:"multiplierDown,omitempty"`
 	} `json:"filters"`
 	OrderType []string `json:"OrderType"`
 }) {
@@ -2612,23 +2624,29 @@ func easyjsonDc809a28Decode2(in *jlexer.Lexer, out *struct {
 				if out.Filters == nil {
 					if !in.IsDelim(']') {
 						out.Filters = make([]struct {
-							FilterType string `json:"filterType"`
-							MinPrice   string `json:"minPrice,omitempty"`
-							MaxPrice   string `json:"maxPrice,omitempty"`
-							TickSize   string `json:"tickSize,omitempty"`
-							MinQty     string `json:"minQty,omitempty"`
-							MaxQty     string `json:"maxQty,omitempty"`
-							StepSize   string `json:"stepSize,omitempty"`
+							FilterType     string `json:"filterType"`
+							MinPrice       string `json:"minPrice,omitempty"`
+							MaxPrice       string `json:"maxPrice,omitempty"`
+							TickSize       string `json:"tickSize,omitempty"`
+							MinQty         string `json:"minQty,omitempty"`
+							MaxQty         string `json:"maxQty,omitempty"`
+							StepSize       string `json:"stepSize,omitempty"`
+							Notional       string `json:"notional,omitempty"`
+							MultiplierUp   string `json:"multiplierUp,omitempty"`
+							MultiplierDown string `json:"multiplierDown,omitempty"`
 						}, 0, 0)
 					} else {
 						out.Filters = []struct {
-							FilterType string `json:"filterType"`
-							MinPrice   string `json:"minPrice,omitempty"`
-							MaxPrice   string `json:"maxPrice,omitempty"`
-							TickSize   string `json:"tickSize,omitempty"`
-							MinQty     string `json:"minQty,omitempty"`
-							MaxQty     string `json:"maxQty,omitempty"`
-							StepSize   string `json:"stepSize,omitempty"`
+							FilterType     string `json:"filterType"`
+							MinPrice       string `json:"minPrice,omitempty"`
+							MaxPrice       string `json:"maxPrice,omitempty"`
+							TickSize       string `json:"tickSize,omitempty"`
+							MinQty         string `json:"minQty,omitempty"`
+							MaxQty         string `json:"maxQty,omitempty"`
+							StepSize       string `json:"stepSize,omitempty"`
+							Notional       string `json:"notional,omitempty"`
+							MultiplierUp   string `json:"multiplierUp,omitempty"`
+							MultiplierDown string `json:"multiplierDown,omitempty"`
 						}{}
 					}
 				} else {
@@ -2636,13 +2654,16 @@ func easyjsonDc809a28Decode2(in *jlexer.Lexer, out *struct {
 				}
 				for !in.IsDelim(']') {
 					var v34 struct {
-						FilterType string `json:"filterType"`
-						MinPrice   string `json:"minPrice,omitempty"`
-						MaxPrice   string `json:"maxPrice,omitempty"`
-						TickSize   string `json:"tickSize,omitempty"`
-						MinQty     string `json:"minQty,omitempty"`
-						MaxQty     string `json:"maxQty,omitempty"`
-						StepSize   string `json:"stepSize,omitempty"`
+						FilterType     string `json:"filterType"`
+						MinPrice       string `json:"minPrice,omitempty"`
+						MaxPrice       string `json:"maxPrice,omitempty"`
+						TickSize       string `json:"tickSize,omitempty"`
+						MinQty         string `json:"minQty,omitempty"`
+						MaxQty         string `json:"maxQty,omitempty"`
+						StepSize       string `json:"stepSize,omitempty"`
+						Notional       string `json:"notional,omitempty"`
+						MultiplierUp   string `json:"multiplierUp,omitempty"`
+						MultiplierDown string `json:"multiplierDown,omitempty"`
 					}
 					easyjsonDc809a28Decode3(in, &v34)
 					out.Filters = append(out.Filters, v34)
@@ -2691,13 +2712,16 @@ func easyjsonDc809a28Encode2(out *jwriter.Writer, in struct {
 	PricePrecision    int    `json:"pricePrecision"`
 	QuantityPrecision int    `json:"quantityPrecision"`
 	Filters           []struct {
-		FilterType string `json:"filterType"`
-		MinPrice   string `json:"minPrice,omitempty"`
-		MaxPrice   string `json:"maxPrice,omitempty"`
-		TickSize   string `json:"tickSize,omitempty"`
-		MinQty     string `json:"minQty,omitempty"`
-		MaxQty     string `json:"maxQty,omitempty"`
-		StepSize   string `json:"stepSize,omitempty"`
+		FilterType     string `json:"filterType"`
+		MinPrice       string `json:"minPrice,omitempty"`
+		MaxPrice       string `json:"maxPrice,omitempty"`
+		TickSize       string `json:"tickSize,omitempty"`
+		MinQty         string `json:"minQty,omitempty"`
+		MaxQty         string `json:"maxQty,omitempty"`
+		StepSize       string `json:"stepSize,omitempty"`
+		Notional       string `json:"notional,omitempty"`
+		MultiplierUp   string `json:"multiplierUp,omitempty"`
+		MultiplierDown string `json:"multiplierDown,omitempty"`
 	} `json:"filters"`
 	OrderType []string `json:"OrderType"`
 }) {
@@ -2769,13 +2793,16 @@ func easyjsonDc809a28Encode2(out *jwriter.Writer, in struct {
 	out.RawByte('}')
 }
 func easyjsonDc809a28Decode3(in *jlexer.Lexer, out *struct {
-	FilterType string `json:"filterType"`
-	MinPrice   string `json:"minPrice,omitempty"`
-	MaxPrice   string `json:"maxPrice,omitempty"`
-	TickSize   string `json:"tickSize,omitempty"`
-	MinQty     string `json:"minQty,omitempty"`
-	MaxQty     string `json:"maxQty,omitempty"`
-	StepSize   string `json:"stepSize,omitempty"`
+	FilterType     string `json:"filterType"`
+	MinPrice       string `json:"minPrice,omitempty"`
+	MaxPrice       string `json:"maxPrice,omitempty"`
+	TickSize       string `json:"tickSize,omitempty"`
+	MinQty         string `json:"minQty,omitempty"`
+	MaxQty         string `json:"maxQty,omitempty"`
+	StepSize       string `json:"stepSize,omitempty"`
+	Notional       string `json:"notional,omitempty"`
+	MultiplierUp   string `json:"multiplierUp,omitempty"`
+	MultiplierDown string `json:"multiplierDown,omitempty"`
 }) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
@@ -2809,6 +2836,12 @@ func easyjsonDc809a28Decode3(in *jlexer.Lexer, out *struct {
 			out.MaxQty = string(in.String())
 		case "stepSize":
 			out.StepSize = string(in.String())
+		case "notional":
+			out.Notional = string(in.String())
+		case "multiplierUp":
+			out.MultiplierUp = string(in.String())
+		case "multiplierDown":
+			out.MultiplierDown = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2820,13 +2853,16 @@ func easyjsonDc809a28Decode3(in *jlexer.Lexer, out *struct {
 	}
 }
 func easyjsonDc809a28Encode3(out *jwriter.Writer, in struct {
-	FilterType string `json:"filterType"`
-	MinPrice   string `json:"minPrice,omitempty"`
-	MaxPrice   string `json:"maxPrice,omitempty"`
-	TickSize   string `json:"tickSize,omitempty"`
-	MinQty     string `json:"minQty,omitempty"`
-	MaxQty     string `json:"maxQty,omitempty"`
-	StepSize   string `json:"stepSize,omitempty"`
+	FilterType     string `json:"filterType"`
+	MinPrice       string `json:"minPrice,omitempty"`
+	MaxPrice       string `json:"maxPrice,omitempty"`
+	TickSize       string `json:"tickSize,omitempty"`
+	MinQty         string `json:"minQty,omitempty"`
+	MaxQty         string `json:"maxQty,omitempty"`
+	StepSize       string `json:"stepSize,omitempty"`
+	Notional       string `json:"notional,omitempty"`
+	MultiplierUp   string `json:"multiplierUp,omitempty"`
+	MultiplierDown string `json:"multiplierDown,omitempty"`
 }) {
 	out.RawByte('{')
 	first := true
@@ -2866,6 +2902,21 @@ func easyjsonDc809a28Encode3(out *jwriter.Writer, in struct {
 		out.RawString(prefix)
 		out.String(string(in.StepSize))
 	}
+	if in.Notional != "" {
+		const prefix string = ",\"notional\":"
+		out.RawString(prefix)
+		out.String(string(in.Notional))
+	}
+	if in.MultiplierUp != "" {
+		const prefix string = ",\"multiplierUp\":"
+		out.RawString(prefix)
+		out.String(string(in.MultiplierUp))
+	}
+	if in.MultiplierDown != "" {
+		const prefix string = ",\"multiplierDown\":"
+		out.RawString(prefix)
+		out.String(string(in.MultiplierDown))
+	}
 	out.RawByte('}')
 }
 func easyjsonDc809a28Decode1(in *jlexer.Lexer, out *struct {
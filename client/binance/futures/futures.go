@@ -2,6 +2,7 @@ package binance
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -24,6 +25,11 @@ type SetLeverageResponse struct {
 
 // makeRestRequest makes an authenticated REST API request to Binance Futures
 func (b *BinanceClient) makeRestRequest(method, endpoint string, params map[string]interface{}) ([]byte, error) {
+	return b.makeRestRequestCtx(context.Background(), method, endpoint, params)
+}
+
+// makeRestRequestCtx is makeRestRequest bound to ctx
+func (b *BinanceClient) makeRestRequestCtx(ctx context.Context, method, endpoint string, params map[string]interface{}) ([]byte, error) {
 	// Add timestamp and recvWindow
 	params["timestamp"] = time.Now().UnixMilli()
 	params["recvWindow"] = 5000
@@ -47,7 +53,7 @@ func (b *BinanceClient) makeRestRequest(method, endpoint string, params map[stri
 
 	fullURL := b.baseURL + endpoint
 	if method == "POST" {
-		req, err = http.NewRequest("POST", fullURL, bytes.NewBufferString(values.Encode()))
+		req, err = http.NewRequestWithContext(ctx, "POST", fullURL, bytes.NewBufferString(values.Encode()))
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
@@ -55,7 +61,7 @@ func (b *BinanceClient) makeRestRequest(method, endpoint string, params map[stri
 	} else {
 		// For GET and other methods, parameters go in query string
 		fullURL += "?" + values.Encode()
-		req, err = http.NewRequest(method, fullURL, nil)
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, nil)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create request: %w", err)
 		}
@@ -64,6 +70,14 @@ func (b *BinanceClient) makeRestRequest(method, endpoint string, params map[stri
 	// Add headers
 	req.Header.Set("X-MBX-APIKEY", b.apiKey)
 
+	// REST shares the IP weight with the ws-api
+	limiter := b.RateLimiter()
+	if limiter != nil {
+		if err := limiter.Wait(req.Context(), rateCosts(endpoint, params)...); err != nil {
+			return nil, fmt.Errorf("%w: %w", core.ErrRequestNotSent, err)
+		}
+	}
+
 	// Make request
 	client := &http.Client{Timeout: 30 * time.Second}
 	resp, err := client.Do(req)
@@ -71,6 +85,9 @@ func (b *BinanceClient) makeRestRequest(method, endpoint string, params map[stri
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
 	defer resp.Body.Close()
+	if limiter != nil {
+		limiter.ObserveHeader(resp.Header)
+	}
 
 	// Read response
 	body, err := io.ReadAll(resp.Body)
@@ -80,6 +97,13 @@ func (b *BinanceClient) makeRestRequest(method, endpoint string, params map[stri
 
 	// Check for API errors
 	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Code int64  `json:"code"`
+			Msg  string `json:"msg"`
+		}
+		if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Code != 0 {
+			return nil, wrapStatusCode(int64(resp.StatusCode), apiErr.Code, apiErr.Msg)
+		}
 		return nil, fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
 	}
 
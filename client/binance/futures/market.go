@@ -4,19 +4,51 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
 	"strings"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
 	"github.com/thoas/go-funk"
 )
 
+// FetchQuotes implements core.PublicClient with the book tickers of all symbols, which come
+// in one request and are filtered
 func (b *BinanceClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
-	return make(map[string]core.Quote), fmt.Errorf("not implemented yet")
+	root, err := b.SendRequest(map[string]interface{}{
+		"id":     nextWSID(),
+		"method": "ticker.book",
+	})
+	if err != nil {
+		return nil, err
+	}
+	var arr wsTickerArr
+	if err := arr.UnmarshalJSON(root["result"]); err != nil {
+		return nil, err
+	}
+	want := make(map[string]bool, len(symbols))
+	for _, symbol := range symbols {
+		want[symbol] = true
+	}
+	now := time.Now()
+	quotes := make(map[string]core.Quote)
+	for _, obj := range arr {
+		if len(symbols) > 0 && !want[obj.Symbol] {
+			continue
+		}
+		quotes[obj.Symbol] = core.Quote{
+			Symbol:   obj.Symbol,
+			BidPrice: core.ParseStringDecimal(obj.BidPrice),
+			BidQty:   core.ParseStringDecimal(obj.BidQty),
+			AskPrice: core.ParseStringDecimal(obj.AskPrice),
+			AskQty:   core.ParseStringDecimal(obj.AskQty),
+			Time:     now,
+		}
+	}
+	return quotes, core.MissingSymbols("binance", symbols, quotes)
 }
 
 func (b *BinanceClient) GetTicker(symbol string) (*core.Ticker, error) {
@@ -37,10 +69,10 @@ func (b *BinanceClient) GetTicker(symbol string) (*core.Ticker, error) {
 	}
 	return &core.Ticker{
 		Symbol:   obj.Symbol,
-		BidPrice: core.ParseStringFloat(obj.BidPrice),
-		BidQty:   core.ParseStringFloat(obj.BidQty),
-		AskPrice: core.ParseStringFloat(obj.AskPrice),
-		AskQty:   core.ParseStringFloat(obj.AskQty),
+		BidPrice: core.ParseStringDecimal(obj.BidPrice),
+		BidQty:   core.ParseStringDecimal(obj.BidQty),
+		AskPrice: core.ParseStringDecimal(obj.AskPrice),
+		AskQty:   core.ParseStringDecimal(obj.AskQty),
 		Time:     time.Now(),
 	}, nil
 }
@@ -65,10 +97,10 @@ func (b *BinanceClient) GetTickers(symbols []string) ([]core.Ticker, error) {
 		if funk.ContainsString(symbols, obj.Symbol) {
 			tickers = append(tickers, core.Ticker{
 				Symbol:   obj.Symbol,
-				BidPrice: core.ParseStringFloat(obj.BidPrice),
-				BidQty:   core.ParseStringFloat(obj.BidQty),
-				AskPrice: core.ParseStringFloat(obj.AskPrice),
-				AskQty:   core.ParseStringFloat(obj.AskQty),
+				BidPrice: core.ParseStringDecimal(obj.BidPrice),
+				BidQty:   core.ParseStringDecimal(obj.BidQty),
+				AskPrice: core.ParseStringDecimal(obj.AskPrice),
+				AskQty:   core.ParseStringDecimal(obj.AskQty),
 				Time:     time.Now(),
 			})
 		}
@@ -76,68 +108,115 @@ func (b *BinanceClient) GetTickers(symbols []string) ([]core.Ticker, error) {
 	return tickers, nil
 }
 
-func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
-	resp, err := http.Get("https://fapi.binance.com/fapi/v1/exchangeInfo")
+// ticker24h is an entry of the rolling 24h ticker statistics, futures give no top of book
+type ticker24h struct {
+	Symbol             string `json:"symbol"`
+	PriceChangePercent string `json:"priceChangePercent"`
+	LastPrice          string `json:"lastPrice"`
+	OpenPrice          string `json:"openPrice"`
+	HighPrice          string `json:"highPrice"`
+	LowPrice           string `json:"lowPrice"`
+	Volume             string `json:"volume"`
+	QuoteVolume        string `json:"quoteVolume"`
+	CloseTime          int64  `json:"closeTime"`
+}
+
+// FetchTickers implements core.PublicClient with the 24h ticker of the rest api, the ws
+// api has none. All symbols come in one request and are filtered.
+func (b *BinanceClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	limiter := b.RateLimiter()
+	if limiter != nil {
+		if err := limiter.Wait(context.Background(), rateCosts("/fapi/v1/ticker/24hr", nil)...); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := restHTTPClient.Get(b.baseURL + "/fapi/v1/ticker/24hr")
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
-
-	var data WsfapiExchangeInfo
-	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
-		return nil, err
+	if limiter != nil {
+		limiter.ObserveHeader(resp.Header)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("24hr ticker: unexpected status %d", resp.StatusCode)
+	}
+	var rows []ticker24h
+	if err := json.NewDecoder(resp.Body).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("failed to decode tickers: %w", err)
 	}
+	tickers := make([]core.Ticker, len(rows))
+	for i, t := range rows {
+		tickers[i] = core.Ticker{
+			Symbol:        t.Symbol,
+			LastPrice:     core.ParseStringDecimal(t.LastPrice),
+			OpenPrice:     core.ParseStringDecimal(t.OpenPrice),
+			HighPrice:     core.ParseStringDecimal(t.HighPrice),
+			LowPrice:      core.ParseStringDecimal(t.LowPrice),
+			Volume:        core.ParseStringDecimal(t.Volume),
+			QuoteVolume:   core.ParseStringDecimal(t.QuoteVolume),
+			ChangePercent: core.ParseStringDecimal(t.PriceChangePercent),
+			Time:          time.UnixMilli(t.CloseTime),
+		}
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
 
+// initRules sets up the rule cache that orders are rounded and validated against
+func (b *BinanceClient) initRules() {
+	// exchangeInfo lists every symbol, the quote of a symbol is not known before
+	b.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		return b.fetchMarketRules(nil)
+	}, core.DefaultRuleTTL)
+}
+
+func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
 	// Create set of allowed quote suffixes
 	quoteSet := make(map[string]struct{}, len(quotes))
 	for _, q := range quotes {
 		quoteSet[q] = struct{}{}
 	}
+	return b.fetchMarketRules(func(symbol, base, quote string) bool {
+		_, ok := quoteSet[quote]
+		return ok && symbol == base+quote
+	})
+}
+
+// fetchMarketRules returns the rules of the trading symbols keep accepts, of all of them
+// when keep is nil
+func (b *BinanceClient) fetchMarketRules(keep func(symbol, base, quote string) bool) ([]core.MarketRule, error) {
+	resp, err := restHTTPClient.Get(b.baseURL + "/fapi/v1/exchangeInfo")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var data WsfapiExchangeInfo
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
 
 	// Build rate limits
 	var rateLimits []core.RateLimit
 	for _, rl := range data.RateLimits {
-		var cat core.RateLimitCategory
-		switch rl.RateLimitType {
-		case "REQUEST_WEIGHT":
-			cat = core.RateLimitRequest
-		case "ORDERS":
-			cat = core.RateLimitOrder
-		case "RAW_REQUEST", "CONNECTIONS":
-			cat = core.RateLimitConnection
-		}
-		var interval time.Duration
-		switch rl.Interval {
-		case "SECOND":
-			interval = time.Second * time.Duration(rl.IntervalNum)
-		case "MINUTE":
-			interval = time.Minute * time.Duration(rl.IntervalNum)
-		case "DAY":
-			interval = 24 * time.Hour * time.Duration(rl.IntervalNum)
-		}
 		rateLimits = append(rateLimits, core.RateLimit{
-			Category: cat,
-			Interval: interval,
+			Category: rateLimitCategory(rl.RateLimitType),
+			Interval: rateLimitInterval(rl.Interval, rl.IntervalNum),
 			Limit:    int64(rl.Limit),
 			Count:    0,
 		})
 	}
+	if limiter := b.RateLimiter(); limiter != nil && len(rateLimits) > 0 {
+		limiter.SetLimits(rateLimits)
+	}
 
-	// Filter symbols ending with allowed quotes
 	var mktRules []core.MarketRule
 	for _, s := range data.Symbols {
 		if s.Status != "TRADING" {
 			continue
 		}
 
-		keep := false
-		for q := range quoteSet {
-			if s.QuoteAsset == q && s.Symbol == s.BaseAsset+q {
-				keep = true
-				break
-			}
-		}
-		if !keep {
+		if keep != nil && !keep(s.Symbol, s.BaseAsset, s.QuoteAsset) {
 			continue
 		}
 
@@ -145,6 +224,9 @@ func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, er
 			minPrice, maxPrice float64
 			minQty, maxQty     float64
 			tickSize, stepSize decimal.Decimal
+			minNotional        decimal.Decimal
+			marketMaxQty       decimal.Decimal
+			priceBand          core.PriceBand
 		)
 		for _, f := range s.Filters {
 			switch f.FilterType {
@@ -156,6 +238,16 @@ func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, er
 				minQty = core.ParseStringFloat(f.MinQty)
 				maxQty = core.ParseStringFloat(f.MaxQty)
 				stepSize = decimal.RequireFromString(f.StepSize)
+			case "MIN_NOTIONAL":
+				minNotional = core.ParseStringDecimal(f.Notional)
+			case "MARKET_LOT_SIZE":
+				marketMaxQty = core.ParseStringDecimal(f.MaxQty)
+			case "PERCENT_PRICE":
+				// around the mark price, for both sides
+				priceBand = core.PriceBand{
+					Up:   core.ParseStringDecimal(f.MultiplierUp),
+					Down: core.ParseStringDecimal(f.MultiplierDown),
+				}
 			}
 		}
 
@@ -171,6 +263,10 @@ func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, er
 			MaxQty:         decimal.NewFromFloat(maxQty),
 			TickSize:       tickSize,
 			StepSize:       stepSize,
+			MinNotional:    minNotional,
+			MarketMaxQty:   marketMaxQty,
+			BuyPriceBand:   priceBand,
+			SellPriceBand:  priceBand,
 			RateLimits:     rateLimits,
 		})
 	}
@@ -240,78 +336,156 @@ func (b *BinanceClient) GetOrderbook(symbol string, depth int64) (*core.Orderboo
 
 func (b *BinanceClient) SubscribeQuotes(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]chan core.Quote, error) {
 	quoteChMap := make(map[string]chan core.Quote)
+	for _, symbol := range symbols {
+		quoteChMap[symbol] = make(chan core.Quote, 1)
+	}
 
 	// Build the combined stream URL for Binance futures
 	streams := make([]string, len(symbols))
 	for i, sym := range symbols {
 		streams[i] = strings.ToLower(sym) + "@bookTicker"
 	}
-	streamURL := fmt.Sprintf("wss://fstream.binance.com/stream?streams=%s", strings.Join(streams, "/"))
+	streamURL := fmt.Sprintf("%s/stream?streams=%s", b.streamURL, strings.Join(streams, "/"))
 
-	ws, _, err := websocket.DefaultDialer.Dial(streamURL, nil)
-	if err != nil {
-		return quoteChMap, err
-	}
-
-	ws.SetPingHandler(func(pingData string) error {
-		return ws.WriteControl(
-			websocket.PongMessage,
-			[]byte(pingData),
-			time.Now().Add(10*time.Second),
-		)
-	})
-	for _, symbol := range symbols {
-		quoteChMap[symbol] = make(chan core.Quote, 1)
-	}
-
-	go func() {
-		defer func() {
-			// Close all channels when context is cancelled
-			for _, ch := range quoteChMap {
-				close(ch)
+	// streams are part of the URL, so nothing to replay on reconnect
+	stream := core.NewStream(streamURL, nil, func(msg []byte) {
+		// Parse combined stream message format
+		var combinedMsg struct {
+			Stream string         `json:"stream"`
+			Data   wsTickerStream `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &combinedMsg); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
 			}
-			ws.Close()
-		}()
-		for {
+			return
+		}
+
+		if ch, exists := quoteChMap[combinedMsg.Data.Symbol]; exists {
 			select {
-			case <-ctx.Done():
-				ws.Close()
-				return
+			case ch <- core.Quote{
+				Symbol:   combinedMsg.Data.Symbol,
+				BidPrice: decimal.RequireFromString(combinedMsg.Data.BestBidPrice),
+				BidQty:   decimal.RequireFromString(combinedMsg.Data.BestBidQty),
+				AskPrice: decimal.RequireFromString(combinedMsg.Data.BestAskPrice),
+				AskQty:   decimal.RequireFromString(combinedMsg.Data.BestAskQty),
+				Time:     time.UnixMilli(combinedMsg.Data.EventTime),
+			}:
 			default:
+				// Channel is full, skip this update
 			}
-			_, msg, err := ws.ReadMessage()
-			if err != nil && errHandler != nil {
-				errHandler(fmt.Errorf("WebSocket service error: %w", err))
-				continue
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return make(map[string]chan core.Quote), err
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range quoteChMap {
+			close(ch)
+		}
+	}()
+	return quoteChMap, nil
+}
+
+// SubscribeOrderbook implements core.PublicClient interface
+// Local books are built from a REST depth snapshot and kept in sync with the @depth diff stream
+func (b *BinanceClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	obChMap := make(map[string]<-chan core.Orderbook)
+	syncers := make(map[string]*core.BookSyncer)
+	chans := make(map[string]chan core.Orderbook)
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		ch := make(chan core.Orderbook, 1)
+		chans[symbol] = ch
+		obChMap[symbol] = ch
+		syncers[symbol] = core.NewBookSyncer(core.NewLocalOrderbook(symbol, depth), b.depthSnapshotFn(symbol),
+			func(ob core.Orderbook) { core.SendOrderbook(ch, ob) }, errHandler)
+		streams[i] = strings.ToLower(symbol) + "@depth@100ms"
+	}
+	streamURL := fmt.Sprintf("%s/stream?streams=%s", b.streamURL, strings.Join(streams, "/"))
+
+	stream := core.NewStream(streamURL, nil, func(msg []byte) {
+		var combinedMsg struct {
+			Stream string        `json:"stream"`
+			Data   wsDepthStream `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &combinedMsg); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
 			}
+			return
+		}
+		ev := combinedMsg.Data
+		if syncer, exists := syncers[ev.Symbol]; exists {
+			// futures diffs chain through pu (previous final update id)
+			syncer.Diff(ev.PrevUpdateID+1, ev.FinalUpdateID,
+				core.ParseOrderbookLevels(ev.Bids), core.ParseOrderbookLevels(ev.Asks))
+		}
+	}, errHandler)
+	// diffs were missed while disconnected, resync from a new snapshot
+	stream.OnReconnect = func() {
+		for _, syncer := range syncers {
+			syncer.Reset()
+		}
+	}
+	if err := stream.Start(ctx); err != nil {
+		return obChMap, err
+	}
+
+	go func() {
+		<-stream.Done()
+		for symbol, ch := range chans {
+			syncers[symbol].Close()
+			close(ch)
+		}
+	}()
+	return obChMap, nil
+}
 
-			// Parse combined stream message format
-			var combinedMsg struct {
-				Stream string          `json:"stream"`
-				Data   wsTickerStream  `json:"data"`
+// restHTTPClient sends the public REST requests of the market data
+var restHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// depthSnapshotFn returns the REST depth snapshot of symbol a local book is synced from, it
+// is read from mainnet like the diff stream
+func (b *BinanceClient) depthSnapshotFn(symbol string) core.SnapshotFunc {
+	return func() (int64, []core.OrderbookEntry, []core.OrderbookEntry, error) {
+		// REST shares the IP weight with the ws-api
+		limiter := b.RateLimiter()
+		if limiter != nil {
+			if err := limiter.Wait(context.Background(), core.RateCost{Category: core.RateLimitRequest, Weight: depthWeight(1000)}); err != nil {
+				return 0, nil, nil, err
 			}
-			if err := json.Unmarshal(msg, &combinedMsg); err != nil {
-				if errHandler != nil {
-					errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
-				}
-				continue
+		}
+		resp, err := restHTTPClient.Get(fmt.Sprintf("%s/fapi/v1/depth?symbol=%s&limit=1000", b.baseURL, symbol))
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to fetch depth snapshot: %w", err)
+		}
+		defer resp.Body.Close()
+		if limiter != nil {
+			limiter.ObserveHeader(resp.Header)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to read depth snapshot: %w", err)
+		}
+		// a rate limited or failed request has no book, it must not become an empty one
+		if resp.StatusCode != http.StatusOK {
+			var apiErr struct {
+				Code int64  `json:"code"`
+				Msg  string `json:"msg"`
 			}
-
-			if ch, exists := quoteChMap[combinedMsg.Data.Symbol]; exists {
-				select {
-				case ch <- core.Quote{
-					Symbol:   combinedMsg.Data.Symbol,
-					BidPrice: decimal.RequireFromString(combinedMsg.Data.BestBidPrice),
-					BidQty:   decimal.RequireFromString(combinedMsg.Data.BestBidQty),
-					AskPrice: decimal.RequireFromString(combinedMsg.Data.BestAskPrice),
-					AskQty:   decimal.RequireFromString(combinedMsg.Data.BestAskQty),
-					Time:     time.UnixMilli(combinedMsg.Data.EventTime),
-				}:
-				default:
-					// Channel is full, skip this update
-				}
+			json.Unmarshal(body, &apiErr)
+			if err := wrapStatusCode(int64(resp.StatusCode), apiErr.Code, apiErr.Msg); err != nil {
+				return 0, nil, nil, err
 			}
+			return 0, nil, nil, fmt.Errorf("depth snapshot: unexpected status %d", resp.StatusCode)
 		}
-	}()
-	return quoteChMap, nil
+		var snap depthSnapshot
+		if err := json.Unmarshal(body, &snap); err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to decode depth snapshot: %w", err)
+		}
+		return snap.LastUpdateID, core.ParseOrderbookLevels(snap.Bids), core.ParseOrderbookLevels(snap.Asks), nil
+	}
 }
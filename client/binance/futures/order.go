@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -21,6 +22,9 @@ type OrderOptions struct {
 	NewOrderRespType        string
 	StopPrice               decimal.Decimal
 	TrailingDelta           int
+	CallbackRate            decimal.Decimal // trailing percent, takes precedence over TrailingDelta
+	ActivationPrice         decimal.Decimal
+	WorkingType             string // CONTRACT_PRICE or MARK_PRICE
 	IcebergQty              decimal.Decimal
 	StrategyId              int64
 	StrategyType            int
@@ -105,14 +109,207 @@ func (b *BinanceClient) TakeProfitLimitSell(symbol string, quantity, targetPrice
 	return b.placeOrder(symbol, "SELL", "TAKE_PROFIT", opts)
 }
 
+// PlaceOrder implements core.PrivateClient. Futures orders are sized in base, a quote
+// quantity is not supported. Post-only orders are placed with GTX.
+func (b *BinanceClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := b.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	opts, err := requestOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.placeOrderCtx(ctx, req.Symbol, string(req.Side), string(req.Type), opts)
+	if err != nil {
+		return core.ResolvePlacement(b, req, err)
+	}
+	return resp, nil
+}
+
+// requestOptions maps a prepared request to the order options
+func requestOptions(req core.OrderRequest) (*OrderOptions, error) {
+	if !req.QuoteQuantity.IsZero() {
+		return nil, core.ErrUnsupportedOrder("binance futures", "quote quantity")
+	}
+	opts := &OrderOptions{
+		Quantity:                req.Quantity,
+		Price:                   req.Price,
+		ReduceOnly:              req.ReduceOnly,
+		NewClientOrderId:        req.ClientOrderID,
+		SelfTradePreventionMode: string(req.STPMode),
+	}
+	if req.Type == core.OrderTypeLimit {
+		opts.TimeInForce = string(req.TimeInForce)
+		if req.PostOnly {
+			opts.TimeInForce = "GTX"
+		}
+	}
+	return opts, nil
+}
+
+// batch sizes of the batchOrders endpoints
+const (
+	placeBatchSize  = 5
+	cancelBatchSize = 10
+)
+
+// PlaceOrders implements core.PrivateClient with the REST batchOrders endpoint
+func (b *BinanceClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return b.rules.PlaceBatches(ctx, reqs, placeBatchSize, b.placeBatch)
+}
+
+func (b *BinanceClient) placeBatch(ctx context.Context, reqs []core.OrderRequest) []core.OrderResult {
+	results := make([]core.OrderResult, len(reqs))
+	var sent []core.OrderRequest
+	var index []int
+	var items []map[string]string
+	for i, req := range reqs {
+		opts, err := requestOptions(req)
+		if err == nil {
+			var params map[string]interface{}
+			params, err = b.orderParams(req.Symbol, string(req.Side), string(req.Type), opts)
+			if err == nil {
+				// batch items take every value as a string
+				item := make(map[string]string, len(params))
+				for k, v := range params {
+					item[k] = fmt.Sprint(v)
+				}
+				items = append(items, item)
+			}
+		}
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		sent = append(sent, req)
+		index = append(index, i)
+	}
+	if len(items) == 0 {
+		return results
+	}
+	batch, _ := json.Marshal(items)
+	body, err := b.makeRestRequestCtx(ctx, "POST", "/fapi/v1/batchOrders", map[string]interface{}{"batchOrders": string(batch)})
+	var batchResults []core.OrderResult
+	if err == nil {
+		batchResults, err = b.parseBatchResults(body, len(sent))
+	}
+	if err != nil {
+		batchResults = core.ResolveBatch(b, sent, err)
+	}
+	for j, res := range batchResults {
+		results[index[j]] = res
+	}
+	return results
+}
+
+// CancelOrders implements core.PrivateClient with the REST batchOrders endpoint
+func (b *BinanceClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelBatches(ctx, orderIds, cancelBatchSize, func(ctx context.Context, orderIds []string) []core.OrderResult {
+		results := make([]core.OrderResult, len(orderIds))
+		var ids []int64
+		var index []int
+		for i, orderId := range orderIds {
+			id, err := strconv.ParseInt(orderId, 10, 64)
+			if err != nil {
+				results[i].Err = fmt.Errorf("invalid order id %q: %w", orderId, err)
+				continue
+			}
+			ids = append(ids, id)
+			index = append(index, i)
+		}
+		if len(ids) == 0 {
+			return results
+		}
+		idList, _ := json.Marshal(ids)
+		body, err := b.makeRestRequestCtx(ctx, "DELETE", "/fapi/v1/batchOrders", map[string]interface{}{
+			"symbol":      symbol,
+			"orderIdList": string(idList),
+		})
+		var batchResults []core.OrderResult
+		if err == nil {
+			batchResults, err = b.parseBatchResults(body, len(ids))
+		}
+		if err != nil {
+			batchResults = core.FailBatch(len(ids), err)
+		}
+		for j, res := range batchResults {
+			if res.Order != nil {
+				b.ordersMu.Lock()
+				delete(b.orders, res.Order.OrderID)
+				b.ordersMu.Unlock()
+			}
+			results[index[j]] = res
+		}
+		return results
+	})
+}
+
+// parseBatchResults parses a batchOrders response, one order or error object per item
+func (b *BinanceClient) parseBatchResults(body []byte, n int) ([]core.OrderResult, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode batch response: %w", err)
+	}
+	if len(items) != n {
+		return nil, fmt.Errorf("batch response has %d items for %d orders", len(items), n)
+	}
+	results := make([]core.OrderResult, n)
+	for i, item := range items {
+		var apiErr struct {
+			Code int64  `json:"code"`
+			Msg  string `json:"msg"`
+		}
+		if err := json.Unmarshal(item, &apiErr); err == nil && apiErr.Code != 0 {
+			results[i].Err = wrapStatusCode(400, apiErr.Code, apiErr.Msg)
+			continue
+		}
+		var ord WsOrderResult
+		if err := ord.UnmarshalJSON(item); err != nil {
+			results[i].Err = fmt.Errorf("failed to decode batch order: %w", err)
+			continue
+		}
+		results[i].Order = b.orderResponse(ord)
+	}
+	return results, nil
+}
+
 // --- ORDER CORE LOGIC ---
 
 func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOptions) (*core.OrderResponse, error) {
+	return b.placeOrderCtx(context.Background(), symbol, side, orderType, opt)
+}
+
+func (b *BinanceClient) placeOrderCtx(ctx context.Context, symbol, side, orderType string, opt *OrderOptions) (*core.OrderResponse, error) {
+	params, err := b.orderParams(symbol, side, orderType, opt)
+	if err != nil {
+		return nil, err
+	}
+	params["timestamp"] = time.Now().UnixMilli()
+	id := nextWSID()
+	req := map[string]interface{}{
+		"id":     id,
+		"method": "order.place",
+		"params": params,
+	}
+	root, err := b.SendRequestCtx(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	var wsResp WsOrderResponse
+	rootByte, _ := json.Marshal(root)
+	if err := wsResp.UnmarshalJSON(rootByte); err != nil {
+		return nil, err
+	}
+	return b.orderResponse(wsResp.Result), nil
+}
+
+// orderParams builds the params of an order, without the timestamp
+func (b *BinanceClient) orderParams(symbol, side, orderType string, opt *OrderOptions) (map[string]interface{}, error) {
 	if err := checkMandatory(orderType, opt); err != nil {
 		return nil, err
 	}
 	params := map[string]interface{}{
-		"symbol": symbol, "side": side, "type": orderType, "timestamp": time.Now().UnixMilli(),
+		"symbol": symbol, "side": side, "type": orderType,
 	}
 	if !opt.Quantity.IsZero() {
 		params["quantity"] = opt.Quantity.String()
@@ -141,6 +338,15 @@ func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOpt
 	if opt.TrailingDelta != 0 {
 		params["callbackRate"] = opt.TrailingDelta
 	}
+	if !opt.CallbackRate.IsZero() {
+		params["callbackRate"] = opt.CallbackRate.String()
+	}
+	if !opt.ActivationPrice.IsZero() {
+		params["activationPrice"] = opt.ActivationPrice.String()
+	}
+	if opt.WorkingType != "" {
+		params["workingType"] = opt.WorkingType
+	}
 	if !opt.IcebergQty.IsZero() {
 		params["icebergQty"] = opt.IcebergQty.String()
 	}
@@ -156,23 +362,11 @@ func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOpt
 	if side == "BUY" && b.hedgeMode {
 		params["reduceOnly"] = true
 	}
-	id := nextWSID()
-	req := map[string]interface{}{
-		"id":     id,
-		"method": "order.place",
-		"params": params,
-	}
-	root, err := b.SendRequest(req)
-	if err != nil {
-		return nil, err
-	}
-	var wsResp WsOrderResponse
-	rootByte, _ := json.Marshal(root)
-	if err := wsResp.UnmarshalJSON(rootByte); err != nil {
-		return nil, err
-	}
-	ord := wsResp.Result
+	return params, nil
+}
 
+// orderResponse converts a placed order and tracks it for CancelAll
+func (b *BinanceClient) orderResponse(ord WsOrderResult) *core.OrderResponse {
 	// Convert string side to core.OrderSide
 	var orderSide core.OrderSide
 	if ord.Side == "BUY" {
@@ -182,13 +376,15 @@ func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOpt
 	}
 
 	resp := &core.OrderResponse{
-		OrderID:    strconv.FormatInt(ord.OrderID, 10),
-		Symbol:     ord.Symbol,
-		Side:       orderSide,
-		Tif:        core.TimeInForce(ord.TimeInForce),
-		Status:     parseOrderStatus(ord.Status),
-		Price:      decimal.RequireFromString(ord.Price),
-		CreateTime: time.UnixMilli(ord.TransactTime),
+		OrderID:       strconv.FormatInt(ord.OrderID, 10),
+		ClientOrderID: ord.ClientOrderID,
+		Symbol:        ord.Symbol,
+		Side:          orderSide,
+		Tif:           core.TimeInForce(ord.TimeInForce),
+		Status:        parseOrderStatus(ord.Status),
+		Reason:        statusReason(ord.Status),
+		Price:         decimal.RequireFromString(ord.Price),
+		CreateTime:    time.UnixMilli(ord.TransactTime),
 	}
 	if ord.OrigQuoteQty != "" {
 		resp.IsQuoteQuantity = true
@@ -200,7 +396,7 @@ func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOpt
 	b.ordersMu.Lock()
 	b.orders[resp.OrderID] = resp
 	b.ordersMu.Unlock()
-	return resp, nil
+	return resp
 }
 
 func checkMandatory(orderType string, opt *OrderOptions) error {
@@ -230,8 +426,8 @@ func checkMandatory(orderType string, opt *OrderOptions) error {
 			return fmt.Errorf("TAKE_PROFIT_LIMIT order requires TimeInForce, Price, Quantity, StopPrice/TrailingDelta")
 		}
 	case "TRAILING_STOP_MARKET":
-		if opt.Quantity.IsZero() || opt.StopPrice.IsZero() || opt.TrailingDelta == 0 {
-			return fmt.Errorf("TRAILING_STOP_MARKET order requires Quantity, StopPrice/TrailingDelta")
+		if opt.Quantity.IsZero() || (opt.TrailingDelta == 0 && opt.CallbackRate.IsZero()) {
+			return fmt.Errorf("TRAILING_STOP_MARKET order requires Quantity, TrailingDelta/CallbackRate")
 		}
 	default:
 		return fmt.Errorf("unsupported orderType: %s", orderType)
@@ -244,22 +440,31 @@ func parseOrderStatus(s string) core.OrderStatus {
 	case "NEW":
 		return core.OrderStatusOpen
 	case "PARTIALLY_FILLED":
-		return core.OrderStatusOpen
+		return core.OrderStatusPartiallyFilled
 	case "FILLED":
 		return core.OrderStatusFilled
 	case "CANCELED":
 		return core.OrderStatusCanceled
 	case "PENDING_CANCEL":
-		return core.OrderStatusOpen
+		return core.OrderStatusPendingCancel
 	case "REJECTED":
-		return core.OrderStatusError
+		return core.OrderStatusRejected
 	case "EXPIRED", "EXPIRED_IN_MATCH":
-		return core.OrderStatusError
+		return core.OrderStatusExpired
 	default:
 		return core.OrderStatusError
 	}
 }
 
+// statusReason returns the reason a status carries, EXPIRED_IN_MATCH is an expiry by
+// self-trade prevention
+func statusReason(s string) string {
+	if s == "EXPIRED_IN_MATCH" {
+		return s
+	}
+	return ""
+}
+
 func toOrderResponse(ord wsOrderTradeUpdate) *core.OrderResponse {
 	// Convert string side to core.OrderSide
 	var side core.OrderSide
@@ -274,6 +479,7 @@ func toOrderResponse(ord wsOrderTradeUpdate) *core.OrderResponse {
 		Symbol:     ord.Symbol,
 		Side:       side,
 		Status:     parseOrderStatus(ord.Status),
+		Reason:     statusReason(ord.Status),
 		Price:      decimal.RequireFromString(ord.Price),
 		Quantity:   decimal.RequireFromString(ord.OrigQty),
 		CreateTime: time.UnixMilli(ord.EventTime),
@@ -330,6 +536,7 @@ func (b *BinanceClient) CancelOrder(symbol, orderId string) (*core.OrderResponse
 		Side:       orderSide,
 		Tif:        core.TimeInForce(ord.TimeInForce),
 		Status:     parseOrderStatus(ord.Status),
+		Reason:     statusReason(ord.Status),
 		Price:      decimal.RequireFromString(ord.Price),
 		CreateTime: time.UnixMilli(ord.TransactTime),
 	}
@@ -349,7 +556,15 @@ func (b *BinanceClient) CancelOrder(symbol, orderId string) (*core.OrderResponse
 
 func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseFull, error) {
 	orderIdInt, _ := strconv.ParseInt(orderId, 10, 64)
-	params := map[string]interface{}{"symbol": symbol, "orderId": orderIdInt, "timestamp": time.Now().UnixMilli()}
+	return b.fetchOrder(map[string]interface{}{"symbol": symbol, "orderId": orderIdInt, "timestamp": time.Now().UnixMilli()})
+}
+
+// FetchOrderByClientID implements core.PrivateClient
+func (b *BinanceClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	return b.fetchOrder(map[string]interface{}{"symbol": symbol, "origClientOrderId": clientOrderID, "timestamp": time.Now().UnixMilli()})
+}
+
+func (b *BinanceClient) fetchOrder(params map[string]interface{}) (*core.OrderResponseFull, error) {
 	id := nextWSID()
 	req := map[string]interface{}{
 		"id":     id,
@@ -365,7 +580,13 @@ func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseF
 	if err := wsResp.UnmarshalJSON(rootByte); err != nil {
 		return nil, err
 	}
-	ord := wsResp.Result
+	resp := orderFull(wsResp.Result)
+	return &resp, nil
+}
+
+// orderFull converts an order of the order queries, commissions are estimated at the
+// taker rate
+func orderFull(ord WsOrderResult) core.OrderResponseFull {
 	price, _ := decimal.NewFromString(ord.Price)
 	avgPrice, _ := decimal.NewFromString(ord.AvgPrice)
 	execQty, _ := decimal.NewFromString(ord.ExecutedQty)
@@ -381,21 +602,27 @@ func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseF
 		commAsset = strings.TrimSuffix(ord.Symbol, "USDT")
 	}
 
-	resp := &core.OrderResponseFull{
+	updateTime := time.Now()
+	if ord.UpdateTime != 0 {
+		updateTime = time.UnixMilli(ord.UpdateTime)
+	}
+	resp := core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
-			OrderID:    strconv.FormatInt(ord.OrderID, 10),
-			Symbol:     ord.Symbol,
-			Side:       orderSide,
-			Tif:        core.TimeInForce(ord.TimeInForce),
-			Status:     parseOrderStatus(ord.Status),
-			Price:      price,
-			CreateTime: time.UnixMilli(ord.TransactTime),
+			OrderID:       strconv.FormatInt(ord.OrderID, 10),
+			ClientOrderID: ord.ClientOrderID,
+			Symbol:        ord.Symbol,
+			Side:          orderSide,
+			Tif:           core.TimeInForce(ord.TimeInForce),
+			Status:        parseOrderStatus(ord.Status),
+			Reason:        statusReason(ord.Status),
+			Price:         price,
+			CreateTime:    time.UnixMilli(ord.TransactTime),
 		},
 		AvgPrice:        avgPrice,
 		ExecutedQty:     execQty,
 		Commission:      execQty.Mul(decimal.NewFromFloat(commisionRate)),
 		CommissionAsset: commAsset,
-		UpdateTime:      time.Now(),
+		UpdateTime:      updateTime,
 	}
 	if ord.OrigQuoteQty != "" {
 		resp.IsQuoteQuantity = true
@@ -404,7 +631,149 @@ func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseF
 		resp.IsQuoteQuantity = false
 		resp.Quantity = decimal.RequireFromString(ord.OrigQty)
 	}
-	return resp, nil
+	return resp
+}
+
+// allOrdersLimit and allOrdersWindow bound one allOrders request
+const (
+	allOrdersLimit  = 1000
+	allOrdersWindow = 7 * 24 * time.Hour
+)
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all symbols
+func (b *BinanceClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	params := map[string]interface{}{}
+	if symbol != "" {
+		params["symbol"] = symbol
+	}
+	orders, err := b.listOrders("/fapi/v1/openOrders", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open orders: %w", err)
+	}
+	core.SortOrders(orders)
+	return orders, nil
+}
+
+// FetchOrderHistory implements core.PrivateClient with allOrders, which keeps 90 days
+func (b *BinanceClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, allOrdersWindow, allOrdersLimit, func(start, end time.Time, limit int) ([]core.OrderResponseFull, error) {
+		orders, err := b.listOrders("/fapi/v1/allOrders", map[string]interface{}{
+			"symbol":    symbol,
+			"startTime": start.UnixMilli(),
+			"endTime":   end.UnixMilli(),
+			"limit":     limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch order history: %w", err)
+		}
+		return orders, nil
+	})
+}
+
+// userTradesLimit and userTradesWindow bound one userTrades request
+const (
+	userTradesLimit  = 1000
+	userTradesWindow = 7 * 24 * time.Hour
+)
+
+// FetchFills implements core.PrivateClient with userTrades, which keeps six months
+func (b *BinanceClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, userTradesWindow, userTradesLimit, func(start, end time.Time, limit int) ([]core.OrderFill, error) {
+		fills, err := b.userTrades(map[string]interface{}{
+			"symbol":    symbol,
+			"startTime": start.UnixMilli(),
+			"endTime":   end.UnixMilli(),
+			"limit":     limit,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch fills: %w", err)
+		}
+		return fills, nil
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (b *BinanceClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := b.userTrades(map[string]interface{}{
+		"symbol":  symbol,
+		"orderId": orderId,
+		"limit":   userTradesLimit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order fills: %w", err)
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+func (b *BinanceClient) userTrades(params map[string]interface{}) ([]core.OrderFill, error) {
+	body, err := b.makeRestRequest("GET", "/fapi/v1/userTrades", params)
+	if err != nil {
+		return nil, err
+	}
+	var trades []struct {
+		Symbol          string `json:"symbol"`
+		ID              int64  `json:"id"`
+		OrderID         int64  `json:"orderId"`
+		Side            string `json:"side"`
+		Price           string `json:"price"`
+		Qty             string `json:"qty"`
+		Commission      string `json:"commission"`
+		CommissionAsset string `json:"commissionAsset"`
+		Time            int64  `json:"time"`
+		Buyer           bool   `json:"buyer"`
+		Maker           bool   `json:"maker"`
+	}
+	if err := json.Unmarshal(body, &trades); err != nil {
+		return nil, fmt.Errorf("failed to decode trades: %w", err)
+	}
+	fills := make([]core.OrderFill, len(trades))
+	for i, trade := range trades {
+		price, _ := decimal.NewFromString(trade.Price)
+		qty, _ := decimal.NewFromString(trade.Qty)
+		fee, _ := decimal.NewFromString(trade.Commission)
+		fills[i] = core.OrderFill{
+			TradeID:   strconv.FormatInt(trade.ID, 10),
+			OrderID:   strconv.FormatInt(trade.OrderID, 10),
+			Symbol:    trade.Symbol,
+			Side:      core.OrderSide(trade.Side),
+			Price:     price,
+			Quantity:  qty,
+			Fee:       fee,
+			FeeAsset:  trade.CommissionAsset,
+			IsMaker:   trade.Maker,
+			TradeTime: time.UnixMilli(trade.Time),
+			IsBuyer:   trade.Buyer,
+		}
+	}
+	return fills, nil
+}
+
+// listOrders fetches an order listing endpoint, whose orders carry their creation time
+// as time
+func (b *BinanceClient) listOrders(endpoint string, params map[string]interface{}) ([]core.OrderResponseFull, error) {
+	body, err := b.makeRestRequest("GET", endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(body, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode orders: %w", err)
+	}
+	orders := make([]core.OrderResponseFull, len(items))
+	for i, item := range items {
+		var ord WsOrderResult
+		var created struct {
+			Time int64 `json:"time"`
+		}
+		if err := ord.UnmarshalJSON(item); err != nil {
+			return nil, fmt.Errorf("failed to decode order: %w", err)
+		}
+		_ = json.Unmarshal(item, &created)
+		orders[i] = orderFull(ord)
+		orders[i].CreateTime = time.UnixMilli(created.Time)
+	}
+	return orders, nil
 }
 
 func (b *BinanceClient) ModifyBuyPrice(symbol, orderId string, quantity, price decimal.Decimal) (*core.OrderResponse, error) {
@@ -454,6 +823,7 @@ func (b *BinanceClient) modifyOrderPrice(positionSide string, symbol, orderId st
 		Side:       orderSide,
 		Tif:        core.TimeInForce(ord.TimeInForce),
 		Status:     parseOrderStatus(ord.Status),
+		Reason:     statusReason(ord.Status),
 		Price:      decimal.RequireFromString(ord.Price),
 		CreateTime: time.UnixMilli(ord.TransactTime),
 	}
@@ -470,3 +840,30 @@ func (b *BinanceClient) modifyOrderPrice(positionSide string, symbol, orderId st
 	b.ordersMu.Unlock()
 	return resp, nil
 }
+
+// AmendOrder implements core.PrivateClient with order.modify, binance requeues every
+// modified order so queue priority is never kept
+func (b *BinanceClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	if err := core.ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	// order.modify needs the side of the order
+	b.ordersMu.RLock()
+	cached, ok := b.orders[orderId]
+	b.ordersMu.RUnlock()
+	var side core.OrderSide
+	if ok {
+		side = cached.Side
+	} else {
+		ord, err := b.FetchOrder(symbol, orderId)
+		if err != nil {
+			return nil, err
+		}
+		side = ord.Side
+	}
+	resp, err := b.modifyOrderPrice(string(side), symbol, orderId, newQty, newPrice)
+	if err != nil {
+		return nil, err
+	}
+	return &core.AmendResult{Order: resp, OriginalOrderID: orderId}, nil
+}
@@ -0,0 +1,130 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// defaultRateLimits are the USDⓈ-M futures limits until FetchMarketRules loads the ones from exchangeInfo
+var defaultRateLimits = []core.RateLimit{
+	{Category: core.RateLimitRequest, Interval: time.Minute, Limit: 2400},
+	{Category: core.RateLimitOrder, Interval: time.Minute, Limit: 1200},
+	{Category: core.RateLimitOrder, Interval: 10 * time.Second, Limit: 300},
+}
+
+// requestWeights are the REQUEST_WEIGHT costs of ws-api methods and REST endpoints other than 1
+var requestWeights = map[string]int64{
+	"order.place":           0,
+	"order.modify":          1,
+	"ticker.book":           2,
+	"v2/account.status":     5,
+	"/fapi/v3/positionRisk": 5,
+	"/fapi/v1/batchOrders":  5,
+	"/fapi/v1/allOrders":    5,
+	"/fapi/v1/userTrades":   5,
+	"/fapi/v1/ticker/24hr":  40, // all symbols
+}
+
+// orderMethods also count against the ORDERS limits
+var orderMethods = map[string]bool{
+	"order.place":  true,
+	"order.modify": true,
+}
+
+func rateCostFn() core.WsRequestCostFunc {
+	return func(req map[string]interface{}) []core.RateCost {
+		method, _ := req["method"].(string)
+		return rateCosts(method, req["params"])
+	}
+}
+
+// rateCosts returns the weights of a ws-api method or REST endpoint
+func rateCosts(method string, params interface{}) []core.RateCost {
+	weight, ok := requestWeights[method]
+	if !ok {
+		weight = 1
+	}
+	switch method {
+	case "depth":
+		p, _ := params.(map[string]interface{})
+		limit, _ := p["limit"].(int64)
+		weight = depthWeight(limit)
+	case "ticker.book":
+		if p, _ := params.(map[string]interface{}); p["symbol"] == nil {
+			weight = 5
+		}
+	case "/fapi/v1/openOrders":
+		if p, _ := params.(map[string]interface{}); p["symbol"] == nil {
+			weight = 40
+		}
+	}
+	costs := []core.RateCost{{Category: core.RateLimitRequest, Weight: weight}}
+	if orderMethods[method] {
+		costs = append(costs, core.RateCost{Category: core.RateLimitOrder, Weight: 1})
+	}
+	return costs
+}
+
+// depthWeight is the weight of a depth request by its limit
+func depthWeight(limit int64) int64 {
+	switch {
+	case limit <= 50:
+		return 2
+	case limit <= 100:
+		return 5
+	case limit <= 500:
+		return 10
+	default:
+		return 20
+	}
+}
+
+// rateUsageFn syncs the limiter with the rateLimits counters and retryAfter of ws-api responses
+func rateUsageFn() core.WsRateUsageFunc {
+	return func(root map[string]json.RawMessage, limiter *core.RateLimiter) {
+		if raw, ok := root["rateLimits"]; ok {
+			var usage []wsRateLimitWithCount
+			if err := json.Unmarshal(raw, &usage); err == nil {
+				for _, rl := range usage {
+					limiter.Observe(rateLimitCategory(rl.RateLimitType), rateLimitInterval(rl.Interval, rl.IntervalNum), int64(rl.Count))
+				}
+			}
+		}
+		if raw, ok := root["error"]; ok {
+			var errObj struct {
+				Data struct {
+					RetryAfter int64 `json:"retryAfter"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &errObj); err == nil && errObj.Data.RetryAfter > 0 {
+				limiter.Pause(time.UnixMilli(errObj.Data.RetryAfter))
+			}
+		}
+	}
+}
+
+func rateLimitCategory(rateLimitType string) core.RateLimitCategory {
+	switch rateLimitType {
+	case "REQUEST_WEIGHT":
+		return core.RateLimitRequest
+	case "ORDERS":
+		return core.RateLimitOrder
+	case "RAW_REQUEST", "CONNECTIONS":
+		return core.RateLimitConnection
+	}
+	return ""
+}
+
+func rateLimitInterval(interval string, num int) time.Duration {
+	switch interval {
+	case "SECOND":
+		return time.Second * time.Duration(num)
+	case "MINUTE":
+		return time.Minute * time.Duration(num)
+	case "DAY":
+		return 24 * time.Hour * time.Duration(num)
+	}
+	return 0
+}
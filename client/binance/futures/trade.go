@@ -0,0 +1,70 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// SubscribeTrades implements core.PublicClient with the @aggTrade stream, futures have no
+// raw trade stream so TradeID is the aggregate trade id and fills of one taker order at
+// the same price are merged
+func (b *BinanceClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	tradeChMap := make(map[string]<-chan core.Trade)
+	chans := make(map[string]chan core.Trade)
+	streams := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Trade, 256)
+		tradeChMap[symbol] = chans[symbol]
+		streams[i] = strings.ToLower(symbol) + "@aggTrade"
+	}
+	streamURL := fmt.Sprintf("%s/stream?streams=%s", b.streamURL, strings.Join(streams, "/"))
+
+	// streams are part of the URL, so nothing to replay on reconnect
+	stream := core.NewStream(streamURL, nil, func(msg []byte) {
+		var combinedMsg struct {
+			Stream string           `json:"stream"`
+			Data   wsAggTradeStream `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &combinedMsg); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
+			}
+			return
+		}
+		ev := combinedMsg.Data
+		ch, exists := chans[ev.Symbol]
+		if !exists {
+			return
+		}
+		// the buyer being the maker means the seller took liquidity
+		side := core.OrderSideBuy
+		if ev.IsBuyerMaker {
+			side = core.OrderSideSell
+		}
+		core.SendTrade(ctx, ch, core.Trade{
+			Symbol:   ev.Symbol,
+			TradeID:  strconv.FormatInt(ev.AggTradeID, 10),
+			Price:    core.ParseStringDecimal(ev.Price),
+			Quantity: core.ParseStringDecimal(ev.Quantity),
+			Side:     side,
+			Time:     time.UnixMilli(ev.TradeTime),
+		})
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return tradeChMap, err
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+	return tradeChMap, nil
+}
@@ -428,23 +428,6 @@ func (uds *BinanceUserDataStream) handleOrderUpdate(msg []byte) {
 
 	order := orderUpdate.Order
 
-	// Convert status
-	var status core.OrderStatus
-	switch order.OrderStatus {
-	case "NEW":
-		status = core.OrderStatusOpen
-	case "FILLED":
-		status = core.OrderStatusFilled
-	case "CANCELED":
-		status = core.OrderStatusCanceled
-	case "REJECTED", "EXPIRED":
-		status = core.OrderStatusError
-	case "PARTIALLY_FILLED":
-		status = core.OrderStatusOpen
-	default:
-		status = core.OrderStatusOpen
-	}
-
 	// Parse decimal values
 	price, _ := decimal.NewFromString(order.OriginalPrice)
 	quantity, _ := decimal.NewFromString(order.OriginalQty)
@@ -452,6 +435,16 @@ func (uds *BinanceUserDataStream) handleOrderUpdate(msg []byte) {
 	avgPrice, _ := decimal.NewFromString(order.AveragePrice)
 	commission, _ := decimal.NewFromString(order.CommissionAmount)
 
+	// the last fill is only set on trade reports, n is the commission of that fill
+	var lastQty, lastPrice, lastCommission decimal.Decimal
+	tradeID := ""
+	if order.ExecutionType == "TRADE" {
+		lastQty, _ = decimal.NewFromString(order.LastFilledQty)
+		lastPrice, _ = decimal.NewFromString(order.LastFilledPrice)
+		lastCommission = commission
+		tradeID = fmt.Sprintf("%d", order.TradeID)
+	}
+
 	// Convert string side to core.OrderSide
 	var side core.OrderSide
 	if order.Side == "BUY" {
@@ -462,10 +455,12 @@ func (uds *BinanceUserDataStream) handleOrderUpdate(msg []byte) {
 
 	orderEvent := core.OrderEvent{
 		OrderID:         fmt.Sprintf("%d", order.OrderID),
+		ClientOrderID:   order.ClientOrderID,
 		Symbol:          order.Symbol,
 		Side:            side,
 		OrderType:       order.OrderType,
-		Status:          status,
+		Status:          parseOrderStatus(order.OrderStatus),
+		Reason:          statusReason(order.OrderStatus),
 		Price:           price,
 		Quantity:        quantity,
 		ExecutedQty:     executedQty,
@@ -473,8 +468,11 @@ func (uds *BinanceUserDataStream) handleOrderUpdate(msg []byte) {
 		Commission:      commission,
 		CommissionAsset: order.CommissionAsset,
 		UpdateTime:      time.Unix(0, orderUpdate.EventTime*int64(time.Millisecond)),
-		TradeID:         fmt.Sprintf("%d", order.TradeID),
+		TradeID:         tradeID,
 		IsMaker:         order.IsMaker,
+		LastQty:         lastQty,
+		LastPrice:       lastPrice,
+		LastCommission:  lastCommission,
 	}
 
 	select {
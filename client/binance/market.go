@@ -4,10 +4,12 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"slices"
 	"strings"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
 )
@@ -20,8 +22,59 @@ func (b *BinanceClient) GetTicker(symbol string) (*core.Ticker, error) {
 	return &tckSlc[0], nil
 }
 
+// FetchQuotes implements core.PublicClient with the book ticker of the ws api. Binance
+// rejects a request naming an unlisted symbol as a whole, it is then answered from the book
+// tickers of all symbols and the unlisted ones are reported in a core.SymbolErrors.
 func (b *BinanceClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
-	return make(map[string]core.Quote), fmt.Errorf("not implemented yet")
+	quotes, err := b.bookTickers(symbols)
+	if len(symbols) > 0 && core.ErrorCategoryOf(err) == core.ErrCategoryInvalidSymbol {
+		all, allErr := b.bookTickers(nil)
+		if allErr != nil {
+			return nil, allErr
+		}
+		quotes, err = make(map[string]core.Quote), nil
+		for _, symbol := range symbols {
+			if quote, ok := all[symbol]; ok {
+				quotes[symbol] = quote
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return quotes, core.MissingSymbols("binance", symbols, quotes)
+}
+
+// bookTickers fetches the book tickers of symbols, of all symbols when it is empty
+func (b *BinanceClient) bookTickers(symbols []string) (map[string]core.Quote, error) {
+	req := map[string]interface{}{
+		"id":     nextWSID(),
+		"method": "ticker.book",
+	}
+	if len(symbols) > 0 {
+		req["params"] = map[string]interface{}{"symbols": symbols}
+	}
+	root, err := b.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	var arr wsTickerArr
+	if err := arr.UnmarshalJSON(root["result"]); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	quotes := make(map[string]core.Quote, len(arr))
+	for _, obj := range arr {
+		quotes[obj.Symbol] = core.Quote{
+			Symbol:   obj.Symbol,
+			BidPrice: core.ParseStringDecimal(obj.BidPrice),
+			BidQty:   core.ParseStringDecimal(obj.BidQty),
+			AskPrice: core.ParseStringDecimal(obj.AskPrice),
+			AskQty:   core.ParseStringDecimal(obj.AskQty),
+			Time:     now,
+		}
+	}
+	return quotes, nil
 }
 
 func (b *BinanceClient) GetTickers(symbols []string) ([]core.Ticker, error) {
@@ -44,26 +97,99 @@ func (b *BinanceClient) GetTickers(symbols []string) ([]core.Ticker, error) {
 	for _, obj := range arr {
 		tickers = append(tickers, core.Ticker{
 			Symbol:   obj.Symbol,
-			BidPrice: core.ParseStringFloat(obj.BidPrice),
-			BidQty:   core.ParseStringFloat(obj.BidQty),
-			AskPrice: core.ParseStringFloat(obj.AskPrice),
-			AskQty:   core.ParseStringFloat(obj.AskQty),
+			BidPrice: core.ParseStringDecimal(obj.BidPrice),
+			BidQty:   core.ParseStringDecimal(obj.BidQty),
+			AskPrice: core.ParseStringDecimal(obj.AskPrice),
+			AskQty:   core.ParseStringDecimal(obj.AskQty),
 			Time:     time.Now(),
 		})
 	}
 	return tickers, nil
 }
 
+// ticker24h is an entry of the rolling 24h ticker statistics
+type ticker24h struct {
+	Symbol             string `json:"symbol"`
+	PriceChangePercent string `json:"priceChangePercent"`
+	LastPrice          string `json:"lastPrice"`
+	BidPrice           string `json:"bidPrice"`
+	BidQty             string `json:"bidQty"`
+	AskPrice           string `json:"askPrice"`
+	AskQty             string `json:"askQty"`
+	OpenPrice          string `json:"openPrice"`
+	HighPrice          string `json:"highPrice"`
+	LowPrice           string `json:"lowPrice"`
+	Volume             string `json:"volume"`
+	QuoteVolume        string `json:"quoteVolume"`
+	CloseTime          int64  `json:"closeTime"`
+}
+
+func (t ticker24h) ticker() core.Ticker {
+	return core.Ticker{
+		Symbol:        t.Symbol,
+		BidPrice:      core.ParseStringDecimal(t.BidPrice),
+		BidQty:        core.ParseStringDecimal(t.BidQty),
+		AskPrice:      core.ParseStringDecimal(t.AskPrice),
+		AskQty:        core.ParseStringDecimal(t.AskQty),
+		LastPrice:     core.ParseStringDecimal(t.LastPrice),
+		OpenPrice:     core.ParseStringDecimal(t.OpenPrice),
+		HighPrice:     core.ParseStringDecimal(t.HighPrice),
+		LowPrice:      core.ParseStringDecimal(t.LowPrice),
+		Volume:        core.ParseStringDecimal(t.Volume),
+		QuoteVolume:   core.ParseStringDecimal(t.QuoteVolume),
+		ChangePercent: core.ParseStringDecimal(t.PriceChangePercent),
+		Time:          time.UnixMilli(t.CloseTime),
+	}
+}
+
+// FetchTickers implements core.PublicClient with the 24h ticker of the ws api, which
+// lists every symbol when none are given
+func (b *BinanceClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	req := map[string]interface{}{
+		"id":     nextWSID(),
+		"method": "ticker.24hr",
+	}
+	if len(symbols) > 0 {
+		req["params"] = map[string]interface{}{"symbols": symbols}
+	}
+	root, err := b.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	var rows []ticker24h
+	if err := json.Unmarshal(root["result"], &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode tickers: %w", err)
+	}
+	tickers := make([]core.Ticker, len(rows))
+	for i, row := range rows {
+		tickers[i] = row.ticker()
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// initRules sets up the rule cache that orders are rounded and validated against
+func (b *BinanceClient) initRules() {
+	// exchangeInfo of the symbol alone, its quote is not known before
+	b.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		return b.fetchMarketRules(map[string]interface{}{"symbol": symbol}, nil)
+	}, core.DefaultRuleTTL)
+}
+
 func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
+	return b.fetchMarketRules(map[string]interface{}{
+		"permissions":  []string{"SPOT"},
+		"symbolStatus": "TRADING",
+	}, func(quote string) bool { return slices.Contains(quotes, quote) })
+}
+
+// fetchMarketRules returns the rules of the exchangeInfo symbols params selects whose quote
+// keep accepts, of all of them when keep is nil
+func (b *BinanceClient) fetchMarketRules(params map[string]interface{}, keep func(quote string) bool) ([]core.MarketRule, error) {
 	id := nextWSID()
 	req := map[string]interface{}{
 		"id":     id,
 		"method": "exchangeInfo",
-		"params": map[string]interface{}{
-			"permissions":  []string{"SPOT"},
-			"symbolStatus": "TRADING",
-		},
-		// No "params" here; get all symbols
+		"params": params,
 	}
 
 	root, err := b.SendRequest(req)
@@ -78,57 +204,71 @@ func (b *BinanceClient) FetchMarketRules(quotes []string) ([]core.MarketRule, er
 	}
 	var rateLimit []core.RateLimit
 	for _, rlObj := range wsRes.RateLimits {
-		var category core.RateLimitCategory
-		var interval time.Duration
-		switch rlObj.RateLimitType {
-		case "REQUEST_WEIGHT":
-			category = core.RateLimitRequest
-		case "ORDERS":
-			category = core.RateLimitOrder
-		case "CONNECTIONS":
-			category = core.RateLimitConnection
-		}
-		switch rlObj.Interval {
-		case "SECOND":
-			interval = time.Second * time.Duration(rlObj.IntervalNum)
-		case "MINUTE":
-			interval = time.Minute * time.Duration(rlObj.IntervalNum)
-		case "DAY":
-			interval = time.Hour * 24 * time.Duration(rlObj.IntervalNum)
-		}
 		rateLimit = append(rateLimit, core.RateLimit{
-			Category: category,
-			Interval: interval,
+			Category: rateLimitCategory(rlObj.RateLimitType),
+			Interval: rateLimitInterval(rlObj.Interval, rlObj.IntervalNum),
 			Limit:    int64(rlObj.Limit),
 			Count:    0,
 		})
 	}
+	if limiter := b.RateLimiter(); limiter != nil && len(rateLimit) > 0 {
+		limiter.SetLimits(rateLimit)
+	}
 
-	// Only keep symbols ending with any quote in quotes
 	for _, obj := range wsRes.Symbols {
-		for _, quote := range quotes {
-			if obj.QuoteAsset == quote {
-				mktRules = append(mktRules, core.MarketRule{
-					Symbol:         obj.Symbol,
-					BaseAsset:      obj.BaseAsset,
-					QuoteAsset:     obj.QuoteAsset,
-					PricePrecision: int64(obj.QuoteAssetPrecision),
-					QtyPrecision:   int64(obj.BaseAssetPrecision),
-					MinPrice:       decimal.RequireFromString(obj.Filters[0].MinPrice),
-					MaxPrice:       decimal.RequireFromString(obj.Filters[0].MaxPrice),
-					MinQty:         decimal.RequireFromString(obj.Filters[1].MinQty),
-					MaxQty:         decimal.RequireFromString(obj.Filters[1].MaxQty),
-					TickSize:       decimal.RequireFromString(obj.Filters[0].TickSize),
-					StepSize:       decimal.RequireFromString(obj.Filters[1].StepSize),
-					RateLimits:     rateLimit,
-				})
-				break // Found a matching quote, skip to next symbol
-			}
+		if keep != nil && !keep(obj.QuoteAsset) {
+			continue
+		}
+		rule := core.MarketRule{
+			Symbol:         obj.Symbol,
+			BaseAsset:      obj.BaseAsset,
+			QuoteAsset:     obj.QuoteAsset,
+			PricePrecision: int64(obj.QuoteAssetPrecision),
+			QtyPrecision:   int64(obj.BaseAssetPrecision),
+			MinPrice:       decimal.RequireFromString(obj.Filters[0].MinPrice),
+			MaxPrice:       decimal.RequireFromString(obj.Filters[0].MaxPrice),
+			MinQty:         decimal.RequireFromString(obj.Filters[1].MinQty),
+			MaxQty:         decimal.RequireFromString(obj.Filters[1].MaxQty),
+			TickSize:       decimal.RequireFromString(obj.Filters[0].TickSize),
+			StepSize:       decimal.RequireFromString(obj.Filters[1].StepSize),
+			RateLimits:     rateLimit,
 		}
+		setOrderFilters(&rule, obj.Filters)
+		mktRules = append(mktRules, rule)
 	}
 	return mktRules, nil
 }
 
+// setOrderFilters sets the notional, market lot size and percent price filters on rule
+func setOrderFilters(rule *core.MarketRule, filters []wsFilter) {
+	for _, f := range filters {
+		switch f.FilterType {
+		case "MIN_NOTIONAL":
+			rule.MinNotional = core.ParseStringDecimal(f.MinNotional)
+		case "NOTIONAL":
+			rule.MinNotional = core.ParseStringDecimal(f.MinNotional)
+			rule.MaxNotional = core.ParseStringDecimal(f.MaxNotional)
+		case "MARKET_LOT_SIZE":
+			rule.MarketMaxQty = core.ParseStringDecimal(f.MaxQty)
+		case "PERCENT_PRICE":
+			band := core.PriceBand{
+				Up:   core.ParseStringDecimal(f.MultiplierUp),
+				Down: core.ParseStringDecimal(f.MultiplierDown),
+			}
+			rule.BuyPriceBand, rule.SellPriceBand = band, band
+		case "PERCENT_PRICE_BY_SIDE":
+			rule.BuyPriceBand = core.PriceBand{
+				Up:   core.ParseStringDecimal(f.BidMultiplierUp),
+				Down: core.ParseStringDecimal(f.BidMultiplierDown),
+			}
+			rule.SellPriceBand = core.PriceBand{
+				Up:   core.ParseStringDecimal(f.AskMultiplierUp),
+				Down: core.ParseStringDecimal(f.AskMultiplierDown),
+			}
+		}
+	}
+}
+
 func (b *BinanceClient) GetOrderbook(symbol string, depth int64) (*core.Orderbook, error) {
 	id := nextWSID()
 	req := map[string]interface{}{
@@ -186,90 +326,153 @@ func (b *BinanceClient) GetOrderbook(symbol string, depth int64) (*core.Orderboo
 }
 
 // SubscribeQuotes subscribes to real-time ticker updates via WebSocket for spot markets
+// Channels stay open across reconnects and are closed when ctx is done
 func (b *BinanceClient) SubscribeQuotes(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]chan core.Quote, error) {
 	quoteChMap := make(map[string]chan core.Quote)
-	ws, _, err := websocket.DefaultDialer.Dial("wss://stream.binance.com:9443/ws/", nil)
-	if err != nil {
-		return quoteChMap, err
+	for _, symbol := range symbols {
+		quoteChMap[symbol] = make(chan core.Quote, 1)
 	}
 
-	ws.SetPingHandler(func(pingData string) error {
-		return ws.WriteControl(
-			websocket.PongMessage,
-			[]byte(pingData),
-			time.Now().Add(10*time.Second),
-		)
-	})
-
 	params := make([]string, len(symbols))
 	for i, sym := range symbols {
 		params[i] = strings.ToLower(sym) + "@bookTicker"
 	}
 
-	req := wsSubscribeRequest{
-		Method: "SUBSCRIBE",
-		Params: params,
-		ID:     1,
-	}
-	err = ws.WriteJSON(req)
-	if err != nil {
-		return quoteChMap, err
+	stream := core.NewStream(b.streamURL, subscribeStreams(params), func(msg []byte) {
+		var res wsTickerStream
+		if err := res.UnmarshalJSON(msg); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
+			}
+			return
+		}
+		if ch, exists := quoteChMap[res.Symbol]; exists {
+			select {
+			case ch <- core.Quote{
+				Symbol:   res.Symbol,
+				BidPrice: decimal.RequireFromString(res.BestBidPrice),
+				BidQty:   decimal.RequireFromString(res.BestBidQty),
+				AskPrice: decimal.RequireFromString(res.BestAskPrice),
+				AskQty:   decimal.RequireFromString(res.BestAskQty),
+				Time:     time.UnixMilli(res.EventTime),
+			}:
+			default:
+				// Channel is full, skip this update
+			}
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return make(map[string]chan core.Quote), err
 	}
-	var res struct {
-		Result interface{} `json:"result"`
-		Id     int64       `json:"id"`
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range quoteChMap {
+			close(ch)
+		}
+	}()
+	return quoteChMap, nil
+}
+
+// subscribeStreams sends the SUBSCRIBE request for the given stream names
+func subscribeStreams(params []string) core.StreamSubscribeFunc {
+	return func(s *core.Stream) error {
+		return s.WriteJSON(wsSubscribeRequest{
+			Method: "SUBSCRIBE",
+			Params: params,
+			ID:     1,
+		})
 	}
-	_, msg, err := ws.ReadMessage()
-	if err != nil {
-		return quoteChMap, fmt.Errorf("[wsclient] WS read error: %v", err)
+}
+
+// SubscribeOrderbook implements core.PublicClient interface
+// Local books are built from a REST depth snapshot and kept in sync with the @depth diff stream
+func (b *BinanceClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	obChMap := make(map[string]<-chan core.Orderbook)
+	syncers := make(map[string]*core.BookSyncer)
+	chans := make(map[string]chan core.Orderbook)
+	params := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		ch := make(chan core.Orderbook, 1)
+		chans[symbol] = ch
+		obChMap[symbol] = ch
+		syncers[symbol] = core.NewBookSyncer(core.NewLocalOrderbook(symbol, depth), b.depthSnapshotFn(symbol),
+			func(ob core.Orderbook) { core.SendOrderbook(ch, ob) }, errHandler)
+		params[i] = strings.ToLower(symbol) + "@depth@100ms"
 	}
-	if err := json.Unmarshal(msg, &res); err != nil {
-		return quoteChMap, fmt.Errorf("[wsclient] Unmarshal error: %v %s", err, string(msg))
+
+	stream := core.NewStream(b.streamURL, subscribeStreams(params), func(msg []byte) {
+		var ev wsDepthStream
+		if err := json.Unmarshal(msg, &ev); err != nil || ev.EventType != "depthUpdate" {
+			return // subscription ack
+		}
+		if syncer, exists := syncers[ev.Symbol]; exists {
+			syncer.Diff(ev.FirstUpdateID, ev.FinalUpdateID,
+				core.ParseOrderbookLevels(ev.Bids), core.ParseOrderbookLevels(ev.Asks))
+		}
+	}, errHandler)
+	// diffs were missed while disconnected, resync from a new snapshot
+	stream.OnReconnect = func() {
+		for _, syncer := range syncers {
+			syncer.Reset()
+		}
 	}
-	for _, symbol := range symbols {
-		quoteChMap[symbol] = make(chan core.Quote, 1)
+	if err := stream.Start(ctx); err != nil {
+		return obChMap, err
 	}
 
 	go func() {
-		defer func() {
-			// Close all channels when context is cancelled
-			for _, ch := range quoteChMap {
-				close(ch)
-			}
-			ws.Close()
-		}()
-		for {
-			select {
-			case <-ctx.Done():
-				ws.Close()
-				return
-			default:
-			}
-			_, msg, err := ws.ReadMessage()
-			if err != nil && errHandler != nil {
-				errHandler(fmt.Errorf("WebSocket service error: %w", err))
+		<-stream.Done()
+		for symbol, ch := range chans {
+			syncers[symbol].Close()
+			close(ch)
+		}
+	}()
+	return obChMap, nil
+}
+
+// restHTTPClient sends the REST requests of the market data, the ws-api has no depth of
+// 1000 levels
+var restHTTPClient = &http.Client{Timeout: 10 * time.Second}
+
+// depthSnapshotFn returns the REST depth snapshot of symbol a local book is synced from
+func (b *BinanceClient) depthSnapshotFn(symbol string) core.SnapshotFunc {
+	return func() (int64, []core.OrderbookEntry, []core.OrderbookEntry, error) {
+		// REST shares the IP weight with the ws-api
+		limiter := b.RateLimiter()
+		if limiter != nil {
+			if err := limiter.Wait(context.Background(), core.RateCost{Category: core.RateLimitRequest, Weight: depthWeight(1000)}); err != nil {
+				return 0, nil, nil, err
 			}
-			var res wsTickerStream
-			if err := res.UnmarshalJSON(msg); err != nil {
-				if errHandler != nil {
-					errHandler(fmt.Errorf("WebSocket unmarshal error: %w", err))
-				}
+		}
+		resp, err := restHTTPClient.Get(fmt.Sprintf("%s/api/v3/depth?symbol=%s&limit=1000", b.restURL, symbol))
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to fetch depth snapshot: %w", err)
+		}
+		defer resp.Body.Close()
+		if limiter != nil {
+			limiter.ObserveHeader(resp.Header)
+		}
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to read depth snapshot: %w", err)
+		}
+		// a rate limited or failed request has no book, it must not become an empty one
+		if resp.StatusCode != http.StatusOK {
+			var apiErr struct {
+				Code int64  `json:"code"`
+				Msg  string `json:"msg"`
 			}
-			if ch, exists := quoteChMap[res.Symbol]; exists {
-				select {
-				case ch <- core.Quote{
-					Symbol:   res.Symbol,
-					BidPrice: decimal.RequireFromString(res.BestBidPrice),
-					BidQty:   decimal.RequireFromString(res.BestBidQty),
-					AskPrice: decimal.RequireFromString(res.BestAskPrice),
-					AskQty:   decimal.RequireFromString(res.BestAskQty),
-					Time:     time.UnixMilli(res.EventTime),
-				}:
-				default:
-					// Channel is full, skip this update
-				}
+			json.Unmarshal(body, &apiErr)
+			if err := wrapStatusCode(int64(resp.StatusCode), apiErr.Code, apiErr.Msg); err != nil {
+				return 0, nil, nil, err
 			}
+			return 0, nil, nil, fmt.Errorf("depth snapshot: unexpected status %d", resp.StatusCode)
 		}
-	}()
-	return quoteChMap, nil
+		var snap depthSnapshot
+		if err := json.Unmarshal(body, &snap); err != nil {
+			return 0, nil, nil, fmt.Errorf("failed to decode depth snapshot: %w", err)
+		}
+		return snap.LastUpdateID, core.ParseOrderbookLevels(snap.Bids), core.ParseOrderbookLevels(snap.Asks), nil
+	}
 }
@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -112,9 +113,53 @@ func (b *BinanceClient) TakeProfitLimitSell(symbol string, quantity, targetPrice
 	return b.placeOrder(symbol, "SELL", "TAKE_PROFIT_LIMIT", opts)
 }
 
+// PlaceOrder implements core.PrivateClient. Post-only orders are placed as LIMIT_MAKER.
+func (b *BinanceClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := b.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	opts := &OrderOptions{
+		Quantity:                req.Quantity,
+		QuoteOrderQty:           req.QuoteQuantity,
+		Price:                   req.Price,
+		NewClientOrderId:        req.ClientOrderID,
+		SelfTradePreventionMode: string(req.STPMode),
+	}
+	orderType := string(req.Type)
+	if req.Type == core.OrderTypeLimit {
+		if req.PostOnly {
+			orderType = "LIMIT_MAKER"
+		} else {
+			opts.TimeInForce = string(req.TimeInForce)
+		}
+	}
+	resp, err := b.placeOrderCtx(ctx, req.Symbol, string(req.Side), orderType, opts)
+	if err != nil {
+		return core.ResolvePlacement(b, req, err)
+	}
+	return resp, nil
+}
+
+// PlaceOrders implements core.PrivateClient, spot has no batch orders so they are placed
+// one by one
+func (b *BinanceClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return core.PlaceEach(ctx, reqs, b.PlaceOrder)
+}
+
+// CancelOrders implements core.PrivateClient, orders are cancelled one by one
+func (b *BinanceClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelEach(ctx, orderIds, func(orderId string) (*core.OrderResponse, error) {
+		return b.CancelOrder(symbol, orderId)
+	})
+}
+
 // --- ORDER INTERNAL API ---
 
 func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOptions) (*core.OrderResponse, error) {
+	return b.placeOrderCtx(context.Background(), symbol, side, orderType, opt)
+}
+
+func (b *BinanceClient) placeOrderCtx(ctx context.Context, symbol, side, orderType string, opt *OrderOptions) (*core.OrderResponse, error) {
 	params := map[string]interface{}{
 		"symbol":    symbol,
 		"side":      side,
@@ -164,7 +209,7 @@ func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOpt
 		"method": "order.place",
 		"params": params,
 	}
-	root, err := b.SendRequest(req)
+	root, err := b.SendRequestCtx(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -220,10 +265,12 @@ func (b *BinanceClient) placeOrder(symbol, side, orderType string, opt *OrderOpt
 
 	resp := &core.OrderResponse{
 		OrderID:         strconv.FormatInt(ord.OrderID, 10),
+		ClientOrderID:   ord.ClientOrderID,
 		Symbol:          ord.Symbol,
 		Side:            orderSide,
 		Tif:             core.TimeInForce(ord.TimeInForce),
 		Status:          parseOrderStatus(ord.Status),
+		Reason:          statusReason(ord.Status),
 		Price:           decimal.RequireFromString(ord.Price),
 		Quantity:        qty,
 		IsQuoteQuantity: isQuoteQty,
@@ -237,22 +284,31 @@ func parseOrderStatus(status string) core.OrderStatus {
 	case "NEW":
 		return core.OrderStatusOpen
 	case "PARTIALLY_FILLED":
-		return core.OrderStatusOpen
+		return core.OrderStatusPartiallyFilled
 	case "FILLED":
 		return core.OrderStatusFilled
 	case "CANCELED":
 		return core.OrderStatusCanceled
 	case "PENDING_CANCEL":
-		return core.OrderStatusOpen
+		return core.OrderStatusPendingCancel
 	case "REJECTED":
-		return core.OrderStatusError
+		return core.OrderStatusRejected
 	case "EXPIRED", "EXPIRED_IN_MATCH":
-		return core.OrderStatusError
+		return core.OrderStatusExpired
 	default:
 		return core.OrderStatusError
 	}
 }
 
+// statusReason returns the reason a status carries, EXPIRED_IN_MATCH is an expiry by
+// self-trade prevention
+func statusReason(status string) string {
+	if status == "EXPIRED_IN_MATCH" {
+		return status
+	}
+	return ""
+}
+
 func (b *BinanceClient) CancelAll(symbol string) error {
 	params := map[string]interface{}{"symbol": symbol, "timestamp": time.Now().UnixMilli()}
 	id := nextWSID()
@@ -307,6 +363,7 @@ func (b *BinanceClient) CancelOrder(symbol, orderId string) (*core.OrderResponse
 		Side:            orderSide,
 		Tif:             core.TimeInForce(ord.TimeInForce),
 		Status:          parseOrderStatus(ord.Status),
+		Reason:          statusReason(ord.Status),
 		Price:           decimal.RequireFromString(ord.Price),
 		Quantity:        qty,
 		IsQuoteQuantity: isQuoteQty,
@@ -315,8 +372,19 @@ func (b *BinanceClient) CancelOrder(symbol, orderId string) (*core.OrderResponse
 	return resp, nil
 }
 
+// FetchOrder implements core.PrivateClient, answered from the order store while it is fresh
 func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseFull, error) {
-	params := map[string]interface{}{"symbol": symbol, "orderId": orderId, "timestamp": time.Now().UnixMilli()}
+	return b.orders.FetchOrder(symbol, orderId)
+}
+
+// FetchOrderByClientID implements core.PrivateClient
+func (b *BinanceClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	return b.fetchOrder(symbol, "origClientOrderId", clientOrderID)
+}
+
+// fetchOrder queries an order by idParam, orderId or origClientOrderId
+func (b *BinanceClient) fetchOrder(symbol, idParam, orderId string) (*core.OrderResponseFull, error) {
+	params := map[string]interface{}{"symbol": symbol, idParam: orderId, "timestamp": time.Now().UnixMilli()}
 	id := nextWSID()
 	req := map[string]interface{}{
 		"id":     id,
@@ -361,10 +429,12 @@ func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseF
 	resp := &core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
 			OrderID:         strconv.FormatInt(ord.OrderID, 10),
+			ClientOrderID:   ord.ClientOrderID,
 			Symbol:          ord.Symbol,
 			Side:            orderSide,
 			Tif:             core.TimeInForce(ord.TimeInForce),
 			Status:          parseOrderStatus(ord.Status),
+			Reason:          statusReason(ord.Status),
 			Price:           decimal.RequireFromString(ord.Price),
 			Quantity:        qty,
 			IsQuoteQuantity: isQuoteQty,
@@ -379,50 +449,127 @@ func (b *BinanceClient) FetchOrder(symbol, orderId string) (*core.OrderResponseF
 	return resp, nil
 }
 
-// getOrderTradeHistory fetches trade history for a specific order and calculates average price
-func (b *BinanceClient) getOrderTradeHistory(symbol, orderId string) (avgPrice, executedQty, commission decimal.Decimal, commissionAsset string, updateTime time.Time, err error) {
-	params := map[string]interface{}{
-		"symbol":    symbol,
-		"orderId":   orderId,
-		"timestamp": time.Now().UnixMilli(),
+// allOrdersLimit and allOrdersWindow bound one allOrders request
+const (
+	allOrdersLimit  = 1000
+	allOrdersWindow = 24 * time.Hour
+)
+
+// queriedOrder is an order of the order query methods, which carry time and updateTime
+// instead of transactTime
+type queriedOrder struct {
+	Symbol              string `json:"symbol"`
+	OrderID             int64  `json:"orderId"`
+	ClientOrderID       string `json:"clientOrderId"`
+	Price               string `json:"price"`
+	OrigQty             string `json:"origQty"`
+	ExecutedQty         string `json:"executedQty"`
+	OrigQuoteOrderQty   string `json:"origQuoteOrderQty"`
+	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
+	Status              string `json:"status"`
+	TimeInForce         string `json:"timeInForce"`
+	Side                string `json:"side"`
+	Time                int64  `json:"time"`
+	UpdateTime          int64  `json:"updateTime"`
+}
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all symbols.
+// Commissions are not part of the listing and left zero.
+func (b *BinanceClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	params := map[string]interface{}{"timestamp": time.Now().UnixMilli()}
+	if symbol != "" {
+		params["symbol"] = symbol
 	}
-	id := nextWSID()
-	req := map[string]interface{}{
-		"id":     id,
-		"method": "myTrades",
-		"params": params,
+	orders, err := b.queryOrders("openOrders.status", params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open orders: %w", err)
 	}
+	core.SortOrders(orders)
+	return orders, nil
+}
 
-	root, err := b.SendRequest(req)
+// FetchOrderHistory implements core.PrivateClient with allOrders, commissions are left zero
+func (b *BinanceClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, allOrdersWindow, allOrdersLimit, func(start, end time.Time, limit int) ([]core.OrderResponseFull, error) {
+		orders, err := b.queryOrders("allOrders", map[string]interface{}{
+			"symbol":    symbol,
+			"startTime": start.UnixMilli(),
+			"endTime":   end.UnixMilli(),
+			"limit":     limit,
+			"timestamp": time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch order history: %w", err)
+		}
+		return orders, nil
+	})
+}
+
+// queryOrders sends an order listing request
+func (b *BinanceClient) queryOrders(method string, params map[string]interface{}) ([]core.OrderResponseFull, error) {
+	root, err := b.SendRequest(map[string]interface{}{
+		"id":     nextWSID(),
+		"method": method,
+		"params": params,
+	})
 	if err != nil {
-		return decimal.Zero, decimal.Zero, decimal.Zero, "", time.Time{}, err
+		return nil, err
 	}
-
-	// Parse the trade history response
 	var wsResp struct {
-		Result []struct {
-			Symbol          string `json:"symbol"`
-			ID              int64  `json:"id"`
-			OrderID         int64  `json:"orderId"`
-			OrderListID     int64  `json:"orderListId"`
-			Price           string `json:"price"`
-			Qty             string `json:"qty"`
-			QuoteQty        string `json:"quoteQty"`
-			Commission      string `json:"commission"`
-			CommissionAsset string `json:"commissionAsset"`
-			Time            int64  `json:"time"`
-			IsBuyer         bool   `json:"isBuyer"`
-			IsMaker         bool   `json:"isMaker"`
-			IsBestMatch     bool   `json:"isBestMatch"`
-		} `json:"result"`
+		Result []queriedOrder `json:"result"`
 	}
-
 	rootByte, _ := json.Marshal(root)
 	if err := json.Unmarshal(rootByte, &wsResp); err != nil {
-		return decimal.Zero, decimal.Zero, decimal.Zero, "", time.Time{}, err
+		return nil, err
+	}
+	orders := make([]core.OrderResponseFull, len(wsResp.Result))
+	for i, ord := range wsResp.Result {
+		orders[i] = ord.full()
+	}
+	return orders, nil
+}
+
+// full converts a listed order, the average price is derived from the quote amount filled
+func (ord queriedOrder) full() core.OrderResponseFull {
+	qty, _ := decimal.NewFromString(ord.OrigQty)
+	isQuoteQty := false
+	if qty.IsZero() && ord.OrigQuoteOrderQty != "" {
+		qty, _ = decimal.NewFromString(ord.OrigQuoteOrderQty)
+		isQuoteQty = true
+	}
+	price, _ := decimal.NewFromString(ord.Price)
+	executedQty, _ := decimal.NewFromString(ord.ExecutedQty)
+	quoteQty, _ := decimal.NewFromString(ord.CummulativeQuoteQty)
+	avgPrice := decimal.Zero
+	if executedQty.IsPositive() {
+		avgPrice = quoteQty.Div(executedQty)
+	}
+	return core.OrderResponseFull{
+		OrderResponse: core.OrderResponse{
+			OrderID:         strconv.FormatInt(ord.OrderID, 10),
+			ClientOrderID:   ord.ClientOrderID,
+			Symbol:          ord.Symbol,
+			Side:            core.OrderSide(ord.Side),
+			Tif:             core.TimeInForce(ord.TimeInForce),
+			Status:          parseOrderStatus(ord.Status),
+			Reason:          statusReason(ord.Status),
+			Price:           price,
+			Quantity:        qty,
+			IsQuoteQuantity: isQuoteQty,
+			CreateTime:      time.UnixMilli(ord.Time),
+		},
+		AvgPrice:    avgPrice,
+		ExecutedQty: executedQty,
+		UpdateTime:  time.UnixMilli(ord.UpdateTime),
 	}
+}
 
-	trades := wsResp.Result
+// getOrderTradeHistory fetches trade history for a specific order and calculates average price
+func (b *BinanceClient) getOrderTradeHistory(symbol, orderId string) (avgPrice, executedQty, commission decimal.Decimal, commissionAsset string, updateTime time.Time, err error) {
+	trades, err := b.FetchOrderFills(symbol, orderId)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, "", time.Time{}, err
+	}
 	if len(trades) == 0 {
 		// No trades found, return zeros
 		return decimal.Zero, decimal.Zero, decimal.Zero, "", time.Time{}, nil
@@ -432,28 +579,23 @@ func (b *BinanceClient) getOrderTradeHistory(symbol, orderId string) (avgPrice,
 	var totalValue decimal.Decimal = decimal.Zero
 	var totalQty decimal.Decimal = decimal.Zero
 	var totalCommission decimal.Decimal = decimal.Zero
-	var latestTime int64 = 0
+	var latestTime time.Time
 	var firstCommissionAsset string
 
 	for _, trade := range trades {
-		tradePrice := decimal.RequireFromString(trade.Price)
-		tradeQty := decimal.RequireFromString(trade.Qty)
-		tradeCommission := decimal.RequireFromString(trade.Commission)
-
 		// Calculate value = price * quantity
-		tradeValue := tradePrice.Mul(tradeQty)
-		totalValue = totalValue.Add(tradeValue)
-		totalQty = totalQty.Add(tradeQty)
-		totalCommission = totalCommission.Add(tradeCommission)
+		totalValue = totalValue.Add(trade.Price.Mul(trade.Quantity))
+		totalQty = totalQty.Add(trade.Quantity)
+		totalCommission = totalCommission.Add(trade.Fee)
 
 		// Track the latest trade time for updateTime
-		if trade.Time > latestTime {
-			latestTime = trade.Time
+		if trade.TradeTime.After(latestTime) {
+			latestTime = trade.TradeTime
 		}
 
 		// Use the first commission asset found
 		if firstCommissionAsset == "" {
-			firstCommissionAsset = trade.CommissionAsset
+			firstCommissionAsset = trade.FeeAsset
 		}
 	}
 
@@ -464,10 +606,229 @@ func (b *BinanceClient) getOrderTradeHistory(symbol, orderId string) (avgPrice,
 		avgPrice = totalValue.Div(totalQty)
 	}
 
-	executedQty = totalQty
-	commission = totalCommission
-	commissionAsset = firstCommissionAsset
-	updateTime = time.UnixMilli(latestTime)
+	return avgPrice, totalQty, totalCommission, firstCommissionAsset, latestTime, nil
+}
+
+// myTradesLimit and myTradesWindow bound one myTrades request
+const (
+	myTradesLimit  = 1000
+	myTradesWindow = 24 * time.Hour
+)
+
+// FetchFills implements core.PrivateClient with myTrades
+func (b *BinanceClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, myTradesWindow, myTradesLimit, func(start, end time.Time, limit int) ([]core.OrderFill, error) {
+		fills, err := b.myTrades(map[string]interface{}{
+			"symbol":    symbol,
+			"startTime": start.UnixMilli(),
+			"endTime":   end.UnixMilli(),
+			"limit":     limit,
+			"timestamp": time.Now().UnixMilli(),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch fills: %w", err)
+		}
+		return fills, nil
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (b *BinanceClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := b.myTrades(map[string]interface{}{
+		"symbol":    symbol,
+		"orderId":   orderId,
+		"limit":     myTradesLimit,
+		"timestamp": time.Now().UnixMilli(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+func (b *BinanceClient) myTrades(params map[string]interface{}) ([]core.OrderFill, error) {
+	root, err := b.SendRequest(map[string]interface{}{
+		"id":     nextWSID(),
+		"method": "myTrades",
+		"params": params,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse the trade history response
+	var wsResp struct {
+		Result []struct {
+			Symbol          string `json:"symbol"`
+			ID              int64  `json:"id"`
+			OrderID         int64  `json:"orderId"`
+			Price           string `json:"price"`
+			Qty             string `json:"qty"`
+			Commission      string `json:"commission"`
+			CommissionAsset string `json:"commissionAsset"`
+			Time            int64  `json:"time"`
+			IsBuyer         bool   `json:"isBuyer"`
+			IsMaker         bool   `json:"isMaker"`
+			IsBestMatch     bool   `json:"isBestMatch"`
+		} `json:"result"`
+	}
+	rootByte, _ := json.Marshal(root)
+	if err := json.Unmarshal(rootByte, &wsResp); err != nil {
+		return nil, err
+	}
+
+	fills := make([]core.OrderFill, len(wsResp.Result))
+	for i, trade := range wsResp.Result {
+		side := core.OrderSideSell
+		if trade.IsBuyer {
+			side = core.OrderSideBuy
+		}
+		fills[i] = core.OrderFill{
+			TradeID:     strconv.FormatInt(trade.ID, 10),
+			OrderID:     strconv.FormatInt(trade.OrderID, 10),
+			Symbol:      trade.Symbol,
+			Side:        side,
+			Price:       decimal.RequireFromString(trade.Price),
+			Quantity:    decimal.RequireFromString(trade.Qty),
+			Fee:         decimal.RequireFromString(trade.Commission),
+			FeeAsset:    trade.CommissionAsset,
+			IsMaker:     trade.IsMaker,
+			TradeTime:   time.UnixMilli(trade.Time),
+			IsBuyer:     trade.IsBuyer,
+			IsBestMatch: trade.IsBestMatch,
+		}
+	}
+	return fills, nil
+}
+
+// AmendOrder implements core.PrivateClient. A pure quantity reduction uses
+// order.amend.keepPriority and keeps queue priority, anything else is replaced atomically
+// with order.cancelReplace under a new order id.
+func (b *BinanceClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	if err := core.ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	orderIdInt, _ := strconv.ParseInt(orderId, 10, 64)
+	// order.status only, FetchOrder would also pull the trade history
+	root, err := b.SendRequest(map[string]interface{}{
+		"id":     nextWSID(),
+		"method": "order.status",
+		"params": map[string]interface{}{"symbol": symbol, "orderId": orderIdInt, "timestamp": time.Now().UnixMilli()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	var ord WsOrderResult
+	if err := json.Unmarshal(root["result"], &ord); err != nil {
+		return nil, fmt.Errorf("failed to decode order status: %w", err)
+	}
+	if status := parseOrderStatus(ord.Status); !status.IsOpen() {
+		return nil, fmt.Errorf("amend %s: order is %s", orderId, status)
+	}
+	price, _ := decimal.NewFromString(ord.Price)
+	qty, _ := decimal.NewFromString(ord.OrigQty)
+	executedQty, _ := decimal.NewFromString(ord.ExecutedQty)
+	result := &core.AmendResult{OriginalOrderID: orderId}
+
+	if newPrice.Equal(price) && newQty.LessThan(qty) {
+		root, err := b.SendRequest(map[string]interface{}{
+			"id":     nextWSID(),
+			"method": "order.amend.keepPriority",
+			"params": map[string]interface{}{
+				"symbol":    symbol,
+				"orderId":   orderIdInt,
+				"newQty":    newQty.String(),
+				"timestamp": time.Now().UnixMilli(),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		var res struct {
+			AmendedOrder struct {
+				Symbol      string `json:"symbol"`
+				OrderID     int64  `json:"orderId"`
+				Price       string `json:"price"`
+				Qty         string `json:"qty"`
+				Status      string `json:"status"`
+				TimeInForce string `json:"timeInForce"`
+				Side        string `json:"side"`
+			} `json:"amendedOrder"`
+		}
+		if err := json.Unmarshal(root["result"], &res); err != nil {
+			return nil, fmt.Errorf("failed to decode amend response: %w", err)
+		}
+		amended := res.AmendedOrder
+		result.KeptPriority = true
+		result.Order = &core.OrderResponse{
+			OrderID:    strconv.FormatInt(amended.OrderID, 10),
+			Symbol:     amended.Symbol,
+			Side:       core.OrderSide(amended.Side),
+			Tif:        core.TimeInForce(amended.TimeInForce),
+			Status:     parseOrderStatus(amended.Status),
+			Reason:     statusReason(amended.Status),
+			Price:      decimal.RequireFromString(amended.Price),
+			Quantity:   decimal.RequireFromString(amended.Qty),
+			CreateTime: time.UnixMilli(ord.TransactTime),
+		}
+		return result, nil
+	}
 
-	return avgPrice, executedQty, commission, commissionAsset, updateTime, nil
+	// a replacement is a new order, it only carries what is left to fill
+	rest := newQty.Sub(executedQty)
+	if !rest.IsPositive() {
+		return nil, fmt.Errorf("amend %s: new quantity %s is not above the executed %s", orderId, newQty, executedQty)
+	}
+	params := map[string]interface{}{
+		"symbol":            symbol,
+		"cancelReplaceMode": "STOP_ON_FAILURE",
+		"cancelOrderId":     orderIdInt,
+		"side":              ord.Side,
+		"type":              ord.Type,
+		"quantity":          rest.String(),
+		"price":             newPrice.String(),
+		"timestamp":         time.Now().UnixMilli(),
+	}
+	// the replacement keeps the type, a LIMIT_MAKER stays post-only and takes no time in force
+	switch ord.Type {
+	case "LIMIT":
+		tif := ord.TimeInForce
+		if tif == "" {
+			tif = string(core.TimeInForceGTC)
+		}
+		params["timeInForce"] = tif
+	case "LIMIT_MAKER":
+	default:
+		return nil, fmt.Errorf("amend %s: %s orders cannot be amended", orderId, ord.Type)
+	}
+	root, err = b.SendRequest(map[string]interface{}{
+		"id":     nextWSID(),
+		"method": "order.cancelReplace",
+		"params": params,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		NewOrderResponse WsOrderResult `json:"newOrderResponse"`
+	}
+	if err := json.Unmarshal(root["result"], &res); err != nil {
+		return nil, fmt.Errorf("failed to decode cancelReplace response: %w", err)
+	}
+	newOrd := res.NewOrderResponse
+	result.Replaced = true
+	result.Order = &core.OrderResponse{
+		OrderID:    strconv.FormatInt(newOrd.OrderID, 10),
+		Symbol:     newOrd.Symbol,
+		Side:       core.OrderSide(newOrd.Side),
+		Tif:        core.TimeInForce(newOrd.TimeInForce),
+		Status:     parseOrderStatus(newOrd.Status),
+		Reason:     statusReason(newOrd.Status),
+		Price:      decimal.RequireFromString(newOrd.Price),
+		Quantity:   decimal.RequireFromString(newOrd.OrigQty),
+		PostOnly:   newOrd.Type == "LIMIT_MAKER",
+		CreateTime: time.UnixMilli(newOrd.TransactTime),
+	}
+	return result, nil
 }
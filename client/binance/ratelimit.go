@@ -0,0 +1,146 @@
+package binance
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// defaultRateLimits are the spot limits until FetchMarketRules loads the ones from exchangeInfo
+var defaultRateLimits = []core.RateLimit{
+	{Category: core.RateLimitRequest, Interval: time.Minute, Limit: 6000},
+	{Category: core.RateLimitOrder, Interval: 10 * time.Second, Limit: 100},
+	{Category: core.RateLimitOrder, Interval: 24 * time.Hour, Limit: 200000},
+	{Category: core.RateLimitConnection, Interval: 5 * time.Minute, Limit: 300},
+}
+
+// requestWeights are the REQUEST_WEIGHT costs of ws-api methods heavier than 1
+var requestWeights = map[string]int64{
+	"exchangeInfo":             20,
+	"account.status":           20,
+	"myTrades":                 20,
+	"allOrders":                20,
+	"ticker.book":              4,
+	"klines":                   2,
+	"openOrders.status":        6,
+	"openOrders.cancelAll":     1,
+	"order.status":             4,
+	"order.amend.keepPriority": 4,
+}
+
+// orderMethods also count against the ORDERS limits, keepPriority amends do not
+var orderMethods = map[string]bool{
+	"order.place":         true,
+	"order.cancelReplace": true,
+}
+
+func rateCostFn() core.WsRequestCostFunc {
+	return func(req map[string]interface{}) []core.RateCost {
+		method, _ := req["method"].(string)
+		weight, ok := requestWeights[method]
+		if !ok {
+			weight = 1
+		}
+		if method == "depth" {
+			params, _ := req["params"].(map[string]interface{})
+			limit, _ := params["limit"].(int64)
+			weight = depthWeight(limit)
+		}
+		if method == "ticker.24hr" {
+			params, _ := req["params"].(map[string]interface{})
+			weight = ticker24hrWeight(params)
+		}
+		if method == "openOrders.status" {
+			if params, _ := req["params"].(map[string]interface{}); params["symbol"] == nil {
+				weight = 80 // all symbols
+			}
+		}
+		costs := []core.RateCost{{Category: core.RateLimitRequest, Weight: weight}}
+		if orderMethods[method] {
+			costs = append(costs, core.RateCost{Category: core.RateLimitOrder, Weight: 1})
+		}
+		return costs
+	}
+}
+
+// rateUsageFn syncs the limiter with the rateLimits counters and retryAfter of ws-api responses
+func rateUsageFn() core.WsRateUsageFunc {
+	return func(root map[string]json.RawMessage, limiter *core.RateLimiter) {
+		if raw, ok := root["rateLimits"]; ok {
+			var usage []wsRateLimitWithCount
+			if err := json.Unmarshal(raw, &usage); err == nil {
+				for _, rl := range usage {
+					limiter.Observe(rateLimitCategory(rl.RateLimitType), rateLimitInterval(rl.Interval, rl.IntervalNum), int64(rl.Count))
+				}
+			}
+		}
+		if raw, ok := root["error"]; ok {
+			var errObj struct {
+				Data struct {
+					RetryAfter int64 `json:"retryAfter"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(raw, &errObj); err == nil && errObj.Data.RetryAfter > 0 {
+				limiter.Pause(time.UnixMilli(errObj.Data.RetryAfter))
+			}
+		}
+	}
+}
+
+// depthWeight is the weight of a depth request by its limit, 100 levels if unset
+func depthWeight(limit int64) int64 {
+	switch {
+	case limit <= 100:
+		return 5
+	case limit <= 500:
+		return 25
+	case limit <= 1000:
+		return 50
+	default:
+		return 250
+	}
+}
+
+// ticker24hrWeight is the weight of a 24h ticker request by its symbol count, 80 for all
+// symbols when none are given
+func ticker24hrWeight(params map[string]interface{}) int64 {
+	n := 0
+	if symbols, ok := params["symbols"].([]string); ok {
+		n = len(symbols)
+	} else if params["symbol"] != nil {
+		n = 1
+	}
+	switch {
+	case n == 0 || n > 100:
+		return 80
+	case n > 20:
+		return 40
+	default:
+		return 2
+	}
+}
+
+func rateLimitCategory(rateLimitType string) core.RateLimitCategory {
+	switch rateLimitType {
+	case "REQUEST_WEIGHT":
+		return core.RateLimitRequest
+	case "ORDERS":
+		return core.RateLimitOrder
+	case "CONNECTIONS":
+		return core.RateLimitConnection
+	}
+	return ""
+}
+
+func rateLimitInterval(interval string, num int) time.Duration {
+	switch interval {
+	case "SECOND":
+		return time.Second * time.Duration(num)
+	case "MINUTE":
+		return time.Minute * time.Duration(num)
+	case "DAY":
+		return time.Hour * 24 * time.Duration(num)
+	}
+	return 0
+}
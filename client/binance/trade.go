@@ -0,0 +1,58 @@
+package binance
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// SubscribeTrades implements core.PublicClient with the @trade stream
+func (b *BinanceClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	tradeChMap := make(map[string]<-chan core.Trade)
+	chans := make(map[string]chan core.Trade)
+	params := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Trade, 256)
+		tradeChMap[symbol] = chans[symbol]
+		params[i] = strings.ToLower(symbol) + "@trade"
+	}
+
+	stream := core.NewStream(b.streamURL, subscribeStreams(params), func(msg []byte) {
+		var ev wsTradeStream
+		if err := json.Unmarshal(msg, &ev); err != nil || ev.EventType != "trade" {
+			return // subscription ack
+		}
+		ch, exists := chans[ev.Symbol]
+		if !exists {
+			return
+		}
+		// the buyer being the maker means the seller took liquidity
+		side := core.OrderSideBuy
+		if ev.IsBuyerMaker {
+			side = core.OrderSideSell
+		}
+		core.SendTrade(ctx, ch, core.Trade{
+			Symbol:   ev.Symbol,
+			TradeID:  strconv.FormatInt(ev.TradeID, 10),
+			Price:    core.ParseStringDecimal(ev.Price),
+			Quantity: core.ParseStringDecimal(ev.Quantity),
+			Side:     side,
+			Time:     time.UnixMilli(ev.TradeTime),
+		})
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return tradeChMap, err
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+	return tradeChMap, nil
+}
@@ -0,0 +1,166 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// klinePageLimit is the max number of klines per request
+const klinePageLimit = 1000
+
+var klineIntervals = map[core.CandleInterval]string{
+	core.CandleInterval1m:  "1",
+	core.CandleInterval3m:  "3",
+	core.CandleInterval5m:  "5",
+	core.CandleInterval15m: "15",
+	core.CandleInterval30m: "30",
+	core.CandleInterval1h:  "60",
+	core.CandleInterval2h:  "120",
+	core.CandleInterval4h:  "240",
+	core.CandleInterval6h:  "360",
+	core.CandleInterval12h: "720",
+	core.CandleInterval1d:  "D",
+	core.CandleInterval1w:  "W",
+	core.CandleInterval1M:  "M",
+}
+
+// FetchCandles implements core.PublicClient
+func (c *BybitClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	bybitInterval, ok := klineIntervals[interval]
+	if !ok {
+		return nil, core.ErrUnsupportedInterval("bybit", interval)
+	}
+	return core.PageCandles(start, end, limit, klinePageLimit, func(start, end time.Time, limit int) ([]core.Candle, error) {
+		params := url.Values{}
+		params.Set("category", "spot")
+		params.Set("symbol", symbol)
+		params.Set("interval", bybitInterval)
+		params.Set("end", strconv.FormatInt(end.UnixMilli(), 10))
+		params.Set("limit", strconv.Itoa(limit))
+		if !start.IsZero() {
+			params.Set("start", strconv.FormatInt(start.UnixMilli(), 10))
+		}
+		resp, err := c.httpClient.Get(bybitRestURL + "/v5/market/kline?" + params.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch klines: %w", err)
+		}
+		defer resp.Body.Close()
+
+		var res struct {
+			RetCode int    `json:"retCode"`
+			RetMsg  string `json:"retMsg"`
+			Result  struct {
+				List [][]string `json:"list"`
+			} `json:"result"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			return nil, fmt.Errorf("failed to decode klines: %w", err)
+		}
+		if err := wrapBybitErrorCode(res.RetCode, res.RetMsg); err != nil {
+			return nil, err
+		}
+		// list is [startTime, open, high, low, close, volume, turnover], newest first
+		candles := make([]core.Candle, 0, len(res.Result.List))
+		for _, row := range res.Result.List {
+			if len(row) < 7 {
+				return nil, fmt.Errorf("invalid kline row: %v", row)
+			}
+			startMs, err := strconv.ParseInt(row[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid kline start time %q: %w", row[0], err)
+			}
+			openTime := time.UnixMilli(startMs)
+			candles = append(candles, core.Candle{
+				Symbol:      symbol,
+				Interval:    interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(interval.Duration() - time.Millisecond),
+				Open:        decimal.RequireFromString(row[1]),
+				High:        decimal.RequireFromString(row[2]),
+				Low:         decimal.RequireFromString(row[3]),
+				Close:       decimal.RequireFromString(row[4]),
+				Volume:      decimal.RequireFromString(row[5]),
+				QuoteVolume: decimal.RequireFromString(row[6]),
+			})
+		}
+		return candles, nil
+	})
+}
+
+// SubscribeCandles implements core.PublicClient with the kline topic, bars are pushed on
+// every update and once more with Closed set when bybit confirms them
+func (c *BybitClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	candleChans := make(map[string]<-chan core.Candle)
+	bybitInterval, ok := klineIntervals[interval]
+	if !ok {
+		return candleChans, core.ErrUnsupportedInterval("bybit", interval)
+	}
+	chans := make(map[string]chan core.Candle)
+	symbolByTopic := make(map[string]string)
+	topics := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		topic := fmt.Sprintf("kline.%s.%s", bybitInterval, symbol)
+		chans[topic] = make(chan core.Candle, 16)
+		candleChans[symbol] = chans[topic]
+		symbolByTopic[topic] = symbol
+		topics[i] = topic
+	}
+
+	stream := newPublicStream("wss://stream.bybit.com/v5/public/spot", topics, func(message []byte) {
+		var msg struct {
+			Topic string `json:"topic"`
+			Data  []struct {
+				Start    int64  `json:"start"`
+				End      int64  `json:"end"`
+				Open     string `json:"open"`
+				Close    string `json:"close"`
+				High     string `json:"high"`
+				Low      string `json:"low"`
+				Volume   string `json:"volume"`
+				Turnover string `json:"turnover"`
+				Confirm  bool   `json:"confirm"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(message, &msg); err != nil {
+			return
+		}
+		ch, exists := chans[msg.Topic]
+		if !exists {
+			return // pong or subscription ack
+		}
+		for _, k := range msg.Data {
+			core.SendCandle(ctx, ch, core.Candle{
+				Symbol:      symbolByTopic[msg.Topic],
+				Interval:    interval,
+				OpenTime:    time.UnixMilli(k.Start),
+				CloseTime:   time.UnixMilli(k.End),
+				Open:        core.ParseStringDecimal(k.Open),
+				High:        core.ParseStringDecimal(k.High),
+				Low:         core.ParseStringDecimal(k.Low),
+				Close:       core.ParseStringDecimal(k.Close),
+				Volume:      core.ParseStringDecimal(k.Volume),
+				QuoteVolume: core.ParseStringDecimal(k.Turnover),
+				Closed:      k.Confirm,
+			})
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return candleChans, fmt.Errorf("failed to connect to spot WebSocket: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return candleChans, nil
+}
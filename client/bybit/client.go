@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
@@ -20,29 +21,41 @@ import (
 
 const (
 	bybitWsURLPrivate = "wss://stream.bybit.com/v5/trade"
+	bybitRestURL      = "https://api.bybit.com"
 	wsLifetime        = 23*time.Hour + 50*time.Minute
 )
 
 type BybitClient struct {
 	*core.WsClient
-	client    *bybit.Client
-	apiKey    string
-	apiSecret string
+	client     *bybit.Client
+	httpClient *http.Client // rate limited, for the requests the bybit client does not cover
+	apiKey     string
+	apiSecret  string
 
 	balances   map[string]*core.Wallet
 	orders     map[string]*core.OrderResponse
 	balancesMu sync.RWMutex
 	ordersMu   sync.RWMutex
+
+	conditionals *core.ConditionalEmulator // trailing stops
+	rules        *core.RuleCache           // rules orders are normalised to
+
+	orderEventMu     sync.Mutex
+	orderEventCh     chan core.OrderEvent
+	orderEventCancel context.CancelFunc
 }
 
 func NewClient(apiKey, apiSecret string) *BybitClient {
-	restCli := bybit.NewClient().WithAuth(apiKey, apiSecret)
+	limiter := core.NewRateLimiter("bybit", defaultRateLimits)
+	httpClient := newHTTPClient(limiter)
+	restCli := bybit.NewClient().WithHTTPClient(httpClient).WithAuth(apiKey, apiSecret)
 	client := &BybitClient{
-		client:    restCli,
-		apiKey:    apiKey,
-		apiSecret: apiSecret,
-		balances:  make(map[string]*core.Wallet),
-		orders:    make(map[string]*core.OrderResponse),
+		client:     restCli,
+		httpClient: httpClient,
+		apiKey:     apiKey,
+		apiSecret:  apiSecret,
+		balances:   make(map[string]*core.Wallet),
+		orders:     make(map[string]*core.OrderResponse),
 	}
 	client.WsClient = core.NewWsClient(
 		bybitWsURLPrivate,
@@ -54,6 +67,9 @@ func NewClient(apiKey, apiSecret string) *BybitClient {
 		extractErrFn(),
 		client.afterConnect(),
 	)
+	client.SetRateLimiter(limiter, rateCostFn(), nil)
+	client.conditionals = core.NewConditionalEmulator(client, client.placeTriggered)
+	client.initRules()
 	return client
 }
 
@@ -92,12 +108,9 @@ func (c *BybitClient) authFn() core.WsAuthFunc {
 			switch resp.RetCode {
 			case 20001:
 				return 0, nil // repeat auth, just go on
-			case 10004:
-				return 0, fmt.Errorf("Bybit ws auth invalid sign: %s", resp.RetMsg)
-			case 10001:
-				return 0, fmt.Errorf("Bybit ws auth param error: %s", resp.RetMsg)
 			default:
-				return 0, fmt.Errorf("bybit ws auth unknown error: %s", resp.RetMsg)
+				// any auth reject is an auth failure regardless of the code
+				return 0, fmt.Errorf("bybit ws auth: %w", core.NewExchangeError("bybit", core.ErrCategoryAuthFailed, strconv.FormatInt(resp.RetCode, 10), resp.RetMsg))
 			}
 		}
 		return 0, nil
@@ -170,7 +183,7 @@ func extractErrFn() core.WsExtractErrFunc {
 				if msgRaw, ok := root["retMsg"]; ok {
 					_ = json.Unmarshal(msgRaw, &retMsg)
 				}
-				return fmt.Errorf("bybit ws error %d: %s", retCode, retMsg)
+				return wrapBybitErrorCode(retCode, retMsg)
 			}
 		}
 		return nil
@@ -204,11 +217,12 @@ func (c *BybitClient) afterConnect() core.WsAfterConnectFunc {
 		}
 		ticker := time.NewTicker(3 * time.Second)
 		pingTicker := time.NewTicker(20 * time.Second)
+		ctx := c.Ctx // stop with this session, a reconnect starts a new loop
 		go func() {
 			defer ticker.Stop()
 			for {
 				select {
-				case <-c.Ctx.Done():
+				case <-ctx.Done():
 					return
 				case <-pingTicker.C:
 					msg := map[string]interface{}{
@@ -269,23 +283,12 @@ func hmacSHA256(msg, secret string) string {
 	return hex.EncodeToString(h.Sum(nil))
 }
 
-// SubscribeOrderEvents implements core.PrivateClient interface
-// Note: Bybit real-time order events not implemented yet
-func (c *BybitClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
-	return nil, fmt.Errorf("real-time order events not implemented for Bybit")
-}
-
 // SubscribeBalanceEvents implements core.PrivateClient interface
 // Note: Bybit real-time balance events not implemented yet
 func (c *BybitClient) SubscribeBalanceEvents(ctx context.Context, assets []string, errHandler func(err error)) (<-chan core.BalanceEvent, error) {
 	return nil, fmt.Errorf("real-time balance events not implemented for Bybit")
 }
 
-// UnsubscribeOrderEvents implements core.PrivateClient interface
-func (c *BybitClient) UnsubscribeOrderEvents() error {
-	return fmt.Errorf("real-time order events not implemented for Bybit")
-}
-
 // UnsubscribeBalanceEvents implements core.PrivateClient interface
 func (c *BybitClient) UnsubscribeBalanceEvents() error {
 	return fmt.Errorf("real-time balance events not implemented for Bybit")
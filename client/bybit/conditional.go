@@ -0,0 +1,61 @@
+package bybit
+
+import (
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// PlaceConditional implements core.ConditionalOrderClient. Stop-loss and take-profit
+// orders are native spot conditional orders on the last price, bybit spot has no
+// trailing stop so those are emulated.
+func (c *BybitClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Source() != core.TriggerSourceLast {
+		return nil, core.ErrUnsupportedTriggerSource("bybit", req.Source())
+	}
+	if req.Type == core.ConditionalTrailingStop {
+		return c.conditionals.Place(req)
+	}
+	opt := conditionalOptions(req)
+	opt.TriggerPrice = req.TriggerPrice
+	opt.OrderFilter = "StopOrder"
+	return c.wsPlaceOrder(opt)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (c *BybitClient) CancelConditional(symbol, orderId string) error {
+	if core.IsEmulatedOrderID(orderId) {
+		return c.conditionals.Cancel(orderId)
+	}
+	_, err := c.cancelOrder(symbol, orderId, "StopOrder")
+	return err
+}
+
+// placeTriggered places the order of a triggered emulated conditional
+func (c *BybitClient) placeTriggered(req core.ConditionalOrderRequest, last decimal.Decimal) (*core.OrderResponse, error) {
+	return c.wsPlaceOrder(conditionalOptions(req))
+}
+
+// conditionalOptions are the options of the order a conditional places, market buys are
+// sized in base like every conditional
+func conditionalOptions(req core.ConditionalOrderRequest) OrderOptions {
+	opt := OrderOptions{
+		Symbol:     req.Symbol,
+		Side:       "Sell",
+		OrderType:  "Market",
+		Qty:        req.Quantity,
+		MarketUnit: "baseCoin",
+	}
+	if req.Side == core.OrderSideBuy {
+		opt.Side = "Buy"
+	}
+	if req.LimitPrice.IsPositive() {
+		opt.OrderType = "Limit"
+		opt.Price = req.LimitPrice
+		opt.TimeInForce = string(req.TimeInForce)
+		opt.MarketUnit = ""
+	}
+	return opt
+}
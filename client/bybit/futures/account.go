@@ -65,16 +65,16 @@ func (c *BybitFuturesClient) GetAccount() (*core.Account, error) {
 	account.Assets = make(map[string]*core.Wallet)
 	var quotes []string
 	for _, coin := range resp.Result.List[0].Coin {
-		avail := core.ToFloat(coin.WalletBalance)
-		total := core.ToFloat(coin.Equity)
-		if total > 10 {
+		avail := core.ParseStringDecimal(coin.WalletBalance)
+		total := core.ParseStringDecimal(coin.Equity)
+		if total.GreaterThan(decimal.NewFromInt(10)) {
 			quotes = append(quotes, coin.Coin)
 		}
 		account.Assets[coin.Coin] = &core.Wallet{
 			Asset:  coin.Coin,
-			Free:   decimal.NewFromFloat(avail),
-			Locked: decimal.NewFromFloat(total - avail),
-			Total:  decimal.NewFromFloat(total),
+			Free:   avail,
+			Locked: total.Sub(avail),
+			Total:  total,
 		}
 	}
 
@@ -0,0 +1,66 @@
+package bybit
+
+import (
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+var triggerBy = map[core.TriggerSource]string{
+	core.TriggerSourceLast:  "LastPrice",
+	core.TriggerSourceMark:  "MarkPrice",
+	core.TriggerSourceIndex: "IndexPrice",
+}
+
+// PlaceConditional implements core.ConditionalOrderClient. Stop-loss and take-profit
+// orders are native, trailing stops are emulated because bybit only trails whole
+// positions.
+func (c *BybitFuturesClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Type == core.ConditionalTrailingStop {
+		return c.conditionals.Place(req)
+	}
+	opt := conditionalOptions(req)
+	opt.TriggerPrice = req.TriggerPrice
+	opt.TriggerBy = triggerBy[req.Source()]
+	opt.TriggerDirection = 2 // falls to the trigger
+	if req.TriggersOnRise() {
+		opt.TriggerDirection = 1
+	}
+	return c.wsPlaceOrder(opt)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (c *BybitFuturesClient) CancelConditional(symbol, orderId string) error {
+	if core.IsEmulatedOrderID(orderId) {
+		return c.conditionals.Cancel(orderId)
+	}
+	_, err := c.CancelOrder(symbol, orderId)
+	return err
+}
+
+// placeTriggered places the order of a triggered emulated conditional
+func (c *BybitFuturesClient) placeTriggered(req core.ConditionalOrderRequest, last decimal.Decimal) (*core.OrderResponse, error) {
+	return c.wsPlaceOrder(conditionalOptions(req))
+}
+
+// conditionalOptions are the options of the order a conditional places
+func conditionalOptions(req core.ConditionalOrderRequest) OrderOptions {
+	opt := OrderOptions{
+		Symbol:     req.Symbol,
+		Side:       "Sell",
+		OrderType:  "Market",
+		Qty:        req.Quantity,
+		ReduceOnly: req.ReduceOnly,
+	}
+	if req.Side == core.OrderSideBuy {
+		opt.Side = "Buy"
+	}
+	if req.LimitPrice.IsPositive() {
+		opt.OrderType = "Limit"
+		opt.Price = req.LimitPrice
+		opt.TimeInForce = string(req.TimeInForce)
+	}
+	return opt
+}
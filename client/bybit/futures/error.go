@@ -1,30 +1,66 @@
 package bybit
 
 import (
-	"fmt"
-	"strings"
+	"errors"
+	"strconv"
 	"time"
+
+	"github.com/hirokisan/bybit/v2"
+	"github.com/ljm2ya/quickex-go/core"
 )
 
+// bybitErrorCategories maps bybit v5 retCodes into core error categories
+var bybitErrorCategories = map[int]core.ErrorCategory{
+	10000:  core.ErrCategoryExecutionUnknown,
+	10006:  core.ErrCategoryRateLimited,
+	10018:  core.ErrCategoryRateLimited,
+	10003:  core.ErrCategoryAuthFailed,
+	10004:  core.ErrCategoryAuthFailed,
+	10005:  core.ErrCategoryAuthFailed,
+	10007:  core.ErrCategoryAuthFailed,
+	10010:  core.ErrCategoryAuthFailed,
+	10016:  core.ErrCategoryMaintenance,
+	110001: core.ErrCategoryOrderNotFound,
+	110003: core.ErrCategoryPriceFilter,
+	110004: core.ErrCategoryInsufficientBalance,
+	110007: core.ErrCategoryInsufficientBalance,
+	110012: core.ErrCategoryInsufficientBalance,
+	110094: core.ErrCategoryMinNotional,
+	170121: core.ErrCategoryInvalidSymbol,
+	170131: core.ErrCategoryInsufficientBalance,
+	170134: core.ErrCategoryPriceFilter,
+	170140: core.ErrCategoryMinNotional,
+	170193: core.ErrCategoryPriceFilter,
+	170194: core.ErrCategoryPriceFilter,
+	170213: core.ErrCategoryOrderNotFound,
+}
+
 func wrapBybitErrorCode(retCode int, msg string) error {
-	switch retCode {
-	case 10006, 10018, 30084: // 서버 에러/레이트 리밋 등
-		return fmt.Errorf("bybit server error or rate limit: %d %s", retCode, msg)
-	default:
-		if retCode != 0 {
-			return fmt.Errorf("bybit error %d: %s", retCode, msg)
-		}
+	if retCode == 0 {
+		return nil
 	}
-	return nil
+	category, ok := bybitErrorCategories[retCode]
+	if !ok {
+		category = core.ErrCategoryUnknown
+	}
+	return core.NewExchangeError("bybit", category, strconv.Itoa(retCode), msg)
 }
 
 func handleBybitError(err error) error {
 	if err == nil {
 		return nil
 	}
-	if strings.Contains(err.Error(), "rate limit") || strings.Contains(err.Error(), "Too many requests") {
+	var errResp *bybit.ErrorResponse
+	if errors.As(err, &errResp) {
+		return wrapBybitErrorCode(errResp.RetCode, errResp.RetMsg)
+	}
+	var rateErr *bybit.RateLimitV5Error
+	if errors.As(err, &rateErr) {
 		time.Sleep(2 * time.Second)
-		return fmt.Errorf("bybit: rate limited, retrying: %v", err)
+		return core.NewExchangeError("bybit", core.ErrCategoryRateLimited, strconv.Itoa(rateErr.RetCode), rateErr.RetMsg)
+	}
+	if errors.Is(err, bybit.ErrInvalidRequest) || errors.Is(err, bybit.ErrForbiddenRequest) {
+		return core.NewExchangeError("bybit", core.ErrCategoryAuthFailed, "", err.Error())
 	}
 	return err
 }
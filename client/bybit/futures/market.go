@@ -5,10 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"net/url"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/hirokisan/bybit/v2"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
@@ -27,35 +25,43 @@ func (c *BybitFuturesClient) GetTicker(symbol string) (*core.Ticker, error) {
 	t := resp.Result.LinearInverse.List[0]
 	return &core.Ticker{
 		Symbol:   t.Symbol,
-		BidPrice: core.ToFloat(t.Bid1Price),
-		BidQty:   core.ToFloat(t.Bid1Size),
-		AskPrice: core.ToFloat(t.Ask1Price),
-		AskQty:   core.ToFloat(t.Ask1Size),
+		BidPrice: core.ParseStringDecimal(t.Bid1Price),
+		BidQty:   core.ParseStringDecimal(t.Bid1Size),
+		AskPrice: core.ParseStringDecimal(t.Ask1Price),
+		AskQty:   core.ParseStringDecimal(t.Ask1Size),
 		Time:     time.Now(),
 	}, nil
 }
 
+// FetchQuotes implements core.PublicClient, all linear tickers come in one request and are
+// filtered
 func (c *BybitFuturesClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
-	var out = make(map[string]core.Quote)
+	resp, err := c.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
+		Category: "linear",
+	})
+	if err != nil {
+		return nil, err
+	}
+	want := make(map[string]bool, len(symbols))
 	for _, s := range symbols {
-		resp, err := c.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
-			Category: "linear", Symbol: &s,
-		})
-		if err != nil || len(resp.Result.LinearInverse.List) == 0 {
-			return out, err
+		want[s] = true
+	}
+	now := time.Now()
+	out := make(map[string]core.Quote)
+	for _, t := range resp.Result.LinearInverse.List {
+		if len(symbols) > 0 && !want[t.Symbol] {
+			continue
 		}
-		t := resp.Result.LinearInverse.List[0]
-		out[s] = core.Quote{
+		out[t.Symbol] = core.Quote{
 			Symbol:   t.Symbol,
-			BidPrice: decimal.RequireFromString(t.Bid1Price),
-			BidQty:   decimal.RequireFromString(t.Bid1Size),
-			AskPrice: decimal.RequireFromString(t.Ask1Price),
-			AskQty:   decimal.RequireFromString(t.Ask1Size),
-			Time:     time.Now(),
+			BidPrice: core.ParseStringDecimal(t.Bid1Price),
+			BidQty:   core.ParseStringDecimal(t.Bid1Size),
+			AskPrice: core.ParseStringDecimal(t.Ask1Price),
+			AskQty:   core.ParseStringDecimal(t.Ask1Size),
+			Time:     now,
 		}
-		time.Sleep(time.Second / 100)
 	}
-	return out, nil
+	return out, core.MissingSymbols("bybit", symbols, out)
 }
 
 func (c *BybitFuturesClient) GetTickers(symbols []string) ([]core.Ticker, error) {
@@ -70,6 +76,62 @@ func (c *BybitFuturesClient) GetTickers(symbols []string) ([]core.Ticker, error)
 	return out, nil
 }
 
+// FetchTickers implements core.PublicClient, all linear tickers come in one request and are
+// filtered
+func (c *BybitFuturesClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	resp, err := c.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
+		Category: "linear",
+	})
+	if err != nil {
+		return nil, err
+	}
+	// decoded through the json form of the SDK list for the 24h fields
+	raw, err := json.Marshal(resp.Result.LinearInverse.List)
+	if err != nil {
+		return nil, err
+	}
+	var items []tickerItem
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode tickers: %w", err)
+	}
+	now := time.Now()
+	tickers := make([]core.Ticker, len(items))
+	for i, t := range items {
+		tickers[i] = core.Ticker{
+			Symbol:        t.Symbol,
+			BidPrice:      core.ParseStringDecimal(t.Bid1Price),
+			BidQty:        core.ParseStringDecimal(t.Bid1Size),
+			AskPrice:      core.ParseStringDecimal(t.Ask1Price),
+			AskQty:        core.ParseStringDecimal(t.Ask1Size),
+			LastPrice:     core.ParseStringDecimal(t.LastPrice),
+			OpenPrice:     core.ParseStringDecimal(t.PrevPrice24h),
+			HighPrice:     core.ParseStringDecimal(t.HighPrice24h),
+			LowPrice:      core.ParseStringDecimal(t.LowPrice24h),
+			Volume:        core.ParseStringDecimal(t.Volume24h),
+			QuoteVolume:   core.ParseStringDecimal(t.Turnover24h),
+			ChangePercent: core.ParseStringDecimal(t.Price24hPcnt).Shift(2), // a fraction
+			Time:          now,
+		}
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// tickerItem holds the fields of a ticker used by FetchTickers
+type tickerItem struct {
+	Symbol       string `json:"symbol"`
+	Bid1Price    string `json:"bid1Price"`
+	Bid1Size     string `json:"bid1Size"`
+	Ask1Price    string `json:"ask1Price"`
+	Ask1Size     string `json:"ask1Size"`
+	LastPrice    string `json:"lastPrice"`
+	PrevPrice24h string `json:"prevPrice24h"`
+	Price24hPcnt string `json:"price24hPcnt"`
+	HighPrice24h string `json:"highPrice24h"`
+	LowPrice24h  string `json:"lowPrice24h"`
+	Volume24h    string `json:"volume24h"`
+	Turnover24h  string `json:"turnover24h"`
+}
+
 func (c *BybitFuturesClient) GetOrderbook(symbol string, depth int64) (*core.Orderbook, error) {
 	resp, err := c.client.V5().Market().GetOrderbook(bybit.V5GetOrderbookParam{
 		Category: "linear", Symbol: symbol,
@@ -95,26 +157,58 @@ func (c *BybitFuturesClient) GetOrderbook(symbol string, depth int64) (*core.Ord
 	return ob, nil
 }
 
+// initRules sets up the rule cache that orders are rounded and validated against
+func (c *BybitFuturesClient) initRules() {
+	c.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		instrument := bybit.SymbolV5(symbol)
+		return c.fetchMarketRules(&instrument, nil)
+	}, core.DefaultRuleTTL)
+}
+
 func (c *BybitFuturesClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
 	if len(quotes) == 0 {
 		return nil, errors.New("empty quotes")
 	}
+	quoteSet := make(map[string]struct{})
+	for _, q := range quotes {
+		quoteSet[q] = struct{}{}
+	}
+	rules, err := c.fetchMarketRules(nil, quoteSet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("no market rules found for quotes: %v", quotes)
+	}
+	return rules, nil
+}
+
+// fetchMarketRules returns the rules of symbol, of all instruments when it is nil, whose
+// quote is in quoteSet, of any quote when quoteSet is nil
+func (c *BybitFuturesClient) fetchMarketRules(symbol *bybit.SymbolV5, quoteSet map[string]struct{}) ([]core.MarketRule, error) {
 	limit := 1000
 	resp, err := c.client.V5().Market().GetInstrumentsInfo(bybit.V5GetInstrumentsInfoParam{
 		Category: "linear",
+		Symbol:   symbol,
 		Limit:    &limit,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	quoteSet := make(map[string]struct{})
-	for _, q := range quotes {
-		quoteSet[q] = struct{}{}
+	// decoded through the json form of the SDK list for the order value and market order size
+	raw, err := json.Marshal(resp.Result.LinearInverse.List)
+	if err != nil {
+		return nil, err
+	}
+	var filters []instrumentFilters
+	if err := json.Unmarshal(raw, &filters); err != nil {
+		return nil, fmt.Errorf("failed to decode instruments: %w", err)
 	}
+
 	var rules []core.MarketRule
-	for _, r := range resp.Result.LinearInverse.List {
-		if _, ok := quoteSet[r.QuoteCoin]; !ok {
+	for i, r := range resp.Result.LinearInverse.List {
+		if _, ok := quoteSet[r.QuoteCoin]; quoteSet != nil && !ok {
 			continue
 		}
 		rules = append(rules, core.MarketRule{
@@ -129,14 +223,21 @@ func (c *BybitFuturesClient) FetchMarketRules(quotes []string) ([]core.MarketRul
 			MaxQty:         decimal.NewFromFloat(core.ToFloat(r.LotSizeFilter.MaxOrderQty)),
 			TickSize:       decimal.RequireFromString(r.PriceFilter.TickSize),
 			StepSize:       decimal.RequireFromString(r.LotSizeFilter.QtyStep),
+			MinNotional:    core.ParseStringDecimal(filters[i].LotSizeFilter.MinNotionalValue),
+			MarketMaxQty:   core.ParseStringDecimal(filters[i].LotSizeFilter.MaxMktOrderQty),
 		})
 	}
-	if len(rules) == 0 {
-		return nil, fmt.Errorf("no market rules found for quotes: %v", quotes)
-	}
 	return rules, nil
 }
 
+// instrumentFilters holds the fields of a linear instrument used by FetchMarketRules
+type instrumentFilters struct {
+	LotSizeFilter struct {
+		MinNotionalValue string `json:"minNotionalValue"`
+		MaxMktOrderQty   string `json:"maxMktOrderQty"`
+	} `json:"lotSizeFilter"`
+}
+
 // FuturesOrderbookMessage represents the structure of Bybit futures orderbook WebSocket messages
 type FuturesOrderbookMessage struct {
 	Topic string `json:"topic"`
@@ -158,97 +259,168 @@ func (c *BybitFuturesClient) SubscribeQuotes(ctx context.Context, symbols []stri
 	quoteChans := make(map[string]chan core.Quote)
 
 	// Create channels for each symbol
-	for _, symbol := range symbols {
+	topics := make([]string, len(symbols))
+	for i, symbol := range symbols {
 		quoteChan := make(chan core.Quote, 1)
 		quoteChans[symbol] = quoteChan
+		topics[i] = fmt.Sprintf("orderbook.1.%s", symbol)
 	}
 
-	// Connect to Bybit linear/futures WebSocket
-	u, _ := url.Parse("wss://stream.bybit.com/v5/public/linear")
-	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
-	if err != nil {
+	stream := newPublicStream("wss://stream.bybit.com/v5/public/linear", topics, func(message []byte) {
+		// Parse orderbook message
+		var msg FuturesOrderbookMessage
+		if err := json.Unmarshal(message, &msg); err != nil {
+			return // Skip non-orderbook messages
+		}
+
+		// Process only orderbook data
+		if msg.Topic != "" && len(msg.Topic) > 10 && msg.Topic[:10] == "orderbook." {
+			// Extract symbol from topic (format: orderbook.1.BTCUSDT)
+			if len(msg.Topic) < 13 {
+				return
+			}
+			symbol := msg.Topic[12:] // Skip "orderbook.1."
+
+			// Ensure we have valid bid/ask data
+			if len(msg.Data.Bids) > 0 && len(msg.Data.Asks) > 0 {
+				bid := msg.Data.Bids[0]
+				ask := msg.Data.Asks[0]
+
+				if len(bid) >= 2 && len(ask) >= 2 {
+					quote := core.Quote{
+						Symbol:   symbol,
+						BidPrice: decimal.RequireFromString(bid[0]),
+						BidQty:   decimal.RequireFromString(bid[1]),
+						AskPrice: decimal.RequireFromString(ask[0]),
+						AskQty:   decimal.RequireFromString(ask[1]),
+						Time:     time.UnixMilli(msg.TS),
+					}
+
+					if ch, exists := quoteChans[symbol]; exists {
+						select {
+						case ch <- quote:
+						default:
+							// Channel full, skip
+						}
+					}
+				}
+			}
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
 		return nil, fmt.Errorf("failed to connect to futures WebSocket: %w", err)
 	}
 
-	// Subscribe to orderbook for each symbol
-	for _, symbol := range symbols {
-		subMsg := map[string]interface{}{
-			"op":   "subscribe",
-			"args": []string{fmt.Sprintf("orderbook.1.%s", symbol)},
+	go func() {
+		<-stream.Done()
+		for _, ch := range quoteChans {
+			close(ch)
 		}
+	}()
 
-		if err := conn.WriteJSON(subMsg); err != nil {
-			if errHandler != nil {
-				errHandler(fmt.Errorf("failed to subscribe to %s: %w", symbol, err))
+	return quoteChans, nil
+}
+
+// newPublicStream creates a reconnecting public stream that (re)subscribes the given topics
+func newPublicStream(url string, topics []string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	stream := core.NewStream(url, func(s *core.Stream) error {
+		for _, topic := range topics {
+			subMsg := map[string]interface{}{
+				"op":   "subscribe",
+				"args": []string{topic},
 			}
-			continue
+			if err := s.WriteJSON(subMsg); err != nil {
+				return fmt.Errorf("failed to subscribe to %s: %w", topic, err)
+			}
+		}
+		return nil
+	}, handler, errHandler)
+	// Bybit drops public connections without a heartbeat
+	stream.PingMessage = []byte(`{"op":"ping"}`)
+	stream.PingInterval = 20 * time.Second
+	return stream
+}
+
+// orderbookStreamDepth returns the smallest linear orderbook stream depth covering depth
+func orderbookStreamDepth(depth int) int {
+	for _, d := range []int{1, 50, 200} {
+		if depth <= d {
+			return d
 		}
 	}
+	return 500
+}
 
-	// Start reading messages
-	go func() {
-		defer func() {
-			conn.Close()
-			for _, ch := range quoteChans {
-				close(ch)
-			}
-		}()
+// SubscribeOrderbook implements core.PublicClient interface
+// The first push of each topic is a snapshot followed by deltas, a gap triggers a resubscribe for a fresh snapshot
+func (c *BybitFuturesClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	obChans := make(map[string]<-chan core.Orderbook)
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				_, message, err := conn.ReadMessage()
-				if err != nil {
-					if errHandler != nil {
-						errHandler(fmt.Errorf("WebSocket read error: %w", err))
-					}
-					return
-				}
+	streamDepth := orderbookStreamDepth(depth)
+	books := make(map[string]*core.LocalOrderbook)
+	chans := make(map[string]chan core.Orderbook)
+	topics := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		topic := fmt.Sprintf("orderbook.%d.%s", streamDepth, symbol)
+		books[topic] = core.NewLocalOrderbook(symbol, depth)
+		chans[topic] = make(chan core.Orderbook, 1)
+		obChans[symbol] = chans[topic]
+		topics[i] = topic
+	}
 
-				// Parse orderbook message
-				var msg FuturesOrderbookMessage
-				if err := json.Unmarshal(message, &msg); err != nil {
-					continue // Skip non-orderbook messages
-				}
+	var stream *core.Stream
+	stream = newPublicStream("wss://stream.bybit.com/v5/public/linear", topics, func(message []byte) {
+		var msg FuturesOrderbookMessage
+		if err := json.Unmarshal(message, &msg); err != nil {
+			return
+		}
+		book, exists := books[msg.Topic]
+		if !exists {
+			return
+		}
+		bids := core.ParseOrderbookLevels(msg.Data.Bids)
+		asks := core.ParseOrderbookLevels(msg.Data.Asks)
 
-				// Process only orderbook data
-				if msg.Topic != "" && len(msg.Topic) > 10 && msg.Topic[:10] == "orderbook." {
-					// Extract symbol from topic (format: orderbook.1.BTCUSDT)
-					if len(msg.Topic) < 13 {
-						continue
-					}
-					symbol := msg.Topic[12:] // Skip "orderbook.1."
-
-					// Ensure we have valid bid/ask data
-					if len(msg.Data.Bids) > 0 && len(msg.Data.Asks) > 0 {
-						bid := msg.Data.Bids[0]
-						ask := msg.Data.Asks[0]
-
-						if len(bid) >= 2 && len(ask) >= 2 {
-							quote := core.Quote{
-								Symbol:   symbol,
-								BidPrice: decimal.RequireFromString(bid[0]),
-								BidQty:   decimal.RequireFromString(bid[1]),
-								AskPrice: decimal.RequireFromString(ask[0]),
-								AskQty:   decimal.RequireFromString(ask[1]),
-								Time:     time.UnixMilli(msg.TS),
-							}
-
-							if ch, exists := quoteChans[symbol]; exists {
-								select {
-								case ch <- quote:
-								default:
-									// Channel full, skip
-								}
-							}
-						}
+		switch msg.Type {
+		case "snapshot":
+			book.ApplySnapshot(msg.Data.U, bids, asks)
+		case "delta":
+			applied, err := book.ApplyDiff(msg.Data.U, msg.Data.U, bids, asks)
+			if err != nil {
+				if errors.Is(err, core.ErrOrderbookGap) {
+					if errHandler != nil {
+						errHandler(fmt.Errorf("%s orderbook resync: %w", book.Symbol, err))
 					}
+					// resubscribe to receive a fresh snapshot
+					stream.WriteJSON(map[string]interface{}{"op": "unsubscribe", "args": []string{msg.Topic}})
+					stream.WriteJSON(map[string]interface{}{"op": "subscribe", "args": []string{msg.Topic}})
 				}
+				return
+			}
+			if !applied {
+				return
 			}
+		default:
+			return
+		}
+		core.SendOrderbook(chans[msg.Topic], book.Orderbook())
+	}, errHandler)
+	// the replayed subscriptions start with a fresh snapshot
+	stream.OnReconnect = func() {
+		for _, book := range books {
+			book.Invalidate()
+		}
+	}
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to futures WebSocket: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
 		}
 	}()
 
-	return quoteChans, nil
+	return obChans, nil
 }
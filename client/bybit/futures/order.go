@@ -1,8 +1,8 @@
 package bybit
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"strconv"
 	"strings"
@@ -22,10 +22,12 @@ type OrderOptions struct {
 	TimeInForce      string // "GTC", "IOC", "FOK", "PostOnly"
 	TriggerPrice     decimal.Decimal
 	TriggerDirection int
+	TriggerBy        string // "LastPrice", "MarkPrice" or "IndexPrice"
 	OrderLinkID      string
 	ReduceOnly       bool
 	CloseOnTrigger   bool
 	MarketUnit       string
+	SmpType          string // "CancelTaker", "CancelMaker" or "CancelBoth"
 }
 
 func orderOptionsToParams(opt OrderOptions) map[string]interface{} {
@@ -53,16 +55,26 @@ func orderOptionsToParams(opt OrderOptions) map[string]interface{} {
 	if opt.TriggerDirection != 0 {
 		params["triggerDirection"] = opt.TriggerDirection
 	}
+	if opt.TriggerBy != "" {
+		params["triggerBy"] = opt.TriggerBy
+	}
 	if opt.ReduceOnly {
 		params["reduceOnly"] = true
 	}
 	if opt.CloseOnTrigger {
 		params["closeOnTrigger"] = true
 	}
+	if opt.SmpType != "" {
+		params["smpType"] = opt.SmpType
+	}
 	return params
 }
 
 func (c *BybitFuturesClient) wsPlaceOrder(opt OrderOptions) (*core.OrderResponse, error) {
+	return c.wsPlaceOrderCtx(context.Background(), opt)
+}
+
+func (c *BybitFuturesClient) wsPlaceOrderCtx(ctx context.Context, opt OrderOptions) (*core.OrderResponse, error) {
 	id := nextWSID()
 	params := orderOptionsToParams(opt)
 	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
@@ -76,7 +88,7 @@ func (c *BybitFuturesClient) wsPlaceOrder(opt OrderOptions) (*core.OrderResponse
 		"op":     "order.create",
 		"args":   []interface{}{params},
 	}
-	root, err := c.WsClient.SendRequest(req)
+	root, err := c.WsClient.SendRequestCtx(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -84,6 +96,12 @@ func (c *BybitFuturesClient) wsPlaceOrder(opt OrderOptions) (*core.OrderResponse
 	if err != nil {
 		return nil, err
 	}
+	c.placedOrder(res, opt)
+	return res, nil
+}
+
+// placedOrder completes the response of a placed order from its options and tracks it
+func (c *BybitFuturesClient) placedOrder(res *core.OrderResponse, opt OrderOptions) {
 	c.ordersMu.Lock()
 	c.orders[res.OrderID] = res
 	c.ordersMu.Unlock()
@@ -103,7 +121,191 @@ func (c *BybitFuturesClient) wsPlaceOrder(opt OrderOptions) (*core.OrderResponse
 		res.Side = core.OrderSideSell
 	}
 	res.Status = core.OrderStatusOpen
-	return res, nil
+}
+
+// PlaceOrder implements core.PrivateClient, the client order id is sent as orderLinkId.
+// Linear orders are sized in base, a quote quantity is not supported.
+func (c *BybitFuturesClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := c.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	opt, err := requestOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.wsPlaceOrderCtx(ctx, opt)
+	if err != nil {
+		return core.ResolvePlacement(c, req, err)
+	}
+	return resp, nil
+}
+
+// requestOptions maps a prepared request to the order options
+func requestOptions(req core.OrderRequest) (OrderOptions, error) {
+	if !req.QuoteQuantity.IsZero() {
+		return OrderOptions{}, core.ErrUnsupportedOrder("bybit futures", "quote quantity")
+	}
+	opt := OrderOptions{
+		Symbol:      req.Symbol,
+		Side:        "Buy",
+		OrderType:   "Market",
+		Qty:         req.Quantity,
+		OrderLinkID: req.ClientOrderID,
+		ReduceOnly:  req.ReduceOnly,
+		SmpType:     smpTypes[req.STPMode],
+	}
+	if req.Side == core.OrderSideSell {
+		opt.Side = "Sell"
+	}
+	if req.Type == core.OrderTypeLimit {
+		opt.OrderType = "Limit"
+		opt.Price = req.Price
+		opt.TimeInForce = string(req.TimeInForce)
+		if req.PostOnly {
+			opt.TimeInForce = "PostOnly"
+		}
+	}
+	return opt, nil
+}
+
+// batch size limits of order.create-batch and order.cancel-batch
+const batchSize = 20
+
+// PlaceOrders implements core.PrivateClient with order.create-batch
+func (c *BybitFuturesClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return c.rules.PlaceBatches(ctx, reqs, batchSize, c.placeBatch)
+}
+
+func (c *BybitFuturesClient) placeBatch(ctx context.Context, reqs []core.OrderRequest) []core.OrderResult {
+	results := make([]core.OrderResult, len(reqs))
+	var sent []core.OrderRequest
+	var opts []OrderOptions
+	var index []int
+	var request []interface{}
+	for i, req := range reqs {
+		opt, err := requestOptions(req)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		params := orderOptionsToParams(opt)
+		delete(params, "category") // set once for the batch
+		request = append(request, params)
+		sent = append(sent, req)
+		opts = append(opts, opt)
+		index = append(index, i)
+	}
+	if len(request) == 0 {
+		return results
+	}
+	root, err := c.sendBatch(ctx, "order.create-batch", request)
+	var batchResults []core.OrderResult
+	if err == nil {
+		batchResults, err = parseBatchResults(root, len(sent))
+	}
+	if err != nil {
+		batchResults = core.ResolveBatch(c, sent, err)
+	} else {
+		for j, res := range batchResults {
+			if res.Order != nil {
+				c.placedOrder(res.Order, opts[j])
+			}
+		}
+	}
+	for j, res := range batchResults {
+		results[index[j]] = res
+	}
+	return results
+}
+
+// CancelOrders implements core.PrivateClient with order.cancel-batch
+func (c *BybitFuturesClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelBatches(ctx, orderIds, batchSize, func(ctx context.Context, orderIds []string) []core.OrderResult {
+		request := make([]interface{}, len(orderIds))
+		for i, orderId := range orderIds {
+			request[i] = map[string]interface{}{"symbol": symbol, "orderId": orderId}
+		}
+		root, err := c.sendBatch(ctx, "order.cancel-batch", request)
+		var results []core.OrderResult
+		if err == nil {
+			results, err = parseBatchResults(root, len(orderIds))
+		}
+		if err != nil {
+			return core.FailBatch(len(orderIds), err)
+		}
+		for _, res := range results {
+			if res.Order != nil {
+				res.Order.Symbol = symbol
+				res.Order.Status = core.OrderStatusCanceled
+				c.ordersMu.Lock()
+				delete(c.orders, res.Order.OrderID)
+				c.ordersMu.Unlock()
+			}
+		}
+		return results
+	})
+}
+
+// sendBatch sends a batch op for the orders of request
+func (c *BybitFuturesClient) sendBatch(ctx context.Context, op string, request []interface{}) (map[string]json.RawMessage, error) {
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	return c.WsClient.SendRequestCtx(ctx, map[string]interface{}{
+		"reqId": nextWSID(),
+		"header": map[string]interface{}{
+			"X-BAPI-TIMESTAMP":   timestamp,
+			"X-BAPI-RECV-WINDOW": "8000",
+		},
+		"op": op,
+		"args": []interface{}{map[string]interface{}{
+			"category": "linear",
+			"request":  request,
+		}},
+	})
+}
+
+// parseBatchResults parses a batch op response, data.list has an order and retExtInfo.list
+// the result code of every item
+func parseBatchResults(root map[string]json.RawMessage, n int) ([]core.OrderResult, error) {
+	var data struct {
+		List []struct {
+			OrderID     string `json:"orderId"`
+			OrderLinkID string `json:"orderLinkId"`
+		} `json:"list"`
+	}
+	var ext struct {
+		List []struct {
+			Code int    `json:"code"`
+			Msg  string `json:"msg"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal(root["data"], &data); err != nil {
+		return nil, fmt.Errorf("failed to decode batch response: %w", err)
+	}
+	if err := json.Unmarshal(root["retExtInfo"], &ext); err != nil {
+		return nil, fmt.Errorf("failed to decode batch response: %w", err)
+	}
+	if len(data.List) != n || len(ext.List) != n {
+		return nil, fmt.Errorf("batch response has %d items for %d orders", len(data.List), n)
+	}
+	results := make([]core.OrderResult, n)
+	for i, item := range data.List {
+		if ext.List[i].Code != 0 {
+			results[i].Err = wrapBybitErrorCode(ext.List[i].Code, ext.List[i].Msg)
+			continue
+		}
+		results[i].Order = &core.OrderResponse{
+			OrderID:       item.OrderID,
+			ClientOrderID: item.OrderLinkID,
+		}
+	}
+	return results, nil
+}
+
+// smpTypes maps self-trade prevention modes to the bybit smpType
+var smpTypes = map[core.STPMode]string{
+	core.STPExpireTaker: "CancelTaker",
+	core.STPExpireMaker: "CancelMaker",
+	core.STPExpireBoth:  "CancelBoth",
 }
 
 func parseOrderResponse(root map[string]json.RawMessage) (*core.OrderResponse, error) {
@@ -112,7 +314,10 @@ func parseOrderResponse(root map[string]json.RawMessage) (*core.OrderResponse, e
 	if err := json.Unmarshal(root["retCode"], &retCode); err != nil || retCode != 0 {
 		var retMsg string
 		_ = json.Unmarshal(root["retMsg"], &retMsg)
-		return nil, fmt.Errorf("bybit ws order error: %v, msg: %v", retCode, retMsg)
+		if retCode == 0 {
+			return nil, fmt.Errorf("bybit ws order error: missing retCode, msg: %v", retMsg)
+		}
+		return nil, wrapBybitErrorCode(retCode, retMsg)
 	}
 
 	// data 필드 파싱
@@ -127,7 +332,8 @@ func parseOrderResponse(root map[string]json.RawMessage) (*core.OrderResponse, e
 	}
 
 	return &core.OrderResponse{
-		OrderID: data.OrderID,
+		OrderID:       data.OrderID,
+		ClientOrderID: data.OrderLinkID,
 		// 필수 필드만 (symbol, price 등 없음)
 	}, nil
 }
@@ -252,66 +458,367 @@ func (c *BybitFuturesClient) TakeProfitTrailingSell(symbol string, qty, triggerP
 }
 
 func (c *BybitFuturesClient) FetchOrder(symbol, orderId string) (*core.OrderResponseFull, error) {
-	param := bybit.V5GetOpenOrdersParam{
+	return c.fetchOrder(bybit.V5GetOpenOrdersParam{
 		Category: "linear",
 		Symbol:   &symbol,
 		OrderID:  &orderId,
-	}
+	})
+}
+
+// FetchOrderByClientID implements core.PrivateClient, the client order id is the orderLinkId
+func (c *BybitFuturesClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	return c.fetchOrder(bybit.V5GetOpenOrdersParam{
+		Category:    "linear",
+		Symbol:      &symbol,
+		OrderLinkID: &clientOrderID,
+	})
+}
+
+func (c *BybitFuturesClient) fetchOrder(param bybit.V5GetOpenOrdersParam) (*core.OrderResponseFull, error) {
 	resp, err := c.client.V5().Order().GetOpenOrders(param)
 	if err != nil {
 		return nil, handleBybitError(err)
 	}
 	if len(resp.Result.List) == 0 {
-		return nil, errors.New("no order found")
+		return nil, core.NewExchangeError("bybit", core.ErrCategoryOrderNotFound, "", "no order found")
 	}
-	order := resp.Result.List[0]
+	// decoded like the order lists, which carry the same fields
+	orders, err := listedOrders(resp.Result.List)
+	if err != nil {
+		return nil, err
+	}
+	return &orders[0], nil
+}
 
-	toTime := func(s string) time.Time {
-		ms, _ := strconv.ParseInt(s, 10, 64)
-		return time.UnixMilli(ms)
+// orderListLimit and historyWindow bound one order listing request
+const (
+	orderListLimit = 50
+	historyWindow  = 7 * 24 * time.Hour
+)
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all USDT contracts
+func (c *BybitFuturesClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	limit := orderListLimit
+	param := bybit.V5GetOpenOrdersParam{
+		Category: "linear",
+		Limit:    &limit,
+	}
+	if symbol != "" {
+		param.Symbol = &symbol
+	} else {
+		settleCoin := bybit.CoinUSDT
+		param.SettleCoin = &settleCoin
 	}
-	toSide := func(s bybit.Side) core.OrderSide {
-		if s == bybit.SideBuy {
-			return core.OrderSideBuy
-		} else if s == bybit.SideSell {
-			return core.OrderSideSell
-		} else {
-			return ""
+	var orders []core.OrderResponseFull
+	for {
+		resp, err := c.client.V5().Order().GetOpenOrders(param)
+		if err != nil {
+			return nil, handleBybitError(err)
 		}
+		page, err := listedOrders(resp.Result.List)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, page...)
+		if resp.Result.NextPageCursor == "" || len(resp.Result.List) == 0 {
+			break
+		}
+		cursor := resp.Result.NextPageCursor
+		param.Cursor = &cursor
+	}
+	core.SortOrders(orders)
+	return orders, nil
+}
+
+// FetchOrderHistory implements core.PrivateClient, the span of a request is at most
+// seven days and its pages are followed by cursor
+func (c *BybitFuturesClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, historyWindow, 0, func(start, end time.Time, _ int) ([]core.OrderResponseFull, error) {
+		limit := orderListLimit
+		startTime, endTime := start.UnixMilli(), end.UnixMilli()
+		param := bybit.V5GetHistoryOrdersParam{
+			Category:  "linear",
+			Symbol:    &symbol,
+			StartTime: &startTime,
+			EndTime:   &endTime,
+			Limit:     &limit,
+		}
+		var orders []core.OrderResponseFull
+		for {
+			resp, err := c.client.V5().Order().GetHistoryOrders(param)
+			if err != nil {
+				return nil, handleBybitError(err)
+			}
+			page, err := listedOrders(resp.Result.List)
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, page...)
+			if resp.Result.NextPageCursor == "" || len(resp.Result.List) == 0 {
+				return orders, nil
+			}
+			cursor := resp.Result.NextPageCursor
+			param.Cursor = &cursor
+		}
+	})
+}
+
+// FetchFills implements core.PrivateClient with the execution list, the span of a
+// request is at most seven days and its pages are followed by cursor
+func (c *BybitFuturesClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, historyWindow, 0, func(start, end time.Time, _ int) ([]core.OrderFill, error) {
+		startTime, endTime := start.UnixMilli(), end.UnixMilli()
+		return c.executions(bybit.V5GetExecutionParam{
+			Category:  "linear",
+			Symbol:    &symbol,
+			StartTime: &startTime,
+			EndTime:   &endTime,
+		})
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (c *BybitFuturesClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := c.executions(bybit.V5GetExecutionParam{
+		Category: "linear",
+		Symbol:   &symbol,
+		OrderID:  &orderId,
+	})
+	if err != nil {
+		return nil, err
 	}
-	toOrderStatus := func(s bybit.OrderStatus) core.OrderStatus {
-		switch s {
-		case bybit.OrderStatusActive, bybit.OrderStatusCreated, bybit.OrderStatusNew, bybit.OrderStatusPartiallyFilled, bybit.OrderStatusPendingCancel, bybit.OrderStatusTriggered, bybit.OrderStatusUntriggered:
-			return core.OrderStatusOpen
-		case bybit.OrderStatusFilled:
-			return core.OrderStatusFilled
-		case bybit.OrderStatusCancelled:
-			return core.OrderStatusCanceled
-		default:
-			return core.OrderStatusError
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// executions follows the pages of an execution list request
+func (c *BybitFuturesClient) executions(param bybit.V5GetExecutionParam) ([]core.OrderFill, error) {
+	limit := orderListLimit
+	param.Limit = &limit
+	var fills []core.OrderFill
+	for {
+		resp, err := c.client.V5().Execution().GetExecutionList(param)
+		if err != nil {
+			return nil, handleBybitError(err)
+		}
+		// decoded through the json form like the order lists
+		raw, err := json.Marshal(resp.Result.List)
+		if err != nil {
+			return nil, err
+		}
+		var items []execution
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return nil, fmt.Errorf("failed to decode executions: %w", err)
 		}
+		for _, item := range items {
+			fills = append(fills, item.fill())
+		}
+		if resp.Result.NextPageCursor == "" || len(items) == 0 {
+			return fills, nil
+		}
+		cursor := resp.Result.NextPageCursor
+		param.Cursor = &cursor
+	}
+}
+
+// execution holds the fields of an execution list item
+type execution struct {
+	ExecID      string `json:"execId"`
+	OrderID     string `json:"orderId"`
+	Symbol      string `json:"symbol"`
+	Side        string `json:"side"`
+	ExecPrice   string `json:"execPrice"`
+	ExecQty     string `json:"execQty"`
+	ExecFee     string `json:"execFee"`
+	FeeCurrency string `json:"feeCurrency"` // spot only, not sent by older api versions
+	ExecTime    string `json:"execTime"`
+	IsMaker     bool   `json:"isMaker"`
+}
+
+// fill converts an execution, fees are charged in the settle coin
+func (e execution) fill() core.OrderFill {
+	side := core.OrderSide(strings.ToUpper(e.Side))
+	price, _ := decimal.NewFromString(e.ExecPrice)
+	qty, _ := decimal.NewFromString(e.ExecQty)
+	fee, _ := decimal.NewFromString(e.ExecFee)
+	ms, _ := strconv.ParseInt(e.ExecTime, 10, 64)
+	feeAsset := "USDT"
+	if strings.HasSuffix(e.Symbol, "USDC") || strings.HasSuffix(e.Symbol, "PERP") {
+		feeAsset = "USDC"
+	}
+	return core.OrderFill{
+		TradeID:   e.ExecID,
+		OrderID:   e.OrderID,
+		Symbol:    e.Symbol,
+		Side:      side,
+		Price:     price,
+		Quantity:  qty,
+		Fee:       fee,
+		FeeAsset:  feeAsset,
+		IsMaker:   e.IsMaker,
+		TradeTime: time.UnixMilli(ms),
+		IsBuyer:   side == core.OrderSideBuy,
 	}
+}
+
+// listedOrder holds the fields shared by the open and history order lists
+type listedOrder struct {
+	OrderID      string `json:"orderId"`
+	OrderLinkID  string `json:"orderLinkId"`
+	Symbol       string `json:"symbol"`
+	Side         string `json:"side"`
+	TimeInForce  string `json:"timeInForce"`
+	OrderStatus  string `json:"orderStatus"`
+	RejectReason string `json:"rejectReason"`
+	CancelType   string `json:"cancelType"`
+	Price        string `json:"price"`
+	Qty          string `json:"qty"`
+	AvgPrice     string `json:"avgPrice"`
+	CumExecQty   string `json:"cumExecQty"`
+	CumExecFee   string `json:"cumExecFee"`
+	CreatedTime  string `json:"createdTime"`
+	UpdatedTime  string `json:"updatedTime"`
+}
 
-	avgPrice, err := decimal.NewFromString(order.AvgPrice)
+// listedOrders converts an order list of the SDK through its json form, the open and
+// history lists are distinct types with the same fields
+func listedOrders(list interface{}) ([]core.OrderResponseFull, error) {
+	raw, err := json.Marshal(list)
 	if err != nil {
-		avgPrice = decimal.Zero
+		return nil, err
+	}
+	var items []listedOrder
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode orders: %w", err)
+	}
+	orders := make([]core.OrderResponseFull, len(items))
+	for i, item := range items {
+		orders[i] = item.full()
 	}
+	return orders, nil
+}
 
-	return &core.OrderResponseFull{
+func (o listedOrder) full() core.OrderResponseFull {
+	toTime := func(s string) time.Time {
+		ms, _ := strconv.ParseInt(s, 10, 64)
+		return time.UnixMilli(ms)
+	}
+	status, reason := orderStatus(o.OrderStatus, o.RejectReason, o.CancelType)
+	price, _ := decimal.NewFromString(o.Price)
+	qty, _ := decimal.NewFromString(o.Qty)
+	avgPrice, _ := decimal.NewFromString(o.AvgPrice)
+	execQty, _ := decimal.NewFromString(o.CumExecQty)
+	fee, _ := decimal.NewFromString(o.CumExecFee)
+	return core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
-			OrderID:    order.OrderID,
-			Symbol:     order.Symbol,
-			Side:       toSide(order.Side),
-			Tif:        core.TimeInForce(order.TimeInForce),
-			Status:     toOrderStatus(order.OrderStatus),
-			Price:      decimal.RequireFromString(order.Price),
-			Quantity:   decimal.RequireFromString(order.Qty),
-			CreateTime: toTime(order.CreatedTime),
+			OrderID:       o.OrderID,
+			ClientOrderID: o.OrderLinkID,
+			Symbol:        o.Symbol,
+			Side:          core.OrderSide(strings.ToUpper(o.Side)),
+			Tif:           core.TimeInForce(o.TimeInForce),
+			Status:        status,
+			Reason:        reason,
+			Price:         price,
+			Quantity:      qty,
+			CreateTime:    toTime(o.CreatedTime),
 		},
 		AvgPrice:        avgPrice,
-		ExecutedQty:     decimal.RequireFromString(order.CumExecQty),
-		Commission:      decimal.RequireFromString(order.CumExecFee),
-		CommissionAsset: SymbolToAsset(symbol),
-		UpdateTime:      toTime(order.UpdatedTime),
+		ExecutedQty:     execQty,
+		Commission:      fee,
+		CommissionAsset: SymbolToAsset(o.Symbol),
+		UpdateTime:      toTime(o.UpdatedTime),
+	}
+}
+
+// orderStatus maps an order status, the reject reason and cancel type tell the expiries and
+// rejects among the cancelled orders and are passed on as the reason
+func orderStatus(status, rejectReason, cancelType string) (core.OrderStatus, string) {
+	reason := ""
+	if rejectReason != "" && rejectReason != "EC_NoError" {
+		reason = rejectReason
+	} else if cancelType != "" && cancelType != "UNKNOWN" {
+		reason = cancelType
+	}
+	switch status {
+	case "Created", "New", "Active", "Untriggered", "Triggered":
+		return core.OrderStatusOpen, ""
+	case "PartiallyFilled":
+		return core.OrderStatusPartiallyFilled, ""
+	case "PendingCancel":
+		return core.OrderStatusPendingCancel, ""
+	case "Filled":
+		return core.OrderStatusFilled, ""
+	case "Rejected":
+		return core.OrderStatusRejected, reason
+	case "Cancelled", "PartiallyFilledCanceled", "Deactivated":
+		switch {
+		case rejectReason == "EC_PostOnlyWillTakeLiquidity":
+			return core.OrderStatusRejected, reason
+		case rejectReason == "EC_CancelForNoFullFill", cancelType == "CancelBySmp":
+			return core.OrderStatusExpired, reason // time in force or self-trade prevention
+		}
+		return core.OrderStatusCanceled, reason
+	default:
+		return core.OrderStatusError, reason
+	}
+}
+
+// AmendOrder implements core.PrivateClient with order.amend, bybit keeps queue priority
+// when only the quantity is reduced
+func (c *BybitFuturesClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	if err := core.ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	c.ordersMu.RLock()
+	cached, ok := c.orders[orderId]
+	c.ordersMu.RUnlock()
+	var prev core.OrderResponse
+	if ok {
+		prev = *cached
+	} else {
+		ord, err := c.FetchOrder(symbol, orderId)
+		if err != nil {
+			return nil, err
+		}
+		prev = ord.OrderResponse
+	}
+
+	timestamp := strconv.FormatInt(time.Now().UnixMilli(), 10)
+	req := map[string]interface{}{
+		"reqId": nextWSID(),
+		"header": map[string]interface{}{
+			"X-BAPI-TIMESTAMP":   timestamp,
+			"X-BAPI-RECV-WINDOW": "8000",
+		},
+		"op": "order.amend",
+		"args": []interface{}{map[string]interface{}{
+			"category": "linear",
+			"symbol":   symbol,
+			"orderId":  orderId,
+			"qty":      newQty.String(),
+			"price":    newPrice.String(),
+		}},
+	}
+	root, err := c.WsClient.SendRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := parseOrderResponse(root); err != nil {
+		return nil, err
+	}
+
+	amended := prev
+	amended.OrderID = orderId
+	amended.Symbol = symbol
+	amended.Quantity = newQty
+	amended.Price = newPrice
+	amended.Status = core.OrderStatusOpen
+	c.ordersMu.Lock()
+	c.orders[orderId] = &amended
+	c.ordersMu.Unlock()
+	return &core.AmendResult{
+		Order:           &amended,
+		OriginalOrderID: orderId,
+		KeptPriority:    core.AmendKeepsPriority(prev.Quantity, prev.Price, newQty, newPrice),
 	}, nil
 }
@@ -0,0 +1,69 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// SubscribeTrades implements core.PublicClient with the publicTrade topic
+func (c *BybitFuturesClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	tradeChans := make(map[string]<-chan core.Trade)
+	chans := make(map[string]chan core.Trade)
+	topics := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Trade, 256)
+		tradeChans[symbol] = chans[symbol]
+		topics[i] = "publicTrade." + symbol
+	}
+
+	stream := newPublicStream("wss://stream.bybit.com/v5/public/linear", topics, func(message []byte) {
+		var msg struct {
+			Topic string `json:"topic"`
+			Data  []struct {
+				Time    int64  `json:"T"`
+				Symbol  string `json:"s"`
+				Side    string `json:"S"` // taker side, Buy or Sell
+				Size    string `json:"v"`
+				Price   string `json:"p"`
+				TradeID string `json:"i"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(message, &msg); err != nil {
+			return
+		}
+		for _, t := range msg.Data {
+			ch, exists := chans[t.Symbol]
+			if !exists {
+				continue
+			}
+			side := core.OrderSideBuy
+			if t.Side == "Sell" {
+				side = core.OrderSideSell
+			}
+			core.SendTrade(ctx, ch, core.Trade{
+				Symbol:   t.Symbol,
+				TradeID:  t.TradeID,
+				Price:    core.ParseStringDecimal(t.Price),
+				Quantity: core.ParseStringDecimal(t.Size),
+				Side:     side,
+				Time:     time.UnixMilli(t.Time),
+			})
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return tradeChans, fmt.Errorf("failed to connect to linear WebSocket: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return tradeChans, nil
+}
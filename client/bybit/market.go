@@ -6,10 +6,8 @@ import (
 	"errors"
 	"fmt"
 	"math"
-	"net/url"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/hirokisan/bybit/v2"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
@@ -28,10 +26,10 @@ func (c *BybitClient) GetTicker(symbol string) (*core.Ticker, error) {
 	t := resp.Result.Spot.List[0]
 	return &core.Ticker{
 		Symbol:   t.Symbol,
-		BidPrice: core.ToFloat(t.Bid1Price),
-		BidQty:   core.ToFloat(t.Bid1Size),
-		AskPrice: core.ToFloat(t.Ask1Price),
-		AskQty:   core.ToFloat(t.Ask1Size),
+		BidPrice: core.ParseStringDecimal(t.Bid1Price),
+		BidQty:   core.ParseStringDecimal(t.Bid1Size),
+		AskPrice: core.ParseStringDecimal(t.Ask1Price),
+		AskQty:   core.ParseStringDecimal(t.Ask1Size),
 		Time:     time.Now(),
 	}, nil
 }
@@ -48,8 +46,91 @@ func (c *BybitClient) GetTickers(symbols []string) ([]core.Ticker, error) {
 	return out, nil
 }
 
-func (b *BybitClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
-	return make(map[string]core.Quote), fmt.Errorf("not implemented yet")
+// FetchTickers implements core.PublicClient, all spot tickers come in one request and are
+// filtered
+func (c *BybitClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	resp, err := c.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
+		Category: "spot",
+	})
+	if err != nil {
+		return nil, err
+	}
+	// decoded through the json form of the SDK list for the 24h fields
+	raw, err := json.Marshal(resp.Result.Spot.List)
+	if err != nil {
+		return nil, err
+	}
+	var items []tickerItem
+	if err := json.Unmarshal(raw, &items); err != nil {
+		return nil, fmt.Errorf("failed to decode tickers: %w", err)
+	}
+	now := time.Now()
+	tickers := make([]core.Ticker, len(items))
+	for i, t := range items {
+		tickers[i] = core.Ticker{
+			Symbol:        t.Symbol,
+			BidPrice:      core.ParseStringDecimal(t.Bid1Price),
+			BidQty:        core.ParseStringDecimal(t.Bid1Size),
+			AskPrice:      core.ParseStringDecimal(t.Ask1Price),
+			AskQty:        core.ParseStringDecimal(t.Ask1Size),
+			LastPrice:     core.ParseStringDecimal(t.LastPrice),
+			OpenPrice:     core.ParseStringDecimal(t.PrevPrice24h),
+			HighPrice:     core.ParseStringDecimal(t.HighPrice24h),
+			LowPrice:      core.ParseStringDecimal(t.LowPrice24h),
+			Volume:        core.ParseStringDecimal(t.Volume24h),
+			QuoteVolume:   core.ParseStringDecimal(t.Turnover24h),
+			ChangePercent: core.ParseStringDecimal(t.Price24hPcnt).Shift(2), // a fraction
+			Time:          now,
+		}
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// tickerItem holds the fields of a ticker used by FetchTickers
+type tickerItem struct {
+	Symbol       string `json:"symbol"`
+	Bid1Price    string `json:"bid1Price"`
+	Bid1Size     string `json:"bid1Size"`
+	Ask1Price    string `json:"ask1Price"`
+	Ask1Size     string `json:"ask1Size"`
+	LastPrice    string `json:"lastPrice"`
+	PrevPrice24h string `json:"prevPrice24h"`
+	Price24hPcnt string `json:"price24hPcnt"`
+	HighPrice24h string `json:"highPrice24h"`
+	LowPrice24h  string `json:"lowPrice24h"`
+	Volume24h    string `json:"volume24h"`
+	Turnover24h  string `json:"turnover24h"`
+}
+
+// FetchQuotes implements core.PublicClient, all spot tickers come in one request and are
+// filtered
+func (c *BybitClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
+	resp, err := c.client.V5().Market().GetTickers(bybit.V5GetTickersParam{
+		Category: "spot",
+	})
+	if err != nil {
+		return nil, err
+	}
+	want := make(map[string]bool, len(symbols))
+	for _, s := range symbols {
+		want[s] = true
+	}
+	now := time.Now()
+	out := make(map[string]core.Quote)
+	for _, t := range resp.Result.Spot.List {
+		if len(symbols) > 0 && !want[t.Symbol] {
+			continue
+		}
+		out[t.Symbol] = core.Quote{
+			Symbol:   t.Symbol,
+			BidPrice: core.ParseStringDecimal(t.Bid1Price),
+			BidQty:   core.ParseStringDecimal(t.Bid1Size),
+			AskPrice: core.ParseStringDecimal(t.Ask1Price),
+			AskQty:   core.ParseStringDecimal(t.Ask1Size),
+			Time:     now,
+		}
+	}
+	return out, core.MissingSymbols("bybit", symbols, out)
 }
 
 func (c *BybitClient) GetOrderbook(symbol string, depth int64) (*core.Orderbook, error) {
@@ -77,32 +158,65 @@ func (c *BybitClient) GetOrderbook(symbol string, depth int64) (*core.Orderbook,
 	return ob, nil
 }
 
+// initRules sets up the rule cache that orders are rounded and validated against
+func (c *BybitClient) initRules() {
+	c.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		instrument := bybit.SymbolV5(symbol)
+		return c.fetchMarketRules(&instrument, nil)
+	}, core.DefaultRuleTTL)
+}
+
 func (c *BybitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
 	if len(quotes) == 0 {
 		return nil, errors.New("empty quotes")
 	}
+	quoteSet := make(map[string]struct{})
+	for _, q := range quotes {
+		quoteSet[q] = struct{}{}
+	}
+	rules, err := c.fetchMarketRules(nil, quoteSet)
+	if err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("no market rules found for quotes: %v", quotes)
+	}
+	return rules, nil
+}
+
+// fetchMarketRules returns the rules of symbol, of all instruments when it is nil, whose
+// quote is in quoteSet, of any quote when quoteSet is nil
+func (c *BybitClient) fetchMarketRules(symbol *bybit.SymbolV5, quoteSet map[string]struct{}) ([]core.MarketRule, error) {
 	resp, err := c.client.V5().Market().GetInstrumentsInfo(bybit.V5GetInstrumentsInfoParam{
 		Category: "spot",
+		Symbol:   symbol,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	quoteSet := make(map[string]struct{})
-	for _, q := range quotes {
-		quoteSet[q] = struct{}{}
+	// decoded through the json form of the SDK list for the order value and price limits
+	raw, err := json.Marshal(resp.Result.Spot.List)
+	if err != nil {
+		return nil, err
 	}
+	var filters []instrumentFilters
+	if err := json.Unmarshal(raw, &filters); err != nil {
+		return nil, fmt.Errorf("failed to decode instruments: %w", err)
+	}
+
 	var rules []core.MarketRule
-	for _, r := range resp.Result.Spot.List {
-		if _, ok := quoteSet[r.QuoteCoin]; !ok {
+	for i, r := range resp.Result.Spot.List {
+		if _, ok := quoteSet[r.QuoteCoin]; quoteSet != nil && !ok {
 			continue
 		}
+		f := filters[i]
 
 		tickSize := decimal.RequireFromString(r.LotSizeFilter.QuotePrecision)
 		stepSize := decimal.RequireFromString(r.LotSizeFilter.BasePrecision)
 		pricePrecision := -tickSize.Exponent()
 		qtyPrecision := -stepSize.Exponent()
-		rules = append(rules, core.MarketRule{
+		rule := core.MarketRule{
 			Symbol:         r.Symbol,
 			BaseAsset:      r.BaseCoin,
 			QuoteAsset:     r.QuoteCoin,
@@ -114,14 +228,35 @@ func (c *BybitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, erro
 			MaxQty:         decimal.RequireFromString(r.LotSizeFilter.MaxOrderQty),
 			TickSize:       decimal.RequireFromString(r.PriceFilter.TickSize),
 			StepSize:       stepSize,
-		})
-	}
-	if len(rules) == 0 {
-		return nil, fmt.Errorf("no market rules found for quotes: %v", quotes)
+			MinNotional:    core.ParseStringDecimal(f.LotSizeFilter.MinOrderAmt),
+			MaxNotional:    core.ParseStringDecimal(f.LotSizeFilter.MaxOrderAmt),
+			MarketMaxQty:   core.ParseStringDecimal(f.LotSizeFilter.MaxMarketOrderQty),
+		}
+		// a buy may be at most X above and a sell at most Y below the last price
+		if x := core.ParseStringDecimal(f.RiskParameters.PriceLimitRatioX); x.IsPositive() {
+			rule.BuyPriceBand.Up = decimal.NewFromInt(1).Add(x)
+		}
+		if y := core.ParseStringDecimal(f.RiskParameters.PriceLimitRatioY); y.IsPositive() {
+			rule.SellPriceBand.Down = decimal.NewFromInt(1).Sub(y)
+		}
+		rules = append(rules, rule)
 	}
 	return rules, nil
 }
 
+// instrumentFilters holds the fields of a spot instrument used by FetchMarketRules
+type instrumentFilters struct {
+	LotSizeFilter struct {
+		MinOrderAmt       string `json:"minOrderAmt"`
+		MaxOrderAmt       string `json:"maxOrderAmt"`
+		MaxMarketOrderQty string `json:"maxMarketOrderQty"`
+	} `json:"lotSizeFilter"`
+	RiskParameters struct {
+		PriceLimitRatioX string `json:"priceLimitRatioX"`
+		PriceLimitRatioY string `json:"priceLimitRatioY"`
+	} `json:"riskParameters"`
+}
+
 // SpotOrderbookMessage represents the structure of Bybit spot orderbook WebSocket messages
 type SpotOrderbookMessage struct {
 	Topic string `json:"topic"`
@@ -143,97 +278,168 @@ func (c *BybitClient) SubscribeQuotes(ctx context.Context, symbols []string, err
 	quoteChans := make(map[string]chan core.Quote)
 
 	// Create channels for each symbol
-	for _, symbol := range symbols {
+	topics := make([]string, len(symbols))
+	for i, symbol := range symbols {
 		quoteChan := make(chan core.Quote, 1)
 		quoteChans[symbol] = quoteChan
+		topics[i] = fmt.Sprintf("orderbook.1.%s", symbol)
 	}
 
-	// Connect to Bybit spot WebSocket
-	u, _ := url.Parse("wss://stream.bybit.com/v5/public/spot")
-	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
-	if err != nil {
+	stream := newPublicStream("wss://stream.bybit.com/v5/public/spot", topics, func(message []byte) {
+		// Parse orderbook message
+		var msg SpotOrderbookMessage
+		if err := json.Unmarshal(message, &msg); err != nil {
+			return // Skip non-orderbook messages
+		}
+
+		// Process only orderbook data
+		if msg.Topic != "" && len(msg.Topic) > 10 && msg.Topic[:10] == "orderbook." {
+			// Extract symbol from topic (format: orderbook.1.BTCUSDT)
+			if len(msg.Topic) < 13 {
+				return
+			}
+			symbol := msg.Topic[12:] // Skip "orderbook.1."
+
+			// Ensure we have valid bid/ask data
+			if len(msg.Data.Bids) > 0 && len(msg.Data.Asks) > 0 {
+				bid := msg.Data.Bids[0]
+				ask := msg.Data.Asks[0]
+
+				if len(bid) >= 2 && len(ask) >= 2 {
+					quote := core.Quote{
+						Symbol:   symbol,
+						BidPrice: decimal.RequireFromString(bid[0]),
+						BidQty:   decimal.RequireFromString(bid[1]),
+						AskPrice: decimal.RequireFromString(ask[0]),
+						AskQty:   decimal.RequireFromString(ask[1]),
+						Time:     time.UnixMilli(msg.TS),
+					}
+
+					if ch, exists := quoteChans[symbol]; exists {
+						select {
+						case ch <- quote:
+						default:
+							// Channel full, skip
+						}
+					}
+				}
+			}
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
 		return nil, fmt.Errorf("failed to connect to spot WebSocket: %w", err)
 	}
 
-	// Subscribe to orderbook for each symbol
-	for _, symbol := range symbols {
-		subMsg := map[string]interface{}{
-			"op":   "subscribe",
-			"args": []string{fmt.Sprintf("orderbook.1.%s", symbol)},
+	go func() {
+		<-stream.Done()
+		for _, ch := range quoteChans {
+			close(ch)
 		}
+	}()
 
-		if err := conn.WriteJSON(subMsg); err != nil {
-			if errHandler != nil {
-				errHandler(fmt.Errorf("failed to subscribe to %s: %w", symbol, err))
+	return quoteChans, nil
+}
+
+// newPublicStream creates a reconnecting public stream that (re)subscribes the given topics
+func newPublicStream(url string, topics []string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	stream := core.NewStream(url, func(s *core.Stream) error {
+		for _, topic := range topics {
+			subMsg := map[string]interface{}{
+				"op":   "subscribe",
+				"args": []string{topic},
 			}
-			continue
+			if err := s.WriteJSON(subMsg); err != nil {
+				return fmt.Errorf("failed to subscribe to %s: %w", topic, err)
+			}
+		}
+		return nil
+	}, handler, errHandler)
+	// Bybit drops public connections without a heartbeat
+	stream.PingMessage = []byte(`{"op":"ping"}`)
+	stream.PingInterval = 20 * time.Second
+	return stream
+}
+
+// orderbookStreamDepth returns the smallest spot orderbook stream depth covering depth
+func orderbookStreamDepth(depth int) int {
+	for _, d := range []int{1, 50, 200} {
+		if depth <= d {
+			return d
 		}
 	}
+	return 1000
+}
 
-	// Start reading messages
-	go func() {
-		defer func() {
-			conn.Close()
-			for _, ch := range quoteChans {
-				close(ch)
-			}
-		}()
+// SubscribeOrderbook implements core.PublicClient interface
+// The first push of each topic is a snapshot followed by deltas, a gap triggers a resubscribe for a fresh snapshot
+func (c *BybitClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	obChans := make(map[string]<-chan core.Orderbook)
 
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				_, message, err := conn.ReadMessage()
-				if err != nil {
-					if errHandler != nil {
-						errHandler(fmt.Errorf("WebSocket read error: %w", err))
-					}
-					return
-				}
+	streamDepth := orderbookStreamDepth(depth)
+	books := make(map[string]*core.LocalOrderbook)
+	chans := make(map[string]chan core.Orderbook)
+	topics := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		topic := fmt.Sprintf("orderbook.%d.%s", streamDepth, symbol)
+		books[topic] = core.NewLocalOrderbook(symbol, depth)
+		chans[topic] = make(chan core.Orderbook, 1)
+		obChans[symbol] = chans[topic]
+		topics[i] = topic
+	}
 
-				// Parse orderbook message
-				var msg SpotOrderbookMessage
-				if err := json.Unmarshal(message, &msg); err != nil {
-					continue // Skip non-orderbook messages
-				}
+	var stream *core.Stream
+	stream = newPublicStream("wss://stream.bybit.com/v5/public/spot", topics, func(message []byte) {
+		var msg SpotOrderbookMessage
+		if err := json.Unmarshal(message, &msg); err != nil {
+			return
+		}
+		book, exists := books[msg.Topic]
+		if !exists {
+			return
+		}
+		bids := core.ParseOrderbookLevels(msg.Data.Bids)
+		asks := core.ParseOrderbookLevels(msg.Data.Asks)
 
-				// Process only orderbook data
-				if msg.Topic != "" && len(msg.Topic) > 10 && msg.Topic[:10] == "orderbook." {
-					// Extract symbol from topic (format: orderbook.1.BTCUSDT)
-					if len(msg.Topic) < 13 {
-						continue
-					}
-					symbol := msg.Topic[12:] // Skip "orderbook.1."
-
-					// Ensure we have valid bid/ask data
-					if len(msg.Data.Bids) > 0 && len(msg.Data.Asks) > 0 {
-						bid := msg.Data.Bids[0]
-						ask := msg.Data.Asks[0]
-
-						if len(bid) >= 2 && len(ask) >= 2 {
-							quote := core.Quote{
-								Symbol:   symbol,
-								BidPrice: decimal.RequireFromString(bid[0]),
-								BidQty:   decimal.RequireFromString(bid[1]),
-								AskPrice: decimal.RequireFromString(ask[0]),
-								AskQty:   decimal.RequireFromString(ask[1]),
-								Time:     time.UnixMilli(msg.TS),
-							}
-
-							if ch, exists := quoteChans[symbol]; exists {
-								select {
-								case ch <- quote:
-								default:
-									// Channel full, skip
-								}
-							}
-						}
+		switch msg.Type {
+		case "snapshot":
+			book.ApplySnapshot(msg.Data.U, bids, asks)
+		case "delta":
+			applied, err := book.ApplyDiff(msg.Data.U, msg.Data.U, bids, asks)
+			if err != nil {
+				if errors.Is(err, core.ErrOrderbookGap) {
+					if errHandler != nil {
+						errHandler(fmt.Errorf("%s orderbook resync: %w", book.Symbol, err))
 					}
+					// resubscribe to receive a fresh snapshot
+					stream.WriteJSON(map[string]interface{}{"op": "unsubscribe", "args": []string{msg.Topic}})
+					stream.WriteJSON(map[string]interface{}{"op": "subscribe", "args": []string{msg.Topic}})
 				}
+				return
 			}
+			if !applied {
+				return
+			}
+		default:
+			return
+		}
+		core.SendOrderbook(chans[msg.Topic], book.Orderbook())
+	}, errHandler)
+	// the replayed subscriptions start with a fresh snapshot
+	stream.OnReconnect = func() {
+		for _, book := range books {
+			book.Invalidate()
+		}
+	}
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to spot WebSocket: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
 		}
 	}()
 
-	return quoteChans, nil
+	return obChans, nil
 }
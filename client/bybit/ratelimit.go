@@ -0,0 +1,78 @@
+package bybit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// defaultRateLimits are the ip limit of the REST api and the per uid order limit of spot,
+// shared by the REST and the ws trade requests
+var defaultRateLimits = []core.RateLimit{
+	{Category: core.RateLimitRequest, Interval: 5 * time.Second, Limit: 600},
+	{Category: core.RateLimitOrder, Interval: time.Second, Limit: 20},
+}
+
+// orderOps count against the ORDER limit, batch ops once per order
+var orderOps = map[string]bool{
+	"order.create":       true,
+	"order.amend":        true,
+	"order.cancel":       true,
+	"order.create-batch": true,
+	"order.amend-batch":  true,
+	"order.cancel-batch": true,
+}
+
+func rateCostFn() core.WsRequestCostFunc {
+	return func(req map[string]interface{}) []core.RateCost {
+		op, _ := req["op"].(string)
+		if !orderOps[op] {
+			return []core.RateCost{{Category: core.RateLimitRequest, Weight: 1}}
+		}
+		weight := int64(1)
+		if strings.HasSuffix(op, "-batch") {
+			if args, _ := req["args"].([]interface{}); len(args) > 0 {
+				arg, _ := args[0].(map[string]interface{})
+				if request, _ := arg["request"].([]interface{}); len(request) > 0 {
+					weight = int64(len(request))
+				}
+			}
+		}
+		return []core.RateCost{{Category: core.RateLimitOrder, Weight: weight}}
+	}
+}
+
+// newHTTPClient returns the http client of the REST requests, waiting on limiter
+func newHTTPClient(limiter *core.RateLimiter) *http.Client {
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &core.LimitedTransport{
+			Limiter: limiter,
+			Cost:    restRateCost,
+			Observe: observeLimitStatus,
+		},
+	}
+}
+
+func restRateCost(req *http.Request) []core.RateCost {
+	costs := []core.RateCost{{Category: core.RateLimitRequest, Weight: 1}}
+	switch req.URL.Path {
+	case "/v5/order/create", "/v5/order/amend", "/v5/order/cancel":
+		costs = append(costs, core.RateCost{Category: core.RateLimitOrder, Weight: 1})
+	}
+	return costs
+}
+
+// observeLimitStatus pauses the limiter until the reset when X-Bapi-Limit-Status reports the
+// endpoint limit used up
+func observeLimitStatus(l *core.RateLimiter, h http.Header) {
+	if h.Get("X-Bapi-Limit-Status") != "0" {
+		return
+	}
+	if reset, err := strconv.ParseInt(h.Get("X-Bapi-Limit-Reset-Timestamp"), 10, 64); err == nil {
+		l.Pause(time.UnixMilli(reset))
+	}
+}
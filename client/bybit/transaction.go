@@ -82,7 +82,7 @@ func (c *BybitClient) FetchWithdrawableAmount(asset string) (decimal.Decimal, er
 	}
 
 	if resp.RetCode != 0 {
-		return decimal.Zero, fmt.Errorf("API error: %s (code: %d)", resp.RetMsg, resp.RetCode)
+		return decimal.Zero, wrapBybitErrorCode(resp.RetCode, resp.RetMsg)
 	}
 
 	if resp.Result.Avail == "" {
@@ -124,8 +124,7 @@ func (c *BybitClient) callUnifiedTransferableAmountAPI(coin string) (*UnifiedTra
 	req.Header.Set("Content-Type", "application/json")
 
 	// Make the request
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make request: %w", err)
 	}
@@ -184,8 +183,7 @@ func (c *BybitClient) SetDepositAccount(accountType string) error {
 	req.Header.Set("Content-Type", "application/json")
 
 	// Make the request
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to make request: %w", err)
 	}
@@ -204,7 +202,7 @@ func (c *BybitClient) SetDepositAccount(accountType string) error {
 	}
 
 	if setDepositResp.RetCode != 0 {
-		return fmt.Errorf("API error: %s (code: %d)", setDepositResp.RetMsg, setDepositResp.RetCode)
+		return wrapBybitErrorCode(setDepositResp.RetCode, setDepositResp.RetMsg)
 	}
 
 	return nil
@@ -267,8 +265,7 @@ func (c *BybitClient) CreateInternalTransfer(coin string, amount decimal.Decimal
 	req.Header.Set("Content-Type", "application/json")
 
 	// Make the request
-	client := &http.Client{Timeout: 30 * time.Second}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to make request: %w", err)
 	}
@@ -287,7 +284,7 @@ func (c *BybitClient) CreateInternalTransfer(coin string, amount decimal.Decimal
 	}
 
 	if transferResp.RetCode != 0 {
-		return "", fmt.Errorf("API error: %s (code: %d)", transferResp.RetMsg, transferResp.RetCode)
+		return "", wrapBybitErrorCode(transferResp.RetCode, transferResp.RetMsg)
 	}
 
 	return transferResp.Result.TransferID, nil
@@ -0,0 +1,253 @@
+package bybit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// bybitWsURLPrivateStream pushes account updates, the trade url only answers order requests
+const bybitWsURLPrivateStream = "wss://stream.bybit.com/v5/private"
+
+// wsOrderUpdate is an order of the order topic
+type wsOrderUpdate struct {
+	Category     string `json:"category"`
+	Symbol       string `json:"symbol"`
+	OrderID      string `json:"orderId"`
+	OrderLinkID  string `json:"orderLinkId"`
+	Side         string `json:"side"`
+	OrderType    string `json:"orderType"`
+	OrderStatus  string `json:"orderStatus"`
+	CancelType   string `json:"cancelType"`
+	RejectReason string `json:"rejectReason"`
+	Price        string `json:"price"`
+	Qty          string `json:"qty"`
+	CumExecQty   string `json:"cumExecQty"`
+	CumExecFee   string `json:"cumExecFee"`
+	AvgPrice     string `json:"avgPrice"`
+	FeeCurrency  string `json:"feeCurrency"`
+	UpdatedTime  string `json:"updatedTime"`
+}
+
+// wsExecution is a fill of the execution topic
+type wsExecution struct {
+	Category    string `json:"category"`
+	Symbol      string `json:"symbol"`
+	OrderID     string `json:"orderId"`
+	OrderLinkID string `json:"orderLinkId"`
+	Side        string `json:"side"`
+	OrderType   string `json:"orderType"`
+	OrderPrice  string `json:"orderPrice"`
+	OrderQty    string `json:"orderQty"`
+	LeavesQty   string `json:"leavesQty"`
+	ExecID      string `json:"execId"`
+	ExecType    string `json:"execType"` // Trade, AdlTrade, BustTrade, Funding, Settle
+	ExecPrice   string `json:"execPrice"`
+	ExecQty     string `json:"execQty"`
+	ExecFee     string `json:"execFee"`
+	FeeCurrency string `json:"feeCurrency"`
+	ExecTime    string `json:"execTime"`
+	IsMaker     bool   `json:"isMaker"`
+}
+
+// SubscribeOrderEvents implements core.PrivateClient with the order and execution topics of
+// the private stream. Order updates carry the cumulative state, executions the last fill.
+// symbols filters the events and all spot orders are reported when it is empty.
+func (c *BybitClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
+	c.orderEventMu.Lock()
+	defer c.orderEventMu.Unlock()
+	if c.orderEventCh != nil {
+		return c.orderEventCh, nil
+	}
+
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	wanted := make(map[string]bool)
+	for _, symbol := range symbols {
+		wanted[symbol] = true
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	ch := make(chan core.OrderEvent, 100)
+	send := func(event core.OrderEvent) {
+		if len(wanted) > 0 && !wanted[event.Symbol] {
+			return
+		}
+		select {
+		case ch <- event:
+		case <-subCtx.Done():
+		}
+	}
+
+	stream := newPrivateStream(c.apiKey, c.apiSecret, []string{"order", "execution"}, func(msg []byte) {
+		var push struct {
+			Topic string          `json:"topic"`
+			Data  json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &push); err != nil {
+			return
+		}
+		switch push.Topic {
+		case "order":
+			var orders []wsOrderUpdate
+			if err := json.Unmarshal(push.Data, &orders); err != nil {
+				errHandler(fmt.Errorf("bybit order push: %w", err))
+				return
+			}
+			for _, o := range orders {
+				if o.Category == "spot" {
+					send(o.orderEvent())
+				}
+			}
+		case "execution":
+			var execs []wsExecution
+			if err := json.Unmarshal(push.Data, &execs); err != nil {
+				errHandler(fmt.Errorf("bybit execution push: %w", err))
+				return
+			}
+			for _, e := range execs {
+				if e.Category == "spot" && e.isTrade() {
+					send(e.orderEvent(e.FeeCurrency))
+				}
+			}
+		}
+	}, errHandler)
+	if err := stream.Start(subCtx); err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to connect to private WebSocket: %w", err)
+	}
+
+	c.orderEventCh = ch
+	c.orderEventCancel = cancel
+	go func() {
+		<-stream.Done() // the handler is not called anymore
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// UnsubscribeOrderEvents implements core.PrivateClient interface, the channel is closed once
+// the stream stopped
+func (c *BybitClient) UnsubscribeOrderEvents() error {
+	c.orderEventMu.Lock()
+	defer c.orderEventMu.Unlock()
+	if c.orderEventCancel != nil {
+		c.orderEventCancel()
+	}
+	c.orderEventCh, c.orderEventCancel = nil, nil
+	return nil
+}
+
+// newPrivateStream creates a reconnecting private stream that authenticates and (re)subscribes
+// the given topics. Rejected logins and subscriptions are reported to errHandler.
+func newPrivateStream(apiKey, apiSecret string, topics []string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	stream := core.NewStream(bybitWsURLPrivateStream, func(s *core.Stream) error {
+		expires := strconv.FormatInt(time.Now().Add(10*time.Second).UnixMilli(), 10)
+		authMsg := map[string]interface{}{
+			"op":   "auth",
+			"args": []interface{}{apiKey, expires, hmacSHA256("GET/realtime"+expires, apiSecret)},
+		}
+		if err := s.WriteJSON(authMsg); err != nil {
+			return fmt.Errorf("failed to authenticate: %w", err)
+		}
+		subMsg := map[string]interface{}{
+			"op":   "subscribe",
+			"args": topics,
+		}
+		if err := s.WriteJSON(subMsg); err != nil {
+			return fmt.Errorf("failed to subscribe to %v: %w", topics, err)
+		}
+		return nil
+	}, func(msg []byte) {
+		var ack struct {
+			Op      string `json:"op"`
+			Success *bool  `json:"success"`
+			RetMsg  string `json:"ret_msg"`
+		}
+		if err := json.Unmarshal(msg, &ack); err == nil && ack.Success != nil {
+			if !*ack.Success {
+				category := core.ErrCategoryUnknown
+				if ack.Op == "auth" {
+					category = core.ErrCategoryAuthFailed
+				}
+				errHandler(fmt.Errorf("bybit private stream %s: %w", ack.Op, core.NewExchangeError("bybit", category, "", ack.RetMsg)))
+			}
+			return
+		}
+		handler(msg)
+	}, errHandler)
+	stream.PingMessage = []byte(`{"op":"ping"}`)
+	stream.PingInterval = 20 * time.Second
+	return stream
+}
+
+// orderEvent converts an order update, it carries no fill
+func (o wsOrderUpdate) orderEvent() core.OrderEvent {
+	status, reason := orderStatus(o.OrderStatus, o.RejectReason, o.CancelType)
+	return core.OrderEvent{
+		OrderID:         o.OrderID,
+		ClientOrderID:   o.OrderLinkID,
+		Symbol:          o.Symbol,
+		Side:            core.OrderSide(strings.ToUpper(o.Side)),
+		OrderType:       strings.ToUpper(o.OrderType),
+		Status:          status,
+		Reason:          reason,
+		Price:           core.ParseStringDecimal(o.Price),
+		Quantity:        core.ParseStringDecimal(o.Qty),
+		ExecutedQty:     core.ParseStringDecimal(o.CumExecQty),
+		AvgPrice:        core.ParseStringDecimal(o.AvgPrice),
+		Commission:      core.ParseStringDecimal(o.CumExecFee),
+		CommissionAsset: o.FeeCurrency,
+		UpdateTime:      parseMillis(o.UpdatedTime),
+	}
+}
+
+// isTrade reports whether the execution is a fill of an order, funding and settlement are not
+func (e wsExecution) isTrade() bool {
+	return e.ExecType != "Funding" && e.ExecType != "Settle"
+}
+
+// orderEvent converts an execution into a fill event of its order, feeAsset is the currency
+// of the fee
+func (e wsExecution) orderEvent(feeAsset string) core.OrderEvent {
+	qty := core.ParseStringDecimal(e.OrderQty)
+	leaves := core.ParseStringDecimal(e.LeavesQty)
+	executed := decimal.Max(qty.Sub(leaves), decimal.Zero)
+	status := core.OpenStatus(executed)
+	if leaves.IsZero() {
+		status = core.OrderStatusFilled
+	}
+	return core.OrderEvent{
+		OrderID:         e.OrderID,
+		ClientOrderID:   e.OrderLinkID,
+		Symbol:          e.Symbol,
+		Side:            core.OrderSide(strings.ToUpper(e.Side)),
+		OrderType:       strings.ToUpper(e.OrderType),
+		Status:          status,
+		Price:           core.ParseStringDecimal(e.OrderPrice),
+		Quantity:        qty,
+		ExecutedQty:     executed,
+		CommissionAsset: feeAsset,
+		UpdateTime:      parseMillis(e.ExecTime),
+		TradeID:         e.ExecID,
+		IsMaker:         e.IsMaker,
+		LastQty:         core.ParseStringDecimal(e.ExecQty),
+		LastPrice:       core.ParseStringDecimal(e.ExecPrice),
+		LastCommission:  core.ParseStringDecimal(e.ExecFee),
+	}
+}
+
+// parseMillis parses a millisecond timestamp string
+func parseMillis(ms string) time.Time {
+	v, _ := strconv.ParseInt(ms, 10, 64)
+	return time.UnixMilli(v)
+}
@@ -0,0 +1,195 @@
+package kucoin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/spot/spotpublic"
+	"github.com/ljm2ya/quickex-go/client/kucoin/common"
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	kucoinRestURL = "https://api.kucoin.com"
+	// candlePageLimit is the max number of candles KuCoin returns per request
+	candlePageLimit = 1500
+)
+
+var candleTypes = map[core.CandleInterval]string{
+	core.CandleInterval1m:  "1min",
+	core.CandleInterval3m:  "3min",
+	core.CandleInterval5m:  "5min",
+	core.CandleInterval15m: "15min",
+	core.CandleInterval30m: "30min",
+	core.CandleInterval1h:  "1hour",
+	core.CandleInterval2h:  "2hour",
+	core.CandleInterval4h:  "4hour",
+	core.CandleInterval6h:  "6hour",
+	core.CandleInterval12h: "12hour",
+	core.CandleInterval1d:  "1day",
+	core.CandleInterval1w:  "1week",
+	core.CandleInterval1M:  "1month",
+}
+
+// FetchCandles implements core.PublicClient
+func (c *KucoinSpotClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	candleType, ok := candleTypes[interval]
+	if !ok {
+		return nil, core.ErrUnsupportedInterval("kucoin", interval)
+	}
+	return core.PageCandles(start, end, limit, candlePageLimit, func(start, end time.Time, limit int) ([]core.Candle, error) {
+		// KuCoin has no limit parameter, bound the window so that it holds about limit candles
+		if from := end.Add(-time.Duration(limit) * interval.Duration()); start.Before(from) {
+			start = from
+		}
+		params := url.Values{}
+		params.Set("type", candleType)
+		params.Set("symbol", symbol)
+		params.Set("startAt", strconv.FormatInt(start.Unix(), 10))
+		params.Set("endAt", strconv.FormatInt(end.Unix(), 10))
+		resp, err := common.PublicHTTPClient.Get(kucoinRestURL + "/api/v1/market/candles?" + params.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch candles: %w", err)
+		}
+		defer resp.Body.Close()
+
+		var res struct {
+			Code string     `json:"code"`
+			Msg  string     `json:"msg"`
+			Data [][]string `json:"data"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			return nil, fmt.Errorf("failed to decode candles: %w", err)
+		}
+		if res.Code != "200000" {
+			return nil, common.WrapKucoinError(res.Code, res.Msg)
+		}
+		// rows are [time, open, close, high, low, volume, turnover], newest first
+		candles := make([]core.Candle, 0, len(res.Data))
+		for _, row := range res.Data {
+			if len(row) < 7 {
+				return nil, fmt.Errorf("invalid candle row: %v", row)
+			}
+			sec, err := strconv.ParseInt(row[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid candle time %q: %w", row[0], err)
+			}
+			openTime := time.Unix(sec, 0)
+			candles = append(candles, core.Candle{
+				Symbol:      symbol,
+				Interval:    interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(interval.Duration() - time.Millisecond),
+				Open:        decimal.RequireFromString(row[1]),
+				Close:       decimal.RequireFromString(row[2]),
+				High:        decimal.RequireFromString(row[3]),
+				Low:         decimal.RequireFromString(row[4]),
+				Volume:      decimal.RequireFromString(row[5]),
+				QuoteVolume: decimal.RequireFromString(row[6]),
+			})
+		}
+		sort.Slice(candles, func(i, j int) bool { return candles[i].OpenTime.After(candles[j].OpenTime) })
+		if len(candles) > limit {
+			candles = candles[:limit]
+		}
+		return candles, nil
+	})
+}
+
+// SubscribeCandles implements core.PublicClient
+// KuCoin pushes the current bar without a close flag, a bar is closed when the next one
+// starts or once its time is over
+func (c *KucoinSpotClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	candleType, ok := candleTypes[interval]
+	if !ok {
+		return nil, core.ErrUnsupportedInterval("kucoin", interval)
+	}
+	ws := c.wsService.NewSpotPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start WebSocket: %w", err)
+	}
+
+	chans := make(map[string]chan core.Candle)
+	out := make(map[string]<-chan core.Candle)
+	builders := make([]*core.CandleBuilder, 0, len(symbols))
+	var subIds []string
+	var closedMu sync.RWMutex
+	closed := false
+	emit := func(candle core.Candle) {
+		closedMu.RLock()
+		defer closedMu.RUnlock()
+		if !closed {
+			core.SendCandle(ctx, chans[candle.Symbol], candle)
+		}
+	}
+
+	for _, symbol := range symbols {
+		builder := core.NewCandleBuilder(symbol, interval)
+		builders = append(builders, builder)
+		chans[symbol] = make(chan core.Candle, 16)
+		out[symbol] = chans[symbol]
+
+		subId, err := ws.Klines(symbol, candleType, func(topic string, subject string, data *spotpublic.KlinesEvent) error {
+			// candles are [time, open, close, high, low, volume, turnover]
+			if len(data.Candles) < 7 {
+				return nil
+			}
+			sec, err := strconv.ParseInt(data.Candles[0], 10, 64)
+			if err != nil {
+				if errHandler != nil {
+					errHandler(fmt.Errorf("invalid candle time %q: %w", data.Candles[0], err))
+				}
+				return nil
+			}
+			openTime := time.Unix(sec, 0)
+			for _, candle := range builder.Update(core.Candle{
+				Symbol:      symbol,
+				Interval:    interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(interval.Duration() - time.Millisecond),
+				Open:        core.ParseStringDecimal(data.Candles[1]),
+				Close:       core.ParseStringDecimal(data.Candles[2]),
+				High:        core.ParseStringDecimal(data.Candles[3]),
+				Low:         core.ParseStringDecimal(data.Candles[4]),
+				Volume:      core.ParseStringDecimal(data.Candles[5]),
+				QuoteVolume: core.ParseStringDecimal(data.Candles[6]),
+			}) {
+				emit(candle)
+			}
+			return nil
+		})
+		if err != nil {
+			for _, id := range subIds {
+				ws.UnSubscribe(id)
+			}
+			ws.Stop()
+			return nil, fmt.Errorf("failed to subscribe to candles for %s: %w", symbol, err)
+		}
+		subIds = append(subIds, subId)
+		c.watchStream(ctx, subId, errHandler)
+	}
+
+	go func() {
+		core.WatchCandleCloses(ctx.Done(), builders, emit)
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		for _, id := range subIds {
+			ws.UnSubscribe(id)
+		}
+		ws.Stop()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/api"
 	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/types"
+	"github.com/ljm2ya/quickex-go/client/kucoin/common"
 	"github.com/ljm2ya/quickex-go/core"
 )
 
@@ -21,20 +23,44 @@ type KucoinSpotClient struct {
 	privateWS *PrivateWebSocket // Private WebSocket for order placement
 
 	serverTimeDelta int64
+
+	// SDK reconnects public streams itself, gaps are reported to the owning subscription
+	streamMu       sync.Mutex
+	streamHandlers map[string]func(err error) // subscription id : errHandler
+	disconnectedAt time.Time
+
+	conditionals *core.ConditionalEmulator // conditional orders, spot orders have no native trigger over the ws api
+	rules        *core.RuleCache           // rules orders are normalised to
+
+	orderEventMu     sync.Mutex
+	orderEventCh     chan core.OrderEvent
+	orderEventCancel context.CancelFunc
 }
 
 func NewClient(apiKey, apiSecret, apiPassphrase string) *KucoinSpotClient {
 	// Disable SDK logs by default
 	DisableKuCoinSDKLogs()
 
-	// Configure HTTP transport options
+	// Configure HTTP transport options, requests wait on the spot pool (VIP0 quota)
+	limiter := core.NewRateLimiter("kucoin", []core.RateLimit{
+		{Category: core.RateLimitRequest, Interval: common.RateLimitInterval, Limit: 4000},
+	})
 	httpOption := types.NewTransportOptionBuilder().
 		SetKeepAlive(true).
 		SetMaxIdleConnsPerHost(10).
+		AddInterceptors(common.RateLimitInterceptor{Limiter: limiter}).
 		Build()
 
+	c := &KucoinSpotClient{
+		apiKey:         apiKey,
+		apiSecret:      apiSecret,
+		apiPassphrase:  apiPassphrase,
+		streamHandlers: make(map[string]func(err error)),
+	}
+
 	// Configure WebSocket options
 	wsOption := types.NewWebSocketClientOptionBuilder().
+		WithEventCallback(c.wsEventCallback).
 		Build()
 
 	option := types.NewClientOptionBuilder().
@@ -46,16 +72,11 @@ func NewClient(apiKey, apiSecret, apiPassphrase string) *KucoinSpotClient {
 		WithWebSocketClientOption(wsOption).
 		Build()
 
-	client := api.NewClient(option)
-	wsService := client.WsService()
-
-	return &KucoinSpotClient{
-		client:        client,
-		apiKey:        apiKey,
-		apiSecret:     apiSecret,
-		apiPassphrase: apiPassphrase,
-		wsService:     wsService,
-	}
+	c.client = api.NewClient(option)
+	c.wsService = c.client.WsService()
+	c.conditionals = core.NewConditionalEmulator(c, core.PlaceTriggered(c))
+	c.initRules()
+	return c
 }
 
 func (c *KucoinSpotClient) Connect(ctx context.Context) (int64, error) {
@@ -125,24 +146,44 @@ func (c *KucoinSpotClient) ToAsset(symbol string) string {
 	return symbol
 }
 
-// SubscribeOrderEvents implements core.PrivateClient interface
-// Note: KuCoin real-time order events not implemented yet
-func (c *KucoinSpotClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
-	return nil, fmt.Errorf("real-time order events not implemented for KuCoin")
-}
-
 // SubscribeBalanceEvents implements core.PrivateClient interface
 // Note: KuCoin real-time balance events not implemented yet
 func (c *KucoinSpotClient) SubscribeBalanceEvents(ctx context.Context, assets []string, errHandler func(err error)) (<-chan core.BalanceEvent, error) {
 	return nil, fmt.Errorf("real-time balance events not implemented for KuCoin")
 }
 
-// UnsubscribeOrderEvents implements core.PrivateClient interface
-func (c *KucoinSpotClient) UnsubscribeOrderEvents() error {
-	return fmt.Errorf("real-time order events not implemented for KuCoin")
-}
-
 // UnsubscribeBalanceEvents implements core.PrivateClient interface
 func (c *KucoinSpotClient) UnsubscribeBalanceEvents() error {
 	return fmt.Errorf("real-time balance events not implemented for KuCoin")
 }
+
+// wsEventCallback reports SDK reconnects as stream gaps to the owning subscription
+func (c *KucoinSpotClient) wsEventCallback(event types.WebSocketEvent, msg string) {
+	c.streamMu.Lock()
+	switch event {
+	case types.EventDisconnected:
+		c.disconnectedAt = time.Now()
+	case types.EventReSubscribeOK:
+		handler := c.streamHandlers[msg]
+		from := c.disconnectedAt
+		c.streamMu.Unlock()
+		if handler != nil {
+			handler(&core.StreamGapError{From: from, To: time.Now(), Cause: fmt.Errorf("kucoin websocket reconnected")})
+		}
+		return
+	}
+	c.streamMu.Unlock()
+}
+
+// watchStream registers errHandler for gap notifications of the subscription until ctx is done
+func (c *KucoinSpotClient) watchStream(ctx context.Context, subId string, errHandler func(err error)) {
+	c.streamMu.Lock()
+	c.streamHandlers[subId] = errHandler
+	c.streamMu.Unlock()
+	go func() {
+		<-ctx.Done()
+		c.streamMu.Lock()
+		delete(c.streamHandlers, subId)
+		c.streamMu.Unlock()
+	}()
+}
@@ -0,0 +1,58 @@
+package common
+
+import (
+	"strings"
+
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/types"
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// kucoinErrorCategories maps KuCoin spot and futures error codes into core error categories
+var kucoinErrorCategories = map[string]core.ErrorCategory{
+	"400001": core.ErrCategoryAuthFailed,
+	"400002": core.ErrCategoryAuthFailed,
+	"400003": core.ErrCategoryAuthFailed,
+	"400004": core.ErrCategoryAuthFailed,
+	"400005": core.ErrCategoryAuthFailed,
+	"400006": core.ErrCategoryAuthFailed,
+	"400007": core.ErrCategoryAuthFailed,
+	"429000": core.ErrCategoryRateLimited,
+	"200002": core.ErrCategoryRateLimited,
+	"200004": core.ErrCategoryInsufficientBalance,
+	"300003": core.ErrCategoryInsufficientBalance,
+	"900001": core.ErrCategoryInvalidSymbol,
+	"503000": core.ErrCategoryMaintenance,
+}
+
+// WrapKucoinError maps a KuCoin error code and message into core.ExchangeError
+func WrapKucoinError(code, msg string) error {
+	category, ok := kucoinErrorCategories[code]
+	if !ok {
+		// parameter errors (400100 etc.) carry the reason in the message
+		lower := strings.ToLower(msg)
+		switch {
+		case strings.Contains(lower, "insufficient") || strings.Contains(lower, "not enough"):
+			category = core.ErrCategoryInsufficientBalance
+		case strings.Contains(lower, "order") && strings.Contains(lower, "not exist"):
+			category = core.ErrCategoryOrderNotFound
+		case strings.Contains(lower, "post only") || strings.Contains(lower, "postonly"):
+			category = core.ErrCategoryPostOnlyRejected
+		case strings.Contains(lower, "minimum") || strings.Contains(lower, "too small"):
+			category = core.ErrCategoryMinNotional
+		case strings.Contains(lower, "price") && strings.Contains(lower, "increment"):
+			category = core.ErrCategoryPriceFilter
+		default:
+			category = core.ErrCategoryUnknown
+		}
+	}
+	return core.NewExchangeError("kucoin", category, code, msg)
+}
+
+// WrapRestError maps the error of an SDK rest call into core.ExchangeError when the exchange
+// answered with an error code, res is the common response of the call
+func WrapRestError(res *types.RestResponse, err error) error {
+	if res != nil && res.Code != types.CodeSuccess {
+		return WrapKucoinError(res.Code, res.Message)
+	}
+	return err
+}
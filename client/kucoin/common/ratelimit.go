@@ -0,0 +1,61 @@
+package common
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// RateLimitInterval is the window of the kucoin resource pools
+const RateLimitInterval = 30 * time.Second
+
+// PublicHTTPClient sends the public requests the SDK does not cover, the public pool is per
+// ip so it is shared by all clients
+var PublicHTTPClient = &http.Client{
+	Timeout: 30 * time.Second,
+	Transport: &core.LimitedTransport{
+		Limiter: core.NewRateLimiter("kucoin", []core.RateLimit{
+			{Category: core.RateLimitRequest, Interval: RateLimitInterval, Limit: 2000},
+		}),
+		Observe: ObserveRateLimitHeader,
+	},
+}
+
+// RateLimitInterceptor makes the SDK requests wait on Limiter, each one counts as one
+// REQUEST, and syncs it with the gw-ratelimit headers of the answers
+type RateLimitInterceptor struct {
+	Limiter *core.RateLimiter
+}
+
+func (i RateLimitInterceptor) Before(req *http.Request) (*http.Request, error) {
+	if err := i.Limiter.Wait(req.Context(), core.RateCost{Category: core.RateLimitRequest, Weight: 1}); err != nil {
+		return nil, fmt.Errorf("%w: %w", core.ErrRequestNotSent, err)
+	}
+	return req, nil
+}
+
+func (i RateLimitInterceptor) After(req *http.Request, resp *http.Response, err error) (*http.Response, error) {
+	if resp != nil {
+		ObserveRateLimitHeader(i.Limiter, resp.Header)
+	}
+	return resp, err
+}
+
+// ObserveRateLimitHeader syncs the limiter with the weight left in the pool and pauses it
+// until the reset once the pool is used up
+func ObserveRateLimitHeader(l *core.RateLimiter, h http.Header) {
+	remaining, err := strconv.ParseInt(h.Get("gw-ratelimit-remaining"), 10, 64)
+	if err != nil {
+		return
+	}
+	l.ObserveRemaining(core.RateLimitRequest, RateLimitInterval, remaining)
+	if remaining > 0 {
+		return
+	}
+	if reset, err := strconv.ParseInt(h.Get("gw-ratelimit-reset"), 10, 64); err == nil {
+		l.Pause(time.Now().Add(time.Duration(reset) * time.Millisecond))
+	}
+}
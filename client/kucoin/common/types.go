@@ -46,6 +46,12 @@ type OrderWSRequest struct {
 	MarginMode  string `json:"marginMode,omitempty"` // Futures only ISOLATED/CROSS
 	StopPrice   string `json:"stopPrice,omitempty"`  // Futures only: for stop orders
 	TimeInForce string `json:"timeInForce,omitempty"`
+
+	Stop          string `json:"stop,omitempty"`          // Futures only: "down" or "up", the price move that triggers
+	StopPriceType string `json:"stopPriceType,omitempty"` // Futures only: TP (last), MP (mark) or IP (index)
+	ReduceOnly    bool   `json:"reduceOnly,omitempty"`    // Futures only
+	PostOnly      bool   `json:"postOnly,omitempty"`
+	Stp           string `json:"stp,omitempty"` // self-trade prevention: CN (cancel newest), CO (cancel oldest) or CB (both)
 }
 
 // OrderWSResponse represents the response from order placement
@@ -53,5 +59,6 @@ type OrderWSResponse struct {
 	OrderID   string `json:"orderId"`
 	Success   bool   `json:"success"`
 	Error     string `json:"error,omitempty"`
+	Code      string `json:"code,omitempty"`
 	ClientOid string `json:"clientOid"`
 }
@@ -14,4 +14,19 @@ func MapTifToKucoin(tif string) string {
 	default:
 		return "GTC"
 	}
-}
\ No newline at end of file
+}
+
+// MapSTPToKucoin maps self-trade prevention modes to the KuCoin stp, the taker is the
+// newest order and the maker the oldest
+func MapSTPToKucoin(mode core.STPMode) string {
+	switch mode {
+	case core.STPExpireTaker:
+		return "CN"
+	case core.STPExpireMaker:
+		return "CO"
+	case core.STPExpireBoth:
+		return "CB"
+	default:
+		return ""
+	}
+}
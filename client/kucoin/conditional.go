@@ -0,0 +1,16 @@
+package kucoin
+
+import (
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// PlaceConditional implements core.ConditionalOrderClient. Orders are placed over the
+// private ws which has no stop orders, so every conditional is emulated on the last price.
+func (c *KucoinSpotClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	return c.conditionals.Place(req)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (c *KucoinSpotClient) CancelConditional(symbol, orderId string) error {
+	return c.conditionals.Cancel(orderId)
+}
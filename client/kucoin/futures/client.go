@@ -3,10 +3,12 @@ package futures
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/api"
 	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/types"
+	"github.com/ljm2ya/quickex-go/client/kucoin/common"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
 )
@@ -22,20 +24,44 @@ type KucoinFuturesClient struct {
 
 	multiplierMap   map[string]decimal.Decimal
 	serverTimeDelta int64
+
+	// SDK reconnects public streams itself, gaps are reported to the owning subscription
+	streamMu       sync.Mutex
+	streamHandlers map[string]func(err error) // subscription id : errHandler
+	disconnectedAt time.Time
+
+	conditionals *core.ConditionalEmulator // trailing stops
+	rules        *core.RuleCache           // rules orders are normalised to
+
+	orderEventMu     sync.Mutex
+	orderEventCh     chan core.OrderEvent
+	orderEventCancel context.CancelFunc
 }
 
 func NewClient(apiKey, apiSecret, apiPassphrase string) *KucoinFuturesClient {
 	// Disable SDK logs by default
 	DisableKuCoinFuturesLogs()
 
-	// Configure HTTP transport options
+	// Configure HTTP transport options, requests wait on the futures pool (VIP0 quota)
+	limiter := core.NewRateLimiter("kucoin", []core.RateLimit{
+		{Category: core.RateLimitRequest, Interval: common.RateLimitInterval, Limit: 2000},
+	})
 	httpOption := types.NewTransportOptionBuilder().
 		SetKeepAlive(true).
 		SetMaxIdleConnsPerHost(10).
+		AddInterceptors(common.RateLimitInterceptor{Limiter: limiter}).
 		Build()
 
+	c := &KucoinFuturesClient{
+		apiKey:         apiKey,
+		apiSecret:      apiSecret,
+		apiPassphrase:  apiPassphrase,
+		streamHandlers: make(map[string]func(err error)),
+	}
+
 	// Configure WebSocket options
 	wsOption := types.NewWebSocketClientOptionBuilder().
+		WithEventCallback(c.wsEventCallback).
 		Build()
 
 	option := types.NewClientOptionBuilder().
@@ -47,16 +73,11 @@ func NewClient(apiKey, apiSecret, apiPassphrase string) *KucoinFuturesClient {
 		WithWebSocketClientOption(wsOption).
 		Build()
 
-	client := api.NewClient(option)
-	wsService := client.WsService()
-
-	return &KucoinFuturesClient{
-		client:        client,
-		apiKey:        apiKey,
-		apiSecret:     apiSecret,
-		apiPassphrase: apiPassphrase,
-		wsService:     wsService,
-	}
+	c.client = api.NewClient(option)
+	c.wsService = c.client.WsService()
+	c.conditionals = core.NewConditionalEmulator(c, c.placeTriggered)
+	c.initRules()
+	return c
 }
 
 func (c *KucoinFuturesClient) Connect(ctx context.Context) (int64, error) {
@@ -143,24 +164,44 @@ func (c *KucoinFuturesClient) ToAsset(symbol string) string {
 	return symbol
 }
 
-// SubscribeOrderEvents implements core.PrivateClient interface
-// Note: KuCoin Futures real-time order events not implemented yet
-func (c *KucoinFuturesClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
-	return nil, fmt.Errorf("real-time order events not implemented for KuCoin Futures")
-}
-
 // SubscribeBalanceEvents implements core.PrivateClient interface
 // Note: KuCoin Futures real-time balance events not implemented yet
 func (c *KucoinFuturesClient) SubscribeBalanceEvents(ctx context.Context, assets []string, errHandler func(err error)) (<-chan core.BalanceEvent, error) {
 	return nil, fmt.Errorf("real-time balance events not implemented for KuCoin Futures")
 }
 
-// UnsubscribeOrderEvents implements core.PrivateClient interface
-func (c *KucoinFuturesClient) UnsubscribeOrderEvents() error {
-	return fmt.Errorf("real-time order events not implemented for KuCoin Futures")
-}
-
 // UnsubscribeBalanceEvents implements core.PrivateClient interface
 func (c *KucoinFuturesClient) UnsubscribeBalanceEvents() error {
 	return fmt.Errorf("real-time balance events not implemented for KuCoin Futures")
 }
+
+// wsEventCallback reports SDK reconnects as stream gaps to the owning subscription
+func (c *KucoinFuturesClient) wsEventCallback(event types.WebSocketEvent, msg string) {
+	c.streamMu.Lock()
+	switch event {
+	case types.EventDisconnected:
+		c.disconnectedAt = time.Now()
+	case types.EventReSubscribeOK:
+		handler := c.streamHandlers[msg]
+		from := c.disconnectedAt
+		c.streamMu.Unlock()
+		if handler != nil {
+			handler(&core.StreamGapError{From: from, To: time.Now(), Cause: fmt.Errorf("kucoin websocket reconnected")})
+		}
+		return
+	}
+	c.streamMu.Unlock()
+}
+
+// watchStream registers errHandler for gap notifications of the subscription until ctx is done
+func (c *KucoinFuturesClient) watchStream(ctx context.Context, subId string, errHandler func(err error)) {
+	c.streamMu.Lock()
+	c.streamHandlers[subId] = errHandler
+	c.streamMu.Unlock()
+	go func() {
+		<-ctx.Done()
+		c.streamMu.Lock()
+		delete(c.streamHandlers, subId)
+		c.streamMu.Unlock()
+	}()
+}
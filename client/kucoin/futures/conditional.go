@@ -0,0 +1,107 @@
+package futures
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/client/kucoin/common"
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+var stopPriceTypes = map[core.TriggerSource]string{
+	core.TriggerSourceLast:  "TP",
+	core.TriggerSourceMark:  "MP",
+	core.TriggerSourceIndex: "IP",
+}
+
+// PlaceConditional implements core.ConditionalOrderClient. Stop-loss and take-profit
+// orders are native stop orders, KuCoin futures has no trailing stop so those are emulated.
+func (c *KucoinFuturesClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Type == core.ConditionalTrailingStop {
+		return c.conditionals.Place(req)
+	}
+	wsReq, err := c.conditionalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	wsReq.Stop = "down"
+	if req.TriggersOnRise() {
+		wsReq.Stop = "up"
+	}
+	wsReq.StopPriceType = stopPriceTypes[req.Source()]
+	wsReq.StopPrice = req.TriggerPrice.String()
+	return c.placeConditionalOrder(req, wsReq)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (c *KucoinFuturesClient) CancelConditional(symbol, orderId string) error {
+	if core.IsEmulatedOrderID(orderId) {
+		return c.conditionals.Cancel(orderId)
+	}
+	_, err := c.CancelOrder(symbol, orderId)
+	return err
+}
+
+// placeTriggered places the order of a triggered emulated conditional, unlike
+// core.PlaceTriggered it keeps reduce-only
+func (c *KucoinFuturesClient) placeTriggered(req core.ConditionalOrderRequest, last decimal.Decimal) (*core.OrderResponse, error) {
+	wsReq, err := c.conditionalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	return c.placeConditionalOrder(req, wsReq)
+}
+
+// conditionalRequest is the order a conditional places, sized in lots
+func (c *KucoinFuturesClient) conditionalRequest(req core.ConditionalOrderRequest) (*OrderWSRequest, error) {
+	mul, on := c.multiplierMap[req.Symbol]
+	if !on {
+		return nil, fmt.Errorf("failed to get lot of order symbol: check initial connection")
+	}
+	lotQty := req.Quantity.DivRound(mul, 0)
+	if lotQty.IsZero() {
+		return nil, fmt.Errorf("order failed: quantity too small: %s", req.Quantity.String())
+	}
+	wsReq := &OrderWSRequest{
+		ClientOid:  fmt.Sprintf("quickex-futures-%d", time.Now().UnixNano()),
+		Side:       strings.ToLower(string(req.Side)),
+		Symbol:     req.Symbol,
+		Type:       "market",
+		Size:       lotQty.String(),
+		MarginMode: "CROSS",
+		ReduceOnly: req.ReduceOnly,
+	}
+	if req.LimitPrice.IsPositive() {
+		wsReq.Type = "limit"
+		wsReq.Price = req.LimitPrice.String()
+		wsReq.TimeInForce = mapTifToKucoin(string(req.TimeInForce))
+	}
+	return wsReq, nil
+}
+
+func (c *KucoinFuturesClient) placeConditionalOrder(req core.ConditionalOrderRequest, wsReq *OrderWSRequest) (*core.OrderResponse, error) {
+	if c.privateWS == nil || !c.privateWS.IsConnected() {
+		return nil, fmt.Errorf("private WebSocket not connected, please call Connect() first")
+	}
+	resp, err := c.privateWS.PlaceOrder(wsReq)
+	if err != nil {
+		return nil, fmt.Errorf("failed to place conditional order: %w", err)
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
+	}
+	return &core.OrderResponse{
+		OrderID:    resp.OrderID,
+		Symbol:     req.Symbol,
+		Side:       req.Side,
+		Status:     core.OrderStatusOpen,
+		Price:      req.LimitPrice,
+		Quantity:   req.Quantity,
+		CreateTime: time.Now(),
+	}, nil
+}
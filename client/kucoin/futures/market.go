@@ -3,8 +3,12 @@ package futures
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/futures/futurespublic"
 	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/futures/market"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
@@ -16,6 +20,7 @@ func (c *KucoinFuturesClient) SubscribeQuotes(ctx context.Context, symbols []str
 	return c.NewWebSocketConnection(ctx, symbols, errHandler)
 }
 
+// FetchQuotes implements core.PublicClient, all tickers come in one request and are filtered
 func (c *KucoinFuturesClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
 	out := make(map[string]core.Quote)
 
@@ -54,7 +59,7 @@ func (c *KucoinFuturesClient) FetchQuotes(symbols []string) (map[string]core.Quo
 			Time:     time.Now(),
 		}
 	}
-	return out, nil
+	return out, core.MissingSymbols("kucoin", symbols, out)
 }
 
 func (c *KucoinFuturesClient) GetAllSymbols() (*market.GetAllSymbolsResp, error) {
@@ -69,46 +74,282 @@ func (c *KucoinFuturesClient) GetAllSymbols() (*market.GetAllSymbolsResp, error)
 	return resp, err
 }
 
+// FetchTickers implements core.PublicClient from the active contracts, which carry the 24h
+// statistics but no top of book. Volumes are in the base and quote currency, not in lots.
+func (c *KucoinFuturesClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	resp, err := c.GetAllSymbols()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	tickers := make([]core.Ticker, 0, len(resp.Data))
+	for _, info := range resp.Data {
+		if info.Status != "Open" {
+			continue
+		}
+		last := decimal.NewFromFloat(info.LastTradePrice)
+		tickers = append(tickers, core.Ticker{
+			Symbol:        info.Symbol,
+			LastPrice:     last,
+			OpenPrice:     last.Sub(decimal.NewFromFloat(info.PriceChg)),
+			HighPrice:     decimal.NewFromFloat(info.HighPrice),
+			LowPrice:      decimal.NewFromFloat(info.LowPrice),
+			Volume:        decimal.NewFromFloat(info.VolumeOf24h),
+			QuoteVolume:   decimal.NewFromFloat(info.TurnoverOf24h),
+			ChangePercent: decimal.NewFromFloat(info.PriceChgPct).Shift(2), // a fraction
+			Time:          now,
+		})
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// initRules sets up the rule cache that orders are rounded and validated against
+func (c *KucoinFuturesClient) initRules() {
+	// the contract list holds every symbol, the quote of a symbol is not known before
+	c.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		return c.fetchMarketRules(nil)
+	}, core.DefaultRuleTTL)
+}
+
 func (c *KucoinFuturesClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
+	return c.fetchMarketRules(func(quote string) bool { return slices.Contains(quotes, quote) })
+}
+
+// fetchMarketRules returns the rules of the open contracts whose quote keep accepts, of all
+// of them when keep is nil
+func (c *KucoinFuturesClient) fetchMarketRules(keep func(quote string) bool) ([]core.MarketRule, error) {
 	resp, err := c.GetAllSymbols()
 	if err != nil {
 		return nil, err
 	}
 	var rules []core.MarketRule
 	for _, info := range resp.Data {
-		if info.Status == "Open" {
-			for _, quote := range quotes {
-				if quote == info.QuoteCurrency {
-					// Parse decimal values directly from response
-					tickSize := decimal.NewFromFloat(info.TickSize)
-					stepSize := decimal.NewFromFloat(float64(info.LotSize) * info.Multiplier)
-					maxOrderQty := decimal.NewFromInt(int64(info.MaxOrderQty))
-					maxPrice := decimal.NewFromFloat(info.BuyLimit)
-					minPrice := decimal.NewFromFloat(info.SellLimit)
-
-					// Calculate precision from tick size
-					pricePrecision := int64(6) // Default precision for futures
-					qtyPrecision := int64(0)   // Futures quantities are usually integers
-
-					rule := core.MarketRule{
-						Symbol:         info.Symbol,
-						BaseAsset:      info.BaseCurrency,
-						QuoteAsset:     info.QuoteCurrency,
-						PricePrecision: pricePrecision,
-						QtyPrecision:   qtyPrecision,
-						MaxPrice:       maxPrice,
-						MinPrice:       minPrice,
-						TickSize:       tickSize,
-						StepSize:       stepSize,
-						MinQty:         stepSize,
-						MaxQty:         maxOrderQty,
-					}
-					rules = append(rules, rule)
-				}
-			}
+		if info.Status != "Open" || keep != nil && !keep(info.QuoteCurrency) {
+			continue
+		}
+		// Parse decimal values directly from response
+		tickSize := decimal.NewFromFloat(info.TickSize)
+		stepSize := decimal.NewFromFloat(float64(info.LotSize) * info.Multiplier)
+		maxOrderQty := decimal.NewFromInt(int64(info.MaxOrderQty)).Mul(decimal.NewFromFloat(info.Multiplier)) // lots to base like stepSize
+		maxPrice := decimal.NewFromFloat(info.BuyLimit)
+		minPrice := decimal.NewFromFloat(info.SellLimit)
 
+		// Calculate precision from tick size
+		pricePrecision := int64(6) // Default precision for futures
+		qtyPrecision := int64(0)   // Futures quantities are usually integers
+
+		rule := core.MarketRule{
+			Symbol:         info.Symbol,
+			BaseAsset:      info.BaseCurrency,
+			QuoteAsset:     info.QuoteCurrency,
+			PricePrecision: pricePrecision,
+			QtyPrecision:   qtyPrecision,
+			MaxPrice:       maxPrice,
+			MinPrice:       minPrice,
+			TickSize:       tickSize,
+			StepSize:       stepSize,
+			MinQty:         stepSize,
+			MaxQty:         maxOrderQty,
 		}
+		rules = append(rules, rule)
 	}
 
 	return rules, nil
 }
+
+// level50Depth is the depth of the level2 snapshots KuCoin pushes
+const level50Depth = 50
+
+// SubscribeOrderbook implements core.PublicClient interface
+// Books of up to 50 levels come from the level2 depth50 snapshots KuCoin pushes, deeper ones
+// are built from a REST snapshot and kept in sync with the level2 increments
+func (c *KucoinFuturesClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	if depth <= 0 || depth > level50Depth {
+		return c.subscribeOrderbookIncrements(ctx, symbols, depth, errHandler)
+	}
+	ws := c.wsService.NewFuturesPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start futures WebSocket: %w", err)
+	}
+
+	chans := make(map[string]chan core.Orderbook)
+	out := make(map[string]<-chan core.Orderbook)
+	var subIds []string
+	var closedMu sync.RWMutex
+	closed := false
+
+	for _, symbol := range symbols {
+		book := core.NewLocalOrderbook(symbol, depth)
+		ch := make(chan core.Orderbook, 1)
+		chans[symbol] = ch
+		out[symbol] = ch
+		mult, ok := c.multiplierMap[symbol]
+		if !ok {
+			mult = decimal.NewFromInt(1)
+		}
+
+		subId, err := ws.OrderbookLevel50(symbol, func(topic string, subject string, data *futurespublic.OrderbookLevel50Event) error {
+			book.ApplySnapshot(data.Sequence, parseContractLevels(data.Bids, mult), parseContractLevels(data.Asks, mult))
+
+			closedMu.RLock()
+			defer closedMu.RUnlock()
+			if !closed {
+				core.SendOrderbook(ch, book.Orderbook())
+			}
+			return nil
+		})
+		if err != nil {
+			for _, id := range subIds {
+				ws.UnSubscribe(id)
+			}
+			ws.Stop()
+			return nil, fmt.Errorf("failed to subscribe to futures orderbook for %s: %w", symbol, err)
+		}
+		subIds = append(subIds, subId)
+		c.watchStream(ctx, subId, errHandler)
+		time.Sleep(time.Second / 10)
+	}
+
+	go func() {
+		<-ctx.Done()
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		for _, id := range subIds {
+			ws.UnSubscribe(id)
+		}
+		ws.Stop()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
+
+// subscribeOrderbookIncrements keeps books of any depth in sync with the level2 increments,
+// each carries one change and its sequence
+func (c *KucoinFuturesClient) subscribeOrderbookIncrements(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	ws := c.wsService.NewFuturesPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start futures WebSocket: %w", err)
+	}
+
+	syncers := make(map[string]*core.BookSyncer)
+	chans := make(map[string]chan core.Orderbook)
+	out := make(map[string]<-chan core.Orderbook)
+	var subIds []string
+
+	for _, symbol := range symbols {
+		ch := make(chan core.Orderbook, 1)
+		chans[symbol] = ch
+		out[symbol] = ch
+		mult, ok := c.multiplierMap[symbol]
+		if !ok {
+			mult = decimal.NewFromInt(1)
+		}
+		syncer := core.NewBookSyncer(core.NewLocalOrderbook(symbol, depth), c.depthSnapshotFn(symbol, depth, mult),
+			func(ob core.Orderbook) { core.SendOrderbook(ch, ob) }, errHandler)
+		syncers[symbol] = syncer
+
+		subId, err := ws.OrderbookIncrement(symbol, func(topic string, subject string, data *futurespublic.OrderbookIncrementEvent) error {
+			// "price,side,size" in contracts
+			change := strings.Split(data.Change, ",")
+			if len(change) != 3 {
+				return nil
+			}
+			level := parseContractLevels([][]interface{}{{change[0], change[2]}}, mult)
+			if change[1] == "buy" {
+				syncer.Diff(data.Sequence, data.Sequence, level, nil)
+			} else {
+				syncer.Diff(data.Sequence, data.Sequence, nil, level)
+			}
+			return nil
+		})
+		if err != nil {
+			for _, id := range subIds {
+				ws.UnSubscribe(id)
+			}
+			ws.Stop()
+			return nil, fmt.Errorf("failed to subscribe to futures orderbook increments for %s: %w", symbol, err)
+		}
+		subIds = append(subIds, subId)
+		c.watchStream(ctx, subId, errHandler)
+		time.Sleep(time.Second / 10)
+	}
+
+	go func() {
+		<-ctx.Done()
+		for _, id := range subIds {
+			ws.UnSubscribe(id)
+		}
+		ws.Stop()
+		for symbol, ch := range chans {
+			syncers[symbol].Close()
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
+
+// depthSnapshotFn returns the REST snapshot of symbol a book of depth levels is synced from,
+// the top 100 levels or the full book, sizes are converted from contracts with mult
+func (c *KucoinFuturesClient) depthSnapshotFn(symbol string, depth int, mult decimal.Decimal) core.SnapshotFunc {
+	marketAPI := c.client.RestService().GetFuturesService().GetMarketAPI()
+	return func() (int64, []core.OrderbookEntry, []core.OrderbookEntry, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		var sequence int64
+		var bids, asks [][]float64
+		if depth > 0 && depth <= 100 {
+			resp, err := marketAPI.GetPartOrderBook(market.NewGetPartOrderBookReqBuilder().
+				SetSymbol(symbol).SetSize("100").Build(), ctx)
+			if err != nil {
+				return 0, nil, nil, fmt.Errorf("failed to fetch depth snapshot: %w", err)
+			}
+			sequence, bids, asks = resp.Sequence, resp.Bids, resp.Asks
+		} else {
+			resp, err := marketAPI.GetFullOrderBook(market.NewGetFullOrderBookReqBuilder().
+				SetSymbol(symbol).Build(), ctx)
+			if err != nil {
+				return 0, nil, nil, fmt.Errorf("failed to fetch depth snapshot: %w", err)
+			}
+			sequence, bids, asks = resp.Sequence, resp.Bids, resp.Asks
+		}
+		return sequence, parseFloatLevels(bids, mult), parseFloatLevels(asks, mult), nil
+	}
+}
+
+// parseFloatLevels converts the [price, contracts] levels of a REST book to base quantity entries
+func parseFloatLevels(levels [][]float64, mult decimal.Decimal) []core.OrderbookEntry {
+	out := make([]core.OrderbookEntry, 0, len(levels))
+	for _, lv := range levels {
+		if len(lv) < 2 {
+			continue
+		}
+		out = append(out, core.OrderbookEntry{Price: decimal.NewFromFloat(lv[0]), Quantity: decimal.NewFromFloat(lv[1]).Mul(mult)})
+	}
+	return out
+}
+
+// parseContractLevels converts [price, contracts] levels to base quantity entries
+func parseContractLevels(levels [][]interface{}, mult decimal.Decimal) []core.OrderbookEntry {
+	out := make([]core.OrderbookEntry, 0, len(levels))
+	for _, lv := range levels {
+		if len(lv) < 2 {
+			continue
+		}
+		price, err := decimal.NewFromString(fmt.Sprint(lv[0]))
+		if err != nil {
+			continue
+		}
+		size, err := decimal.NewFromString(fmt.Sprint(lv[1]))
+		if err != nil {
+			continue
+		}
+		out = append(out, core.OrderbookEntry{Price: price, Quantity: size.Mul(mult)})
+	}
+	return out
+}
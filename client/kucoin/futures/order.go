@@ -2,6 +2,7 @@ package futures
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -37,7 +38,12 @@ func (c *KucoinFuturesClient) FetchOrder(symbol, orderId string) (*core.OrderRes
 		time.Sleep(time.Millisecond * 150)
 	}
 
-	mul, ok := c.multiplierMap[symbol]
+	return c.orderFull(resp)
+}
+
+// orderFull converts an order query response, executed quantities from lots into base
+func (c *KucoinFuturesClient) orderFull(resp *order.GetOrderByOrderIdResp) (*core.OrderResponseFull, error) {
+	mul, ok := c.multiplierMap[resp.Symbol]
 	if !ok {
 		return nil, fmt.Errorf("no matching symbol or not connected")
 	}
@@ -60,15 +66,20 @@ func (c *KucoinFuturesClient) FetchOrder(symbol, orderId string) (*core.OrderRes
 		side = core.OrderSideSell
 	}
 
+	status, reason := mapOrderStatus(resp)
 	return &core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
-			OrderID:    resp.Id,
-			Symbol:     resp.Symbol,
-			Side:       side,
-			Status:     mapOrderStatus(resp),
-			Price:      price,
-			Quantity:   quantity,
-			CreateTime: createTime,
+			OrderID:       resp.Id,
+			ClientOrderID: resp.ClientOid,
+			Symbol:        resp.Symbol,
+			Side:          side,
+			Tif:           core.TimeInForce(resp.TimeInForce),
+			Status:        status,
+			Reason:        reason,
+			Price:         price,
+			Quantity:      quantity,
+			PostOnly:      resp.PostOnly,
+			CreateTime:    createTime,
 		},
 		AvgPrice:        avgPrice,
 		ExecutedQty:     executedQty,
@@ -78,6 +89,150 @@ func (c *KucoinFuturesClient) FetchOrder(symbol, orderId string) (*core.OrderRes
 	}, nil
 }
 
+// FetchOrderByClientID implements core.PrivateClient. The order id is looked up by the
+// client oid first, the two responses differ so FetchOrder builds the result.
+func (c *KucoinFuturesClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	orderAPI := c.client.RestService().GetFuturesService().GetOrderAPI()
+	req := order.NewGetOrderByClientOidReqBuilder().
+		SetClientOid(clientOrderID).
+		Build()
+	resp, err := orderAPI.GetOrderByClientOid(req, context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", common.WrapRestError(resp.CommonResponse, err))
+	}
+	if resp.Id == "" {
+		return nil, core.NewExchangeError("kucoin", core.ErrCategoryOrderNotFound, "", "no order found")
+	}
+	return c.FetchOrder(symbol, resp.Id)
+}
+
+// orderListLimit and historyWindow bound one order list request
+const (
+	orderListLimit = 1000
+	historyWindow  = 7 * 24 * time.Hour
+)
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all symbols
+func (c *KucoinFuturesClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	builder := order.NewGetOrderListReqBuilder().SetStatus("active")
+	if symbol != "" {
+		builder.SetSymbol(symbol)
+	}
+	orders, err := c.listOrders(builder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open orders: %w", err)
+	}
+	core.SortOrders(orders)
+	return orders, nil
+}
+
+// FetchOrderHistory implements core.PrivateClient
+func (c *KucoinFuturesClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, historyWindow, 0, func(start, end time.Time, _ int) ([]core.OrderResponseFull, error) {
+		builder := order.NewGetOrderListReqBuilder().
+			SetStatus("done").
+			SetSymbol(symbol).
+			SetStartAt(start.UnixMilli()).
+			SetEndAt(end.UnixMilli())
+		orders, err := c.listOrders(builder)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get order history: %w", err)
+		}
+		return orders, nil
+	})
+}
+
+// listOrders follows the pages of an order list request
+func (c *KucoinFuturesClient) listOrders(builder *order.GetOrderListReqBuilder) ([]core.OrderResponseFull, error) {
+	orderAPI := c.client.RestService().GetFuturesService().GetOrderAPI()
+	builder.SetPageSize(orderListLimit)
+	var orders []core.OrderResponseFull
+	for page := int32(1); ; page++ {
+		resp, err := orderAPI.GetOrderList(builder.SetCurrentPage(page).Build(), context.Background())
+		if err != nil {
+			return nil, common.WrapRestError(resp.CommonResponse, err)
+		}
+		for _, item := range resp.Items {
+			// a listed order has the fields of the order query response
+			var byId order.GetOrderByOrderIdResp
+			raw, _ := json.Marshal(item)
+			_ = json.Unmarshal(raw, &byId)
+			full, err := c.orderFull(&byId)
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, *full)
+		}
+		if page >= resp.TotalPage || len(resp.Items) == 0 {
+			return orders, nil
+		}
+	}
+}
+
+// FetchFills implements core.PrivateClient, the span of a request is at most seven days
+func (c *KucoinFuturesClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, historyWindow, 0, func(start, end time.Time, _ int) ([]core.OrderFill, error) {
+		fills, err := c.tradeHistory(order.NewGetTradeHistoryReqBuilder().
+			SetSymbol(symbol).
+			SetStartAt(start.UnixMilli()).
+			SetEndAt(end.UnixMilli()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trade history: %w", err)
+		}
+		return fills, nil
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (c *KucoinFuturesClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := c.tradeHistory(order.NewGetTradeHistoryReqBuilder().
+		SetSymbol(symbol).
+		SetOrderId(orderId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order fills: %w", err)
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// tradeHistory follows the pages of a trade history request, sizes from lots into base
+func (c *KucoinFuturesClient) tradeHistory(builder *order.GetTradeHistoryReqBuilder) ([]core.OrderFill, error) {
+	orderAPI := c.client.RestService().GetFuturesService().GetOrderAPI()
+	builder.SetPageSize(orderListLimit)
+	var fills []core.OrderFill
+	for page := int32(1); ; page++ {
+		resp, err := orderAPI.GetTradeHistory(builder.SetCurrentPage(page).Build(), context.Background())
+		if err != nil {
+			return nil, common.WrapRestError(resp.CommonResponse, err)
+		}
+		for _, item := range resp.Items {
+			mul, ok := c.multiplierMap[item.Symbol]
+			if !ok {
+				return nil, fmt.Errorf("no matching symbol or not connected")
+			}
+			side := core.OrderSide(strings.ToUpper(item.Side))
+			price, _ := decimal.NewFromString(item.Price)
+			fee, _ := decimal.NewFromString(item.Fee)
+			fills = append(fills, core.OrderFill{
+				TradeID:   item.TradeId,
+				OrderID:   item.OrderId,
+				Symbol:    item.Symbol,
+				Side:      side,
+				Price:     price,
+				Quantity:  decimal.NewFromInt(int64(item.Size)).Mul(mul),
+				Fee:       fee,
+				FeeAsset:  item.FeeCurrency,
+				IsMaker:   item.Liquidity == "maker",
+				TradeTime: time.Unix(0, item.TradeTime), // nanoseconds
+				IsBuyer:   side == core.OrderSideBuy,
+			})
+		}
+		if page >= resp.TotalPage || len(resp.Items) == 0 {
+			return fills, nil
+		}
+	}
+}
+
 func (c *KucoinFuturesClient) LimitBuy(symbol string, quantity, price decimal.Decimal, tif string) (*core.OrderResponse, error) {
 	return c.placeLimitOrder(symbol, "buy", quantity, price, tif)
 }
@@ -123,7 +278,7 @@ func (c *KucoinFuturesClient) placeLimitOrder(symbol, side string, quantity, pri
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("order placement failed: %s", resp.Error)
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
 	}
 
 	// Convert string side to core.OrderSide
@@ -172,7 +327,7 @@ func (c *KucoinFuturesClient) MarketBuy(symbol string, quoteQuantity decimal.Dec
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("order placement failed: %s", resp.Error)
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
 	}
 
 	return &core.OrderResponse{
@@ -211,7 +366,7 @@ func (c *KucoinFuturesClient) MarketSell(symbol string, quantity decimal.Decimal
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("order placement failed: %s", resp.Error)
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
 	}
 
 	return &core.OrderResponse{
@@ -224,6 +379,167 @@ func (c *KucoinFuturesClient) MarketSell(symbol string, quantity decimal.Decimal
 	}, nil
 }
 
+// PlaceOrder implements core.PrivateClient, the client order id is sent as clientOid.
+// Base quantities are sent in lots, quote quantities of market orders as valueQty.
+func (c *KucoinFuturesClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := c.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	if c.privateWS == nil || !c.privateWS.IsConnected() {
+		return nil, fmt.Errorf("private WebSocket not connected, please call Connect() first")
+	}
+	wsReq := &OrderWSRequest{
+		ClientOid:  req.ClientOrderID,
+		Side:       strings.ToLower(string(req.Side)),
+		Symbol:     req.Symbol,
+		Type:       "market",
+		MarginMode: "CROSS",
+		ReduceOnly: req.ReduceOnly,
+		Stp:        common.MapSTPToKucoin(req.STPMode),
+	}
+	if req.QuoteQuantity.IsPositive() {
+		wsReq.ValueQty = req.QuoteQuantity.String()
+	} else {
+		lotQty, err := c.lots(req.Symbol, req.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		wsReq.Size = lotQty.String()
+	}
+	if req.Type == core.OrderTypeLimit {
+		wsReq.Type = "limit"
+		wsReq.Price = req.Price.String()
+		wsReq.TimeInForce = mapTifToKucoin(string(req.TimeInForce))
+		wsReq.PostOnly = req.PostOnly
+	}
+
+	resp, err := c.privateWS.PlaceOrderCtx(ctx, wsReq)
+	if err != nil {
+		return core.ResolvePlacement(c, req, fmt.Errorf("failed to place %s order: %w", wsReq.Side, err))
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
+	}
+
+	res := &core.OrderResponse{
+		OrderID:       resp.OrderID,
+		ClientOrderID: req.ClientOrderID,
+		Symbol:        req.Symbol,
+		Side:          req.Side,
+		Tif:           req.TimeInForce,
+		Status:        core.OrderStatusOpen,
+		Price:         req.Price,
+		Quantity:      req.Quantity,
+		CreateTime:    time.Now(),
+	}
+	if req.QuoteQuantity.IsPositive() {
+		res.Quantity = req.QuoteQuantity
+		res.IsQuoteQuantity = true
+	}
+	return res, nil
+}
+
+// lots converts a base quantity into contracts
+func (c *KucoinFuturesClient) lots(symbol string, quantity decimal.Decimal) (decimal.Decimal, error) {
+	mul, on := c.multiplierMap[symbol]
+	if !on {
+		return decimal.Zero, fmt.Errorf("failed to get lot of order symbol: check initial connection")
+	}
+	lotQty := quantity.DivRound(mul, 0)
+	if lotQty.IsZero() {
+		return decimal.Zero, fmt.Errorf("order failed: quantity too small: %s", quantity.String())
+	}
+	return lotQty, nil
+}
+
+// batchSize is the max orders of a futures batch
+const batchSize = 20
+
+// PlaceOrders implements core.PrivateClient with the REST batch orders endpoint
+func (c *KucoinFuturesClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return c.rules.PlaceBatches(ctx, reqs, batchSize, c.placeBatch)
+}
+
+func (c *KucoinFuturesClient) placeBatch(ctx context.Context, reqs []core.OrderRequest) []core.OrderResult {
+	results := make([]core.OrderResult, len(reqs))
+	var sent []core.OrderRequest
+	var index []int
+	var items []order.BatchAddOrdersItem
+	for i, req := range reqs {
+		builder := order.NewBatchAddOrdersItemBuilder().
+			SetClientOid(req.ClientOrderID).
+			SetSide(strings.ToLower(string(req.Side))).
+			SetSymbol(req.Symbol).
+			SetType("market").
+			SetMarginMode("CROSS").
+			SetReduceOnly(req.ReduceOnly)
+		if stp := common.MapSTPToKucoin(req.STPMode); stp != "" {
+			builder.SetStp(stp)
+		}
+		if req.QuoteQuantity.IsPositive() {
+			builder.SetValueQty(req.QuoteQuantity.String())
+		} else {
+			lotQty, err := c.lots(req.Symbol, req.Quantity)
+			if err != nil {
+				results[i].Err = err
+				continue
+			}
+			builder.SetSize(int32(lotQty.IntPart()))
+		}
+		if req.Type == core.OrderTypeLimit {
+			builder.SetType("limit").
+				SetPrice(req.Price.String()).
+				SetTimeInForce(mapTifToKucoin(string(req.TimeInForce))).
+				SetPostOnly(req.PostOnly)
+		}
+		items = append(items, *builder.Build())
+		sent = append(sent, req)
+		index = append(index, i)
+	}
+	if len(items) == 0 {
+		return results
+	}
+
+	orderAPI := c.client.RestService().GetFuturesService().GetOrderAPI()
+	resp, err := orderAPI.BatchAddOrders(order.NewBatchAddOrdersReqBuilder().SetItems(items).Build(), ctx)
+	if err == nil && len(resp.Data) != len(sent) {
+		err = fmt.Errorf("batch response has %d items for %d orders", len(resp.Data), len(sent))
+	}
+	if err != nil {
+		if resp != nil {
+			err = common.WrapRestError(resp.CommonResponse, err)
+		}
+		for j, res := range core.ResolveBatch(c, sent, fmt.Errorf("failed to place batch orders: %w", err)) {
+			results[index[j]] = res
+		}
+		return results
+	}
+	for j, data := range resp.Data {
+		req := sent[j]
+		if data.OrderId == "" || (data.Code != "" && data.Code != "200000") {
+			results[index[j]].Err = common.WrapKucoinError(data.Code, data.Msg)
+			continue
+		}
+		res := &core.OrderResponse{
+			OrderID:       data.OrderId,
+			ClientOrderID: req.ClientOrderID,
+			Symbol:        req.Symbol,
+			Side:          req.Side,
+			Tif:           req.TimeInForce,
+			Status:        core.OrderStatusOpen,
+			Price:         req.Price,
+			Quantity:      req.Quantity,
+			CreateTime:    time.Now(),
+		}
+		if req.QuoteQuantity.IsPositive() {
+			res.Quantity = req.QuoteQuantity
+			res.IsQuoteQuantity = true
+		}
+		results[index[j]].Order = res
+	}
+	return results
+}
+
 func (c *KucoinFuturesClient) CancelOrder(symbol, orderId string) (*core.OrderResponse, error) {
 	ctx := context.Background()
 	restService := c.client.RestService()
@@ -254,6 +570,44 @@ func (c *KucoinFuturesClient) CancelOrder(symbol, orderId string) (*core.OrderRe
 	}, nil
 }
 
+// CancelOrders implements core.PrivateClient with the REST batch cancel endpoint
+func (c *KucoinFuturesClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelBatches(ctx, orderIds, batchSize, func(ctx context.Context, orderIds []string) []core.OrderResult {
+		orderAPI := c.client.RestService().GetFuturesService().GetOrderAPI()
+		request := order.NewBatchCancelOrdersReqBuilder().SetOrderIdsList(orderIds).Build()
+		resp, err := orderAPI.BatchCancelOrders(request, ctx)
+		if err != nil {
+			if resp != nil {
+				err = common.WrapRestError(resp.CommonResponse, err)
+			}
+			return core.FailBatch(len(orderIds), fmt.Errorf("failed to cancel batch orders: %w", err))
+		}
+		// items carry the order id, match them rather than relying on their order
+		byId := make(map[string]order.BatchCancelOrdersData, len(resp.Data))
+		for _, data := range resp.Data {
+			byId[data.OrderId] = data
+		}
+		results := make([]core.OrderResult, len(orderIds))
+		for i, orderId := range orderIds {
+			data, ok := byId[orderId]
+			switch {
+			case !ok:
+				results[i].Err = fmt.Errorf("batch response has no item for order %s", orderId)
+			case data.Code != "" && data.Code != "200":
+				results[i].Err = common.WrapKucoinError(data.Code, data.Msg)
+			default:
+				results[i].Order = &core.OrderResponse{
+					OrderID:    orderId,
+					Symbol:     symbol,
+					Status:     core.OrderStatusCanceled,
+					CreateTime: time.Now(),
+				}
+			}
+		}
+		return results
+	})
+}
+
 func (c *KucoinFuturesClient) CancelAll(symbol string) error {
 	ctx := context.Background()
 	restService := c.client.RestService()
@@ -278,20 +632,29 @@ func (c *KucoinFuturesClient) CancelAll(symbol string) error {
 
 // Helper functions
 
-func mapOrderStatus(order *order.GetOrderByOrderIdResp) core.OrderStatus {
+// mapOrderStatus maps an order, KuCoin only tells open from done orders. A cancelled order
+// expired when its time in force ended it, which is passed on as the reason.
+func mapOrderStatus(order *order.GetOrderByOrderIdResp) (core.OrderStatus, string) {
 	if order.Status == "open" {
-		return core.OrderStatusOpen
-	} else {
-		if order.CancelExist {
-			return core.OrderStatusCanceled
-		} else {
-			if order.DealSize != 0 {
-				return core.OrderStatusFilled
-			}
-			return core.OrderStatusError
+		return core.OpenStatus(decimal.NewFromInt(int64(order.DealSize))), ""
+	}
+	if order.CancelExist {
+		if order.TimeInForce == "IOC" || order.TimeInForce == "FOK" {
+			return core.OrderStatusExpired, order.TimeInForce
 		}
+		return core.OrderStatusCanceled, ""
 	}
+	if order.DealSize != 0 {
+		return core.OrderStatusFilled, ""
+	}
+	return core.OrderStatusError, ""
 }
 
 // Use common utility function
 var mapTifToKucoin = common.MapTifToKucoin
+
+// AmendOrder implements core.PrivateClient, KuCoin futures has no amend endpoint so the
+// order is cancelled and placed again
+func (c *KucoinFuturesClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	return core.CancelReplace(c, symbol, orderId, newQty, newPrice)
+}
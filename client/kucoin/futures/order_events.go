@@ -0,0 +1,170 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/futures/futuresprivate"
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// SubscribeOrderEvents implements core.PrivateClient with the private tradeOrders channel,
+// symbols filters the events and all orders are reported when it is empty. Quantity is in
+// lots and the executed quantities in base as in orderFull. The channel does not carry
+// fees, Commission and LastCommission stay zero.
+func (c *KucoinFuturesClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
+	c.orderEventMu.Lock()
+	defer c.orderEventMu.Unlock()
+	if c.orderEventCh != nil {
+		return c.orderEventCh, nil
+	}
+
+	ws := c.wsService.NewFuturesPrivateWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start private WebSocket: %w", err)
+	}
+
+	wanted := make(map[string]bool)
+	for _, symbol := range symbols {
+		wanted[symbol] = true
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	ch := make(chan core.OrderEvent, 100)
+	fills := newFillTracker()
+	var closedMu sync.RWMutex
+	closed := false
+
+	subId, err := ws.AllOrder(func(topic string, subject string, data *futuresprivate.AllOrderEvent) error {
+		if len(wanted) > 0 && !wanted[data.Symbol] {
+			return nil
+		}
+		mul, ok := c.multiplierMap[data.Symbol]
+		if !ok {
+			mul = decimal.NewFromInt(1)
+		}
+		event := fills.orderEvent(data, mul)
+		closedMu.RLock()
+		defer closedMu.RUnlock()
+		if !closed {
+			select {
+			case ch <- event:
+			case <-subCtx.Done():
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		cancel()
+		ws.Stop()
+		return nil, fmt.Errorf("failed to subscribe to order events: %w", err)
+	}
+	c.watchStream(subCtx, subId, errHandler)
+
+	c.orderEventCh = ch
+	c.orderEventCancel = cancel
+	go func() {
+		<-subCtx.Done()
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		ws.UnSubscribe(subId)
+		ws.Stop()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// UnsubscribeOrderEvents implements core.PrivateClient interface, the channel is closed once
+// the subscription stopped
+func (c *KucoinFuturesClient) UnsubscribeOrderEvents() error {
+	c.orderEventMu.Lock()
+	defer c.orderEventMu.Unlock()
+	if c.orderEventCancel != nil {
+		c.orderEventCancel()
+	}
+	c.orderEventCh, c.orderEventCancel = nil, nil
+	return nil
+}
+
+// fillTracker sums the matches of open orders, the channel reports no average price. The
+// average is left zero for orders that filled before the subscription. It is only used from
+// the SDK callback goroutine.
+type fillTracker struct {
+	fills map[string]trackedFills // order id : matches seen
+}
+
+type trackedFills struct {
+	qty      decimal.Decimal // lots
+	notional decimal.Decimal // price * lots
+}
+
+func newFillTracker() *fillTracker {
+	return &fillTracker{fills: make(map[string]trackedFills)}
+}
+
+// orderEvent converts an order update, match events carry the execution. mul converts lots
+// into base.
+func (t *fillTracker) orderEvent(data *futuresprivate.AllOrderEvent, mul decimal.Decimal) core.OrderEvent {
+	filled := core.ParseStringDecimal(data.FilledSize)
+	event := core.OrderEvent{
+		OrderID:     data.OrderId,
+		Symbol:      data.Symbol,
+		Side:        core.OrderSide(strings.ToUpper(data.Side)),
+		Price:       core.ParseStringDecimal(data.Price),
+		Quantity:    core.ParseStringDecimal(data.Size),
+		ExecutedQty: filled.Mul(mul),
+		UpdateTime:  time.Unix(0, data.Ts),
+	}
+	if data.ClientOid != nil {
+		event.ClientOrderID = *data.ClientOid
+	}
+	if data.OrderType != nil {
+		event.OrderType = strings.ToUpper(*data.OrderType)
+	}
+
+	seen := t.fills[data.OrderId]
+	if data.Type == "match" {
+		lots := optionalDecimal(data.MatchSize)
+		event.LastQty = lots.Mul(mul)
+		event.LastPrice = optionalDecimal(data.MatchPrice)
+		if data.TradeId != nil {
+			event.TradeID = *data.TradeId
+		}
+		event.IsMaker = data.Liquidity != nil && *data.Liquidity == "maker"
+		seen.qty = seen.qty.Add(lots)
+		seen.notional = seen.notional.Add(lots.Mul(event.LastPrice))
+		t.fills[data.OrderId] = seen
+	}
+	if seen.qty.IsPositive() && seen.qty.Equal(filled) {
+		event.AvgPrice = seen.notional.Div(seen.qty)
+	}
+
+	switch {
+	case data.Type == "filled":
+		event.Status = core.OrderStatusFilled
+	case data.Type == "canceled":
+		event.Status = core.OrderStatusCanceled
+	case data.Status == "done":
+		// a match that completes the order, the filled event follows
+		event.Status = core.OrderStatusFilled
+	default:
+		event.Status = core.OpenStatus(filled)
+	}
+	if data.Type == "filled" || data.Type == "canceled" {
+		delete(t.fills, data.OrderId)
+	}
+	return event
+}
+
+// optionalDecimal parses an optional decimal field, zero when absent
+func optionalDecimal(s *string) decimal.Decimal {
+	if s == nil {
+		return decimal.Zero
+	}
+	return core.ParseStringDecimal(*s)
+}
@@ -0,0 +1,81 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/futures/futurespublic"
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// SubscribeTrades implements core.PublicClient with the execution channel, side is the
+// taker side and sizes are converted from contracts to base quantity
+func (c *KucoinFuturesClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	ws := c.wsService.NewFuturesPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start futures WebSocket: %w", err)
+	}
+
+	chans := make(map[string]chan core.Trade)
+	out := make(map[string]<-chan core.Trade)
+	var subIds []string
+	var closedMu sync.RWMutex
+	closed := false
+
+	for _, symbol := range symbols {
+		ch := make(chan core.Trade, 256)
+		chans[symbol] = ch
+		out[symbol] = ch
+		mult, ok := c.multiplierMap[symbol]
+		if !ok {
+			mult = decimal.NewFromInt(1)
+		}
+
+		subId, err := ws.Execution(symbol, func(topic string, subject string, data *futurespublic.ExecutionEvent) error {
+			closedMu.RLock()
+			defer closedMu.RUnlock()
+			if !closed {
+				core.SendTrade(ctx, ch, core.Trade{
+					Symbol:   data.Symbol,
+					TradeID:  data.TradeId,
+					Price:    core.ParseStringDecimal(data.Price),
+					Quantity: decimal.NewFromInt(int64(data.Size)).Mul(mult),
+					Side:     core.OrderSide(strings.ToUpper(data.Side)),
+					Time:     time.Unix(0, data.Ts), // nanoseconds
+				})
+			}
+			return nil
+		})
+		if err != nil {
+			for _, id := range subIds {
+				ws.UnSubscribe(id)
+			}
+			ws.Stop()
+			return nil, fmt.Errorf("failed to subscribe to futures trades for %s: %w", symbol, err)
+		}
+		subIds = append(subIds, subId)
+		c.watchStream(ctx, subId, errHandler)
+		time.Sleep(time.Second / 10)
+	}
+
+	go func() {
+		<-ctx.Done()
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		for _, id := range subIds {
+			ws.UnSubscribe(id)
+		}
+		ws.Stop()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
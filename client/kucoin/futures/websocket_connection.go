@@ -74,6 +74,7 @@ func (c *KucoinFuturesClient) NewWebSocketConnection(ctx context.Context, symbol
 		}
 
 		conn.subscriptions = append(conn.subscriptions, subId)
+		c.watchStream(connCtx, subId, errHandler)
 		time.Sleep(time.Second / 10)
 	}
 	conn.loadingMu.Lock()
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/spot/market"
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/spot/spotpublic"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
 )
@@ -18,6 +21,7 @@ func (c *KucoinSpotClient) SubscribeQuotes(ctx context.Context, symbols []string
 	return c.NewWebSocketConnection(ctx, symbols, errHandler)
 }
 
+// FetchQuotes implements core.PublicClient, all tickers come in one request and are filtered
 func (c *KucoinSpotClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
 	out := make(map[string]core.Quote)
 
@@ -55,7 +59,46 @@ func (c *KucoinSpotClient) FetchQuotes(symbols []string) (map[string]core.Quote,
 			Time:     time.Now(),
 		}
 	}
-	return out, nil
+	return out, core.MissingSymbols("kucoin", symbols, out)
+}
+
+// FetchTickers implements core.PublicClient, all tickers come in one request and are
+// filtered. The change rate is a fraction and the open price is derived from the change.
+func (c *KucoinSpotClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	marketAPI := c.client.RestService().GetSpotService().GetMarketAPI()
+	resp, err := marketAPI.GetAllTickers(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tickers: %w", err)
+	}
+	tickers := make([]core.Ticker, len(resp.Ticker))
+	for i, t := range resp.Ticker {
+		last := core.ParseStringDecimal(t.Last)
+		tickers[i] = core.Ticker{
+			Symbol:        t.Symbol,
+			BidPrice:      core.ParseStringDecimal(t.Buy),
+			BidQty:        core.ParseStringDecimal(t.BestBidSize),
+			AskPrice:      core.ParseStringDecimal(t.Sell),
+			AskQty:        core.ParseStringDecimal(t.BestAskSize),
+			LastPrice:     last,
+			OpenPrice:     last.Sub(core.ParseStringDecimal(t.ChangePrice)),
+			HighPrice:     core.ParseStringDecimal(t.High),
+			LowPrice:      core.ParseStringDecimal(t.Low),
+			Volume:        core.ParseStringDecimal(t.Vol),
+			QuoteVolume:   core.ParseStringDecimal(t.VolValue),
+			ChangePercent: core.ParseStringDecimal(t.ChangeRate).Shift(2),
+			Time:          time.UnixMilli(resp.Time),
+		}
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// initRules sets up the rule cache that orders are rounded and validated against
+func (c *KucoinSpotClient) initRules() {
+	c.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		// BASE-QUOTE
+		_, quote, _ := strings.Cut(symbol, "-")
+		return c.FetchMarketRules([]string{quote})
+	}, core.DefaultRuleTTL)
 }
 
 func (c *KucoinSpotClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
@@ -115,6 +158,16 @@ func (c *KucoinSpotClient) FetchMarketRules(quotes []string) ([]core.MarketRule,
 			MaxQty:         maxQty,
 			TickSize:       minPrice, // PriceIncrement is the tick size
 			StepSize:       minQty,   // BaseIncrement is the step size
+			MinNotional:    core.ParseStringDecimal(symbolData.MinFunds),
+			MaxNotional:    core.ParseStringDecimal(symbolData.QuoteMaxSize),
+		}
+		if rule.MinNotional.IsZero() {
+			rule.MinNotional = core.ParseStringDecimal(symbolData.QuoteMinSize)
+		}
+		// price protection keeps a buy below and a sell above the opposite best price by the rate
+		if rate := core.ParseStringDecimal(symbolData.PriceLimitRate); rate.IsPositive() {
+			rule.BuyPriceBand.Up = decimal.NewFromInt(1).Add(rate)
+			rule.SellPriceBand.Down = decimal.NewFromInt(1).Sub(rate)
 		}
 
 		rules = append(rules, rule)
@@ -134,3 +187,144 @@ func calculatePrecision(increment string) int {
 	}
 	return len(parts[1])
 }
+
+// level50Depth is the depth of the level2 snapshots KuCoin pushes
+const level50Depth = 50
+
+// SubscribeOrderbook implements core.PublicClient interface
+// Books of up to 50 levels come from the level2 depth50 snapshots KuCoin pushes, deeper ones
+// are built from a REST snapshot and kept in sync with the level2 increments
+func (c *KucoinSpotClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	if depth <= 0 || depth > level50Depth {
+		return c.subscribeOrderbookIncrements(ctx, symbols, depth, errHandler)
+	}
+	ws := c.wsService.NewSpotPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start WebSocket: %w", err)
+	}
+
+	books := make(map[string]*core.LocalOrderbook)
+	chans := make(map[string]chan core.Orderbook)
+	out := make(map[string]<-chan core.Orderbook)
+	for _, symbol := range symbols {
+		books[symbol] = core.NewLocalOrderbook(symbol, depth)
+		chans[symbol] = make(chan core.Orderbook, 1)
+		out[symbol] = chans[symbol]
+	}
+
+	var closedMu sync.RWMutex
+	closed := false
+	subId, err := ws.OrderbookLevel50(symbols, func(topic string, subject string, data *spotpublic.OrderbookLevel50Event) error {
+		symbol := topic[strings.LastIndex(topic, ":")+1:]
+		book, exists := books[symbol]
+		if !exists {
+			return nil
+		}
+		book.ApplySnapshot(data.Timestamp, core.ParseOrderbookLevels(data.Bids), core.ParseOrderbookLevels(data.Asks))
+
+		closedMu.RLock()
+		defer closedMu.RUnlock()
+		if !closed {
+			core.SendOrderbook(chans[symbol], book.Orderbook())
+		}
+		return nil
+	})
+	if err != nil {
+		ws.Stop()
+		return nil, fmt.Errorf("failed to subscribe to orderbook: %w", err)
+	}
+	c.watchStream(ctx, subId, errHandler)
+
+	go func() {
+		<-ctx.Done()
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		ws.UnSubscribe(subId)
+		ws.Stop()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
+
+// subscribeOrderbookIncrements keeps books of any depth in sync with the level2 increments,
+// each carries the sequence range of its changes
+func (c *KucoinSpotClient) subscribeOrderbookIncrements(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	ws := c.wsService.NewSpotPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start WebSocket: %w", err)
+	}
+
+	syncers := make(map[string]*core.BookSyncer)
+	chans := make(map[string]chan core.Orderbook)
+	out := make(map[string]<-chan core.Orderbook)
+	for _, symbol := range symbols {
+		ch := make(chan core.Orderbook, 1)
+		chans[symbol] = ch
+		out[symbol] = ch
+		syncers[symbol] = core.NewBookSyncer(core.NewLocalOrderbook(symbol, depth), c.depthSnapshotFn(symbol, depth),
+			func(ob core.Orderbook) { core.SendOrderbook(ch, ob) }, errHandler)
+	}
+
+	subId, err := ws.OrderbookIncrement(symbols, func(topic string, subject string, data *spotpublic.OrderbookIncrementEvent) error {
+		symbol := topic[strings.LastIndex(topic, ":")+1:]
+		if syncer, exists := syncers[symbol]; exists {
+			syncer.Diff(data.SequenceStart, data.SequenceEnd,
+				core.ParseOrderbookLevels(data.Changes.Bids), core.ParseOrderbookLevels(data.Changes.Asks))
+		}
+		return nil
+	})
+	if err != nil {
+		ws.Stop()
+		return nil, fmt.Errorf("failed to subscribe to orderbook increments: %w", err)
+	}
+	c.watchStream(ctx, subId, errHandler)
+
+	go func() {
+		<-ctx.Done()
+		ws.UnSubscribe(subId)
+		ws.Stop()
+		for symbol, ch := range chans {
+			syncers[symbol].Close()
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
+
+// depthSnapshotFn returns the REST snapshot of symbol a book of depth levels is synced from,
+// the top 100 levels or the full book, which needs an api key
+func (c *KucoinSpotClient) depthSnapshotFn(symbol string, depth int) core.SnapshotFunc {
+	marketAPI := c.client.RestService().GetSpotService().GetMarketAPI()
+	return func() (int64, []core.OrderbookEntry, []core.OrderbookEntry, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		var sequence string
+		var bids, asks [][]string
+		if depth > 0 && depth <= 100 {
+			resp, err := marketAPI.GetPartOrderBook(market.NewGetPartOrderBookReqBuilder().
+				SetSymbol(symbol).SetSize("100").Build(), ctx)
+			if err != nil {
+				return 0, nil, nil, fmt.Errorf("failed to fetch depth snapshot: %w", err)
+			}
+			sequence, bids, asks = resp.Sequence, resp.Bids, resp.Asks
+		} else {
+			resp, err := marketAPI.GetFullOrderBook(market.NewGetFullOrderBookReqBuilder().
+				SetSymbol(symbol).Build(), ctx)
+			if err != nil {
+				return 0, nil, nil, fmt.Errorf("failed to fetch depth snapshot: %w", err)
+			}
+			sequence, bids, asks = resp.Sequence, resp.Bids, resp.Asks
+		}
+		seq, err := strconv.ParseInt(sequence, 10, 64)
+		if err != nil {
+			return 0, nil, nil, fmt.Errorf("invalid depth snapshot sequence %q: %w", sequence, err)
+		}
+		return seq, core.ParseOrderbookLevels(bids), core.ParseOrderbookLevels(asks), nil
+	}
+}
@@ -2,7 +2,9 @@ package kucoin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -37,6 +39,29 @@ func (c *KucoinSpotClient) FetchOrder(symbol, orderId string) (*core.OrderRespon
 		}
 		time.Sleep(time.Millisecond * 150)
 	}
+	return orderFull(resp), nil
+}
+
+// FetchOrderByClientID implements core.PrivateClient
+func (c *KucoinSpotClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	orderAPI := c.client.RestService().GetSpotService().GetOrderAPI()
+	req := order.NewGetOrderByClientOidReqBuilder().
+		SetClientOid(clientOrderID).
+		SetSymbol(symbol).
+		Build()
+	resp, err := orderAPI.GetOrderByClientOid(req, context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order: %w", common.WrapRestError(resp.CommonResponse, err))
+	}
+	if resp.Id == "" {
+		return nil, core.NewExchangeError("kucoin", core.ErrCategoryOrderNotFound, "", "no order found")
+	}
+	// the by client oid and by order id responses have the same fields
+	byId := order.GetOrderByOrderIdResp(*resp)
+	return orderFull(&byId), nil
+}
+
+func orderFull(resp *order.GetOrderByOrderIdResp) *core.OrderResponseFull {
 	// Parse order data from response fields
 	price, _ := decimal.NewFromString(resp.Price)
 	quantity, _ := decimal.NewFromString(resp.Size)
@@ -105,22 +130,152 @@ func (c *KucoinSpotClient) FetchOrder(symbol, orderId string) (*core.OrderRespon
 		side = core.OrderSideSell
 	}
 
+	status, reason := mapOrderStatus(resp)
 	return &core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
-			OrderID:    resp.Id,
-			Symbol:     resp.Symbol,
-			Side:       side,
-			Status:     mapOrderStatus(resp),
-			Price:      price,
-			Quantity:   quantity,
-			CreateTime: createTime,
+			OrderID:       resp.Id,
+			ClientOrderID: resp.ClientOid,
+			Symbol:        resp.Symbol,
+			Side:          side,
+			Status:        status,
+			Reason:        reason,
+			Price:         price,
+			Quantity:      quantity,
+			CreateTime:    createTime,
 		},
 		AvgPrice:        avgPrice,
 		ExecutedQty:     executedQty,
 		Commission:      fee,
 		CommissionAsset: resp.FeeCurrency,
 		UpdateTime:      updateTime,
-	}, nil
+	}
+}
+
+// orderListLimit and historyWindow bound one closed orders request
+const (
+	orderListLimit = 100
+	historyWindow  = 7 * 24 * time.Hour
+)
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists every symbol with
+// open orders
+func (c *KucoinSpotClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	orderAPI := c.client.RestService().GetSpotService().GetOrderAPI()
+	symbols := []string{symbol}
+	if symbol == "" {
+		resp, err := orderAPI.GetSymbolsWithOpenOrder(context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get symbols with open orders: %w", common.WrapRestError(resp.CommonResponse, err))
+		}
+		symbols = resp.Symbols
+	}
+	var orders []core.OrderResponseFull
+	for _, symbol := range symbols {
+		req := order.NewGetOpenOrdersReqBuilder().SetSymbol(symbol).Build()
+		resp, err := orderAPI.GetOpenOrders(req, context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get open orders: %w", common.WrapRestError(resp.CommonResponse, err))
+		}
+		for _, item := range resp.Data {
+			orders = append(orders, *orderFull(byOrderId(item)))
+		}
+	}
+	core.SortOrders(orders)
+	return orders, nil
+}
+
+// FetchOrderHistory implements core.PrivateClient, the pages of a span are followed by
+// lastId
+func (c *KucoinSpotClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	orderAPI := c.client.RestService().GetSpotService().GetOrderAPI()
+	return core.PageOrders(since, until, historyWindow, 0, func(start, end time.Time, _ int) ([]core.OrderResponseFull, error) {
+		builder := order.NewGetClosedOrdersReqBuilder().
+			SetSymbol(symbol).
+			SetStartAt(start.UnixMilli()).
+			SetEndAt(end.UnixMilli()).
+			SetLimit(orderListLimit)
+		var orders []core.OrderResponseFull
+		for {
+			resp, err := orderAPI.GetClosedOrders(builder.Build(), context.Background())
+			if err != nil {
+				return nil, fmt.Errorf("failed to get order history: %w", common.WrapRestError(resp.CommonResponse, err))
+			}
+			for _, item := range resp.Items {
+				orders = append(orders, *orderFull(byOrderId(item)))
+			}
+			if len(resp.Items) < orderListLimit {
+				return orders, nil
+			}
+			builder.SetLastId(resp.LastId)
+		}
+	})
+}
+
+// byOrderId converts a listed order into the order query response, they share the json
+// form
+func byOrderId(item interface{}) *order.GetOrderByOrderIdResp {
+	var resp order.GetOrderByOrderIdResp
+	raw, _ := json.Marshal(item)
+	_ = json.Unmarshal(raw, &resp)
+	return &resp
+}
+
+// FetchFills implements core.PrivateClient, the span of a request is at most seven days
+func (c *KucoinSpotClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, historyWindow, 0, func(start, end time.Time, _ int) ([]core.OrderFill, error) {
+		return c.tradeHistory(order.NewGetTradeHistoryReqBuilder().
+			SetSymbol(symbol).
+			SetStartAt(start.UnixMilli()).
+			SetEndAt(end.UnixMilli()))
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (c *KucoinSpotClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := c.tradeHistory(order.NewGetTradeHistoryReqBuilder().
+		SetSymbol(symbol).
+		SetOrderId(orderId))
+	if err != nil {
+		return nil, err
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// tradeHistory follows the pages of a trade history request by lastId
+func (c *KucoinSpotClient) tradeHistory(builder *order.GetTradeHistoryReqBuilder) ([]core.OrderFill, error) {
+	orderAPI := c.client.RestService().GetSpotService().GetOrderAPI()
+	builder.SetLimit(orderListLimit)
+	var fills []core.OrderFill
+	for {
+		resp, err := orderAPI.GetTradeHistory(builder.Build(), context.Background())
+		if err != nil {
+			return nil, fmt.Errorf("failed to get trade history: %w", common.WrapRestError(resp.CommonResponse, err))
+		}
+		for _, item := range resp.Items {
+			side := core.OrderSide(strings.ToUpper(item.Side))
+			price, _ := decimal.NewFromString(item.Price)
+			size, _ := decimal.NewFromString(item.Size)
+			fee, _ := decimal.NewFromString(item.Fee)
+			fills = append(fills, core.OrderFill{
+				TradeID:   strconv.FormatInt(item.TradeId, 10),
+				OrderID:   item.OrderId,
+				Symbol:    item.Symbol,
+				Side:      side,
+				Price:     price,
+				Quantity:  size,
+				Fee:       fee,
+				FeeAsset:  item.FeeCurrency,
+				IsMaker:   item.Liquidity == "maker",
+				TradeTime: time.UnixMilli(item.CreatedAt),
+				IsBuyer:   side == core.OrderSideBuy,
+			})
+		}
+		if len(resp.Items) < orderListLimit {
+			return fills, nil
+		}
+		builder.SetLastId(resp.LastId)
+	}
 }
 
 func (c *KucoinSpotClient) LimitBuy(symbol string, quantity, price decimal.Decimal, tif string) (*core.OrderResponse, error) {
@@ -158,7 +313,7 @@ func (c *KucoinSpotClient) placeLimitOrder(symbol, side string, quantity, price
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("order placement failed: %s", resp.Error)
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
 	}
 
 	// Convert string side to core.OrderSide
@@ -204,7 +359,7 @@ func (c *KucoinSpotClient) MarketBuy(symbol string, quoteQuantity decimal.Decima
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("market buy order failed: %s", resp.Error)
+		return nil, fmt.Errorf("market buy order failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
 	}
 
 	return &core.OrderResponse{
@@ -242,7 +397,7 @@ func (c *KucoinSpotClient) MarketSell(symbol string, quantity decimal.Decimal) (
 	}
 
 	if !resp.Success {
-		return nil, fmt.Errorf("market sell order failed: %s", resp.Error)
+		return nil, fmt.Errorf("market sell order failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
 	}
 
 	return &core.OrderResponse{
@@ -255,6 +410,127 @@ func (c *KucoinSpotClient) MarketSell(symbol string, quantity decimal.Decimal) (
 	}, nil
 }
 
+// PlaceOrder implements core.PrivateClient, the client order id is sent as clientOid
+func (c *KucoinSpotClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := c.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	if c.privateWS == nil || !c.privateWS.IsConnected() {
+		return nil, fmt.Errorf("private WebSocket not connected, please call Connect() first")
+	}
+	wsReq := &OrderWSRequest{
+		ClientOid: req.ClientOrderID,
+		Side:      strings.ToLower(string(req.Side)),
+		Symbol:    req.Symbol,
+		Type:      "market",
+		Size:      req.Quantity.String(),
+		Stp:       common.MapSTPToKucoin(req.STPMode),
+	}
+	if req.Type == core.OrderTypeLimit {
+		wsReq.Type = "limit"
+		wsReq.Price = req.Price.String()
+		wsReq.TimeInForce = mapTifToKucoin(string(req.TimeInForce))
+		wsReq.PostOnly = req.PostOnly
+	} else if req.QuoteQuantity.IsPositive() {
+		wsReq.Size = ""
+		wsReq.Funds = req.QuoteQuantity.String()
+	}
+
+	resp, err := c.privateWS.PlaceOrderCtx(ctx, wsReq)
+	if err != nil {
+		return core.ResolvePlacement(c, req, fmt.Errorf("failed to place %s order: %w", wsReq.Side, err))
+	}
+	if !resp.Success {
+		return nil, fmt.Errorf("order placement failed: %w", common.WrapKucoinError(resp.Code, resp.Error))
+	}
+
+	res := &core.OrderResponse{
+		OrderID:       resp.OrderID,
+		ClientOrderID: req.ClientOrderID,
+		Symbol:        req.Symbol,
+		Side:          req.Side,
+		Tif:           req.TimeInForce,
+		Status:        core.OrderStatusOpen,
+		Price:         req.Price,
+		Quantity:      req.Quantity,
+		CreateTime:    time.Now(),
+	}
+	if req.QuoteQuantity.IsPositive() {
+		res.Quantity = req.QuoteQuantity
+		res.IsQuoteQuantity = true
+	}
+	return res, nil
+}
+
+// spotBatchSize is the max orders of a spot batch
+const spotBatchSize = 5
+
+// PlaceOrders implements core.PrivateClient. The spot batch endpoint only takes limit
+// orders of one symbol, other batches are placed one by one.
+func (c *KucoinSpotClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	for _, req := range reqs {
+		if req.Type != core.OrderTypeLimit || req.Symbol != reqs[0].Symbol {
+			return core.PlaceEach(ctx, reqs, c.PlaceOrder)
+		}
+	}
+	return c.rules.PlaceBatches(ctx, reqs, spotBatchSize, c.placeBatch)
+}
+
+func (c *KucoinSpotClient) placeBatch(ctx context.Context, reqs []core.OrderRequest) []core.OrderResult {
+	list := make([]order.BatchAddOrdersOrderList, len(reqs))
+	for i, req := range reqs {
+		builder := order.NewBatchAddOrdersOrderListBuilder().
+			SetClientOid(req.ClientOrderID).
+			SetSymbol(req.Symbol).
+			SetType("limit").
+			SetSide(strings.ToLower(string(req.Side))).
+			SetPrice(req.Price.String()).
+			SetSize(req.Quantity.String()).
+			SetTimeInForce(mapTifToKucoin(string(req.TimeInForce))).
+			SetPostOnly(req.PostOnly)
+		if stp := common.MapSTPToKucoin(req.STPMode); stp != "" {
+			builder.SetStp(stp)
+		}
+		list[i] = *builder.Build()
+	}
+	orderAPI := c.client.RestService().GetSpotService().GetOrderAPI()
+	resp, err := orderAPI.BatchAddOrders(order.NewBatchAddOrdersReqBuilder().SetOrderList(list).Build(), ctx)
+	if err == nil && len(resp.Data) != len(reqs) {
+		err = fmt.Errorf("batch response has %d items for %d orders", len(resp.Data), len(reqs))
+	}
+	if err != nil {
+		if resp != nil {
+			err = common.WrapRestError(resp.CommonResponse, err)
+		}
+		return core.ResolveBatch(c, reqs, fmt.Errorf("failed to place batch orders: %w", err))
+	}
+
+	results := make([]core.OrderResult, len(reqs))
+	for i, data := range resp.Data {
+		req := reqs[i]
+		if !data.Success || data.OrderId == nil {
+			msg := "order rejected"
+			if data.FailMsg != nil {
+				msg = *data.FailMsg
+			}
+			results[i].Err = common.WrapKucoinError("", msg)
+			continue
+		}
+		results[i].Order = &core.OrderResponse{
+			OrderID:       *data.OrderId,
+			ClientOrderID: req.ClientOrderID,
+			Symbol:        req.Symbol,
+			Side:          req.Side,
+			Tif:           req.TimeInForce,
+			Status:        core.OrderStatusOpen,
+			Price:         req.Price,
+			Quantity:      req.Quantity,
+			CreateTime:    time.Now(),
+		}
+	}
+	return results
+}
+
 func (c *KucoinSpotClient) CancelOrder(symbol, orderId string) (*core.OrderResponse, error) {
 	restService := c.client.RestService()
 	spotService := restService.GetSpotService()
@@ -295,25 +571,81 @@ func (c *KucoinSpotClient) CancelAll(symbol string) error {
 	return nil
 }
 
+// CancelOrders implements core.PrivateClient, spot has no batch cancel by order id so
+// orders are cancelled one by one
+func (c *KucoinSpotClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelEach(ctx, orderIds, func(orderId string) (*core.OrderResponse, error) {
+		return c.CancelOrder(symbol, orderId)
+	})
+}
+
 // Helper functions
 
-func mapOrderStatus(order *order.GetOrderByOrderIdResp) core.OrderStatus {
-	if order.Type == "market" {
-		if order.DealSize != "" {
-			return core.OrderStatusFilled
-		}
-	}
+// mapOrderStatus maps an order, KuCoin only tells active from done orders. A done order
+// that did not fill was cancelled, or expired when its time in force ended it, which is
+// passed on as the reason.
+func mapOrderStatus(order *order.GetOrderByOrderIdResp) (core.OrderStatus, string) {
 	if order == nil {
-		return core.OrderStatusError
+		return core.OrderStatusError, ""
 	}
+	dealSize, _ := decimal.NewFromString(order.DealSize)
 	if order.Active {
-		return core.OrderStatusOpen
+		return core.OpenStatus(dealSize), ""
+	}
+	if order.Type == "market" && order.DealSize != "" {
+		return core.OrderStatusFilled, ""
 	}
 	if order.DealSize == order.Size {
-		return core.OrderStatusFilled
+		return core.OrderStatusFilled, ""
+	}
+	if order.TimeInForce == "IOC" || order.TimeInForce == "FOK" {
+		return core.OrderStatusExpired, order.TimeInForce
 	}
-	return core.OrderStatusCanceled
+	return core.OrderStatusCanceled, ""
 }
 
 // Use common utility function
 var mapTifToKucoin = common.MapTifToKucoin
+
+// AmendOrder implements core.PrivateClient with the HF modify endpoint. KuCoin cancels the
+// order and places a new one atomically, so the order id changes and priority is lost.
+func (c *KucoinSpotClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	if err := core.ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	prev, err := c.FetchOrder(symbol, orderId)
+	if err != nil {
+		return nil, err
+	}
+	if !prev.Status.IsOpen() {
+		return nil, fmt.Errorf("amend %s: order is %s", orderId, prev.Status)
+	}
+
+	orderAPI := c.client.RestService().GetSpotService().GetOrderAPI()
+	req := order.NewModifyOrderReqBuilder().
+		SetSymbol(symbol).
+		SetOrderId(orderId).
+		SetNewPrice(newPrice.String()).
+		SetNewSize(newQty.String()).
+		Build()
+
+	resp, err := orderAPI.ModifyOrder(req, context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to amend order: %w", err)
+	}
+
+	return &core.AmendResult{
+		Order: &core.OrderResponse{
+			OrderID:    resp.NewOrderId,
+			Symbol:     symbol,
+			Side:       prev.Side,
+			Tif:        prev.Tif,
+			Status:     core.OrderStatusOpen,
+			Price:      newPrice,
+			Quantity:   newQty,
+			CreateTime: time.Now(),
+		},
+		OriginalOrderID: orderId,
+		Replaced:        true,
+	}, nil
+}
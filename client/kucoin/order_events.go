@@ -0,0 +1,162 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/spot/spotprivate"
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// SubscribeOrderEvents implements core.PrivateClient with the private tradeOrdersV2 channel,
+// symbols filters the events and all orders are reported when it is empty. The channel does
+// not carry fees, Commission and LastCommission stay zero.
+func (c *KucoinSpotClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
+	c.orderEventMu.Lock()
+	defer c.orderEventMu.Unlock()
+	if c.orderEventCh != nil {
+		return c.orderEventCh, nil
+	}
+
+	ws := c.wsService.NewSpotPrivateWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start private WebSocket: %w", err)
+	}
+
+	wanted := make(map[string]bool)
+	for _, symbol := range symbols {
+		wanted[symbol] = true
+	}
+	subCtx, cancel := context.WithCancel(ctx)
+	ch := make(chan core.OrderEvent, 100)
+	fills := newFillTracker()
+	var closedMu sync.RWMutex
+	closed := false
+
+	subId, err := ws.OrderV2(func(topic string, subject string, data *spotprivate.OrderV2Event) error {
+		if len(wanted) > 0 && !wanted[data.Symbol] {
+			return nil
+		}
+		event := fills.orderEvent(data)
+		closedMu.RLock()
+		defer closedMu.RUnlock()
+		if !closed {
+			select {
+			case ch <- event:
+			case <-subCtx.Done():
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		cancel()
+		ws.Stop()
+		return nil, fmt.Errorf("failed to subscribe to order events: %w", err)
+	}
+	c.watchStream(subCtx, subId, errHandler)
+
+	c.orderEventCh = ch
+	c.orderEventCancel = cancel
+	go func() {
+		<-subCtx.Done()
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		ws.UnSubscribe(subId)
+		ws.Stop()
+		close(ch)
+	}()
+	return ch, nil
+}
+
+// UnsubscribeOrderEvents implements core.PrivateClient interface, the channel is closed once
+// the subscription stopped
+func (c *KucoinSpotClient) UnsubscribeOrderEvents() error {
+	c.orderEventMu.Lock()
+	defer c.orderEventMu.Unlock()
+	if c.orderEventCancel != nil {
+		c.orderEventCancel()
+	}
+	c.orderEventCh, c.orderEventCancel = nil, nil
+	return nil
+}
+
+// fillTracker sums the matches of open orders, the channel reports no average price. The
+// average is left zero for orders that filled before the subscription. It is only used from
+// the SDK callback goroutine.
+type fillTracker struct {
+	fills map[string]trackedFills // order id : matches seen
+}
+
+type trackedFills struct {
+	qty      decimal.Decimal
+	notional decimal.Decimal
+}
+
+func newFillTracker() *fillTracker {
+	return &fillTracker{fills: make(map[string]trackedFills)}
+}
+
+// orderEvent converts an order update, match events carry the execution
+func (t *fillTracker) orderEvent(data *spotprivate.OrderV2Event) core.OrderEvent {
+	quantity := core.ParseStringDecimal(data.OriginSize)
+	if data.Size != nil {
+		quantity = core.ParseStringDecimal(*data.Size)
+	}
+	event := core.OrderEvent{
+		OrderID:       data.OrderId,
+		ClientOrderID: data.ClientOid,
+		Symbol:        data.Symbol,
+		Side:          core.OrderSide(strings.ToUpper(data.Side)),
+		OrderType:     strings.ToUpper(data.OrderType),
+		Price:         optionalDecimal(data.Price),
+		Quantity:      quantity,
+		ExecutedQty:   optionalDecimal(data.FilledSize),
+		UpdateTime:    time.Unix(0, data.Ts),
+	}
+
+	seen := t.fills[data.OrderId]
+	if data.Type == "match" {
+		event.LastQty = optionalDecimal(data.MatchSize)
+		event.LastPrice = optionalDecimal(data.MatchPrice)
+		if data.TradeId != nil {
+			event.TradeID = *data.TradeId
+		}
+		event.IsMaker = data.Liquidity != nil && *data.Liquidity == "maker"
+		seen.qty = seen.qty.Add(event.LastQty)
+		seen.notional = seen.notional.Add(event.LastQty.Mul(event.LastPrice))
+		t.fills[data.OrderId] = seen
+	}
+	if seen.qty.IsPositive() && seen.qty.Equal(event.ExecutedQty) {
+		event.AvgPrice = seen.notional.Div(seen.qty)
+	}
+
+	switch {
+	case data.Type == "filled":
+		event.Status = core.OrderStatusFilled
+	case data.Type == "canceled":
+		event.Status = core.OrderStatusCanceled
+	case data.Status == "done":
+		// a match that completes the order, the filled event follows
+		event.Status = core.OrderStatusFilled
+	default:
+		event.Status = core.OpenStatus(event.ExecutedQty)
+	}
+	if data.Type == "filled" || data.Type == "canceled" {
+		delete(t.fills, data.OrderId)
+	}
+	return event
+}
+
+// optionalDecimal parses an optional decimal field, zero when absent
+func optionalDecimal(s *string) decimal.Decimal {
+	if s == nil {
+		return decimal.Zero
+	}
+	return core.ParseStringDecimal(*s)
+}
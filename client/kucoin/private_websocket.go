@@ -136,8 +136,9 @@ func (ws *PrivateWebSocket) Connect() error {
 	}
 	if _, hasCode := msg["code"]; hasCode {
 		// Error message - log it and send to error channel
-		msgBytes, _ := json.Marshal(msg)
-		return fmt.Errorf("KuCoin WebSocket error: %s", string(msgBytes))
+		code := fmt.Sprintf("%v", msg["code"])
+		errMsg, _ := msg["data"].(string)
+		return fmt.Errorf("KuCoin WebSocket error: %w", common.WrapKucoinError(code, errMsg))
 	}
 	// Start message reader
 	go ws.readMessages()
@@ -233,6 +234,11 @@ func (ws *PrivateWebSocket) readMessages() {
 
 // PlaceOrder places an order via WebSocket
 func (ws *PrivateWebSocket) PlaceOrder(req *OrderWSRequest) (*OrderWSResponse, error) {
+	return ws.PlaceOrderCtx(context.Background(), req)
+}
+
+// PlaceOrderCtx places an order via WebSocket and waits for the response until ctx is done
+func (ws *PrivateWebSocket) PlaceOrderCtx(ctx context.Context, req *OrderWSRequest) (*OrderWSResponse, error) {
 	// Generate request ID
 	ws.requestIDMu.Lock()
 	ws.requestID++
@@ -259,18 +265,26 @@ func (ws *PrivateWebSocket) PlaceOrder(req *OrderWSRequest) (*OrderWSResponse, e
 		args["size"] = req.Size
 		args["timeInForce"] = strings.ToUpper(req.TimeInForce)
 	case "market":
-		if req.Side == "buy" {
+		if req.Funds != "" {
 			args["funds"] = req.Funds
 		} else {
 			args["size"] = req.Size
 		}
 	}
+	if req.ClientOid != "" {
+		args["clientOid"] = req.ClientOid
+	}
+	if req.PostOnly {
+		args["postOnly"] = true
+	}
+	if req.Stp != "" {
+		args["stp"] = req.Stp
+	}
 
 	operation := "spot.order"
 	// Futures-specific configuration
 	if ws.marketType == common.MarketTypeFutures {
 		operation = "futures.order"
-		args["clientOid"] = req.ClientOid
 
 		// Margin mode configuration
 		if req.MarginMode != "CROSS" {
@@ -282,12 +296,23 @@ func (ws *PrivateWebSocket) PlaceOrder(req *OrderWSRequest) (*OrderWSResponse, e
 			args["marginMode"] = req.MarginMode
 		}
 		if req.Type == "market" {
-			if req.Side == "buy" {
+			switch {
+			case req.Size != "":
+				args["size"] = req.Size // in lots
+			case req.ValueQty != "":
 				args["valueQty"] = req.ValueQty
-			} else {
+			default:
 				args["qty"] = req.Qty
 			}
 		}
+		if req.Stop != "" {
+			args["stop"] = req.Stop
+			args["stopPrice"] = req.StopPrice
+			args["stopPriceType"] = req.StopPriceType
+		}
+		if req.ReduceOnly {
+			args["reduceOnly"] = true
+		}
 	}
 
 	// Use the unified trading API message format
@@ -322,6 +347,12 @@ func (ws *PrivateWebSocket) PlaceOrder(req *OrderWSRequest) (*OrderWSResponse, e
 		ws.pendingMu.Unlock()
 		close(respChan)
 		return nil, fmt.Errorf("order placement timeout")
+	case <-ctx.Done():
+		ws.pendingMu.Lock()
+		delete(ws.pendingOrders, requestID)
+		ws.pendingMu.Unlock()
+		close(respChan)
+		return nil, ctx.Err()
 	case <-ws.ctx.Done():
 		return nil, fmt.Errorf("connection closed")
 	}
@@ -439,6 +470,11 @@ func (ws *PrivateWebSocket) handleErrorRaw(msg map[string]interface{}) {
 	response := &OrderWSResponse{
 		Success: false,
 	}
+	if code, ok := msg["code"].(string); ok {
+		response.Code = code
+	} else if code, ok := msg["code"].(float64); ok {
+		response.Code = strconv.FormatInt(int64(code), 10)
+	}
 
 	// Extract error message from various possible fields
 	if errMsg, ok := msg["msg"].(string); ok {
@@ -0,0 +1,73 @@
+package kucoin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Kucoin/kucoin-universal-sdk/sdk/golang/pkg/generate/spot/spotpublic"
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// SubscribeTrades implements core.PublicClient with the match channel, side is the taker side
+func (c *KucoinSpotClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	ws := c.wsService.NewSpotPublicWS()
+	if err := ws.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start WebSocket: %w", err)
+	}
+
+	chans := make(map[string]chan core.Trade)
+	out := make(map[string]<-chan core.Trade)
+	for _, symbol := range symbols {
+		chans[symbol] = make(chan core.Trade, 256)
+		out[symbol] = chans[symbol]
+	}
+
+	var closedMu sync.RWMutex
+	closed := false
+	subId, err := ws.Trade(symbols, func(topic string, subject string, data *spotpublic.TradeEvent) error {
+		ch, exists := chans[data.Symbol]
+		if !exists {
+			return nil
+		}
+		// time is in nanoseconds
+		ns, _ := strconv.ParseInt(data.Time, 10, 64)
+
+		closedMu.RLock()
+		defer closedMu.RUnlock()
+		if !closed {
+			core.SendTrade(ctx, ch, core.Trade{
+				Symbol:   data.Symbol,
+				TradeID:  data.TradeId,
+				Price:    core.ParseStringDecimal(data.Price),
+				Quantity: core.ParseStringDecimal(data.Size),
+				Side:     core.OrderSide(strings.ToUpper(data.Side)),
+				Time:     time.Unix(0, ns),
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		ws.Stop()
+		return nil, fmt.Errorf("failed to subscribe to trades: %w", err)
+	}
+	c.watchStream(ctx, subId, errHandler)
+
+	go func() {
+		<-ctx.Done()
+		closedMu.Lock()
+		closed = true
+		closedMu.Unlock()
+
+		ws.UnSubscribe(subId)
+		ws.Stop()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
@@ -70,6 +70,7 @@ func (c *KucoinSpotClient) NewWebSocketConnection(ctx context.Context, symbols [
 	}
 	
 	conn.subscriptions = append(conn.subscriptions, subId)
+	c.watchStream(connCtx, subId, errHandler)
 	
 	// Monitor context cancellation
 	go func() {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
@@ -141,15 +143,29 @@ func (c *OKXClient) FetchOrder(symbol, orderId string) (*core.OrderResponseFull,
 		return nil, fmt.Errorf("order not found: %s", orderId)
 	}
 	
-	order := response.Data[0]
-	status := c.mapOrderStatus(order.State)
-	
+	return c.orderFull(response.Data[0]), nil
+}
+
+// FetchOrderByClientID implements core.PrivateClient. It queries REST, the ws api has no
+// order query op.
+func (c *OKXClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	order, err := FetchOrderByClOrdID(c.apiKey, c.secretKey, c.passphrase, symbol, clientOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order: %w", err)
+	}
+	return c.orderFull(*order), nil
+}
+
+func (c *OKXClient) orderFull(order OKXOrder) *core.OrderResponseFull {
+	status, reason := order.OrderStatus()
 	return &core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
 			OrderID:         order.OrdID,
+			ClientOrderID:   order.ClOrdID,
 			Symbol:          order.InstID,
-			Side:            order.Side,
+			Side:            core.OrderSide(strings.ToUpper(order.Side)),
 			Status:          status,
+			Reason:          reason,
 			Price:           ToDecimal(order.Px),
 			Quantity:        ToDecimal(order.Sz),
 			IsQuoteQuantity: false,
@@ -157,8 +173,59 @@ func (c *OKXClient) FetchOrder(symbol, orderId string) (*core.OrderResponseFull,
 		},
 		AvgPrice:        ToDecimal(order.AvgPx),
 		ExecutedQty:     ToDecimal(order.AccFillSz),
-		Commission:      ToDecimal(order.Fee),
+		Commission:      ToDecimal(order.Fee).Neg(), // negative when charged
 		CommissionAsset: order.FeeCcy,
 		UpdateTime:      ToTime(order.UTime),
-	}, nil
-}
\ No newline at end of file
+	}
+}
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all symbols
+func (c *OKXClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	orders, err := FetchPendingOrders(c.apiKey, c.secretKey, c.passphrase, "SPOT", symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open orders: %w", err)
+	}
+	return c.ordersFull(orders), nil
+}
+
+// FetchOrderHistory implements core.PrivateClient from the history archive of three months
+func (c *OKXClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, HistoryWindow, 0, func(start, end time.Time, _ int) ([]core.OrderResponseFull, error) {
+		orders, err := FetchOrderHistory(c.apiKey, c.secretKey, c.passphrase, "SPOT", symbol, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch order history: %w", err)
+		}
+		return c.ordersFull(orders), nil
+	})
+}
+
+// FetchFills implements core.PrivateClient from the fills history of three months
+func (c *OKXClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, HistoryWindow, 0, func(start, end time.Time, _ int) ([]core.OrderFill, error) {
+		fills, err := FetchFills(c.apiKey, c.secretKey, c.passphrase, "SPOT", symbol, "", start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch fills: %w", err)
+		}
+		return fills, nil
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (c *OKXClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := FetchFills(c.apiKey, c.secretKey, c.passphrase, "SPOT", symbol, orderId, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order fills: %w", err)
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// ordersFull converts a listing oldest first
+func (c *OKXClient) ordersFull(orders []OKXOrder) []core.OrderResponseFull {
+	out := make([]core.OrderResponseFull, len(orders))
+	for i, order := range orders {
+		out[i] = *c.orderFull(order)
+	}
+	core.SortOrders(out)
+	return out
+}
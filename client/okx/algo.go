@@ -0,0 +1,219 @@
+package okx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// algo orders are REST only, the ws api has no order-algo op
+const (
+	algoOrderPath  = "/api/v5/trade/order-algo"
+	algoCancelPath = "/api/v5/trade/cancel-algos"
+)
+
+// orderPath queries a single order, REST only as well
+const orderPath = "/api/v5/trade/order"
+
+var triggerPxTypes = map[core.TriggerSource]string{
+	core.TriggerSourceLast:  "last",
+	core.TriggerSourceMark:  "mark",
+	core.TriggerSourceIndex: "index",
+}
+
+// AlgoOrderArgs builds the order-algo body of a conditional order, tdMode is "cash" for
+// spot and the margin mode for swaps. Stop-loss and take-profit are conditional algo
+// orders, trailing stops are move_order_stop and trigger on the last price.
+func AlgoOrderArgs(req core.ConditionalOrderRequest, tdMode string) (map[string]interface{}, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	args := map[string]interface{}{
+		"instId": req.Symbol,
+		"tdMode": tdMode,
+		"side":   strings.ToLower(string(req.Side)),
+		"sz":     req.Quantity.String(),
+	}
+	if tdMode == "cash" {
+		args["tgtCcy"] = "base_ccy" // spot market buys are sized in quote otherwise
+	} else if req.ReduceOnly {
+		args["reduceOnly"] = true
+	}
+
+	ordPx := "-1" // market on trigger
+	if req.LimitPrice.IsPositive() {
+		ordPx = req.LimitPrice.String()
+	}
+	switch req.Type {
+	case core.ConditionalStopLoss:
+		args["ordType"] = "conditional"
+		args["slTriggerPx"] = req.TriggerPrice.String()
+		args["slOrdPx"] = ordPx
+		args["slTriggerPxType"] = triggerPxTypes[req.Source()]
+	case core.ConditionalTakeProfit:
+		args["ordType"] = "conditional"
+		args["tpTriggerPx"] = req.TriggerPrice.String()
+		args["tpOrdPx"] = ordPx
+		args["tpTriggerPxType"] = triggerPxTypes[req.Source()]
+	case core.ConditionalTrailingStop:
+		if req.LimitPrice.IsPositive() {
+			return nil, fmt.Errorf("okx trailing stops are market orders only")
+		}
+		if req.Source() != core.TriggerSourceLast {
+			return nil, core.ErrUnsupportedTriggerSource("okx trailing stop", req.Source())
+		}
+		args["ordType"] = "move_order_stop"
+		args["callbackRatio"] = req.TrailingDelta.Div(decimal.NewFromInt(100)).String()
+		if req.TriggerPrice.IsPositive() {
+			args["activePx"] = req.TriggerPrice.String()
+		}
+	}
+	return args, nil
+}
+
+// PlaceAlgoOrder places an algo order and returns its algoId
+func PlaceAlgoOrder(apiKey, secretKey, passphrase string, args map[string]interface{}) (string, error) {
+	data, err := privateAlgoRequest(apiKey, secretKey, passphrase, algoOrderPath, args)
+	if err != nil {
+		return "", fmt.Errorf("failed to place algo order: %w", err)
+	}
+	return data.AlgoID, nil
+}
+
+// CancelAlgoOrder cancels an algo order that has not triggered yet
+func CancelAlgoOrder(apiKey, secretKey, passphrase, instId, algoId string) error {
+	body := []map[string]string{{"instId": instId, "algoId": algoId}}
+	if _, err := privateAlgoRequest(apiKey, secretKey, passphrase, algoCancelPath, body); err != nil {
+		return fmt.Errorf("failed to cancel algo order: %w", err)
+	}
+	return nil
+}
+
+type algoResult struct {
+	AlgoID string `json:"algoId"`
+	SCode  string `json:"sCode"`
+	SMsg   string `json:"sMsg"`
+}
+
+// privateAlgoRequest posts a signed request and checks both the request and item codes
+func privateAlgoRequest(apiKey, secretKey, passphrase, path string, body interface{}) (*algoResult, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq, err := http.NewRequest(http.MethodPost, okxRestURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range CreateAuthHeaders(apiKey, secretKey, passphrase, http.MethodPost, path, string(payload)) {
+		httpReq.Header.Set(k, v)
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Code string       `json:"code"`
+		Msg  string       `json:"msg"`
+		Data []algoResult `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode response (status %d): %w", resp.StatusCode, err)
+	}
+	// a failed item carries the precise code, the request code is generic then
+	if len(res.Data) > 0 && res.Data[0].SCode != "" && res.Data[0].SCode != "0" {
+		return nil, ParseOKXError(res.Data[0].SCode, res.Data[0].SMsg)
+	}
+	if err := ParseOKXError(res.Code, res.Msg); err != nil {
+		return nil, err
+	}
+	if len(res.Data) == 0 {
+		return nil, fmt.Errorf("empty algo response")
+	}
+	return &res.Data[0], nil
+}
+
+// FetchOrderByClOrdID queries an order by its client order id
+func FetchOrderByClOrdID(apiKey, secretKey, passphrase, instId, clOrdId string) (*OKXOrder, error) {
+	orders, err := getOrders(apiKey, secretKey, passphrase, orderPath, url.Values{"instId": {instId}, "clOrdId": {clOrdId}})
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, core.NewExchangeError("okx", core.ErrCategoryOrderNotFound, "", "no order found")
+	}
+	return &orders[0], nil
+}
+
+// getOrders sends a signed GET to an order query endpoint
+func getOrders(apiKey, secretKey, passphrase, path string, params url.Values) ([]OKXOrder, error) {
+	return getList[OKXOrder](apiKey, secretKey, passphrase, path, params)
+}
+
+// getList sends a signed GET request and decodes the data of the response
+func getList[T any](apiKey, secretKey, passphrase, path string, params url.Values) ([]T, error) {
+	path += "?" + params.Encode()
+	httpReq, err := http.NewRequest(http.MethodGet, okxRestURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range CreateAuthHeaders(apiKey, secretKey, passphrase, http.MethodGet, path, "") {
+		httpReq.Header.Set(k, v)
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Code string `json:"code"`
+		Msg  string `json:"msg"`
+		Data []T    `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode response (status %d): %w", resp.StatusCode, err)
+	}
+	if err := ParseOKXError(res.Code, res.Msg); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// AlgoOrderResponse is the pending order of a placed algo order
+func AlgoOrderResponse(req core.ConditionalOrderRequest, algoId string) *core.OrderResponse {
+	tif := req.TimeInForce
+	if tif == "" {
+		tif = core.TimeInForceGTC
+	}
+	return &core.OrderResponse{
+		OrderID:    algoId,
+		Symbol:     req.Symbol,
+		Side:       req.Side,
+		Tif:        tif,
+		Status:     core.OrderStatusOpen,
+		Price:      req.LimitPrice,
+		Quantity:   req.Quantity,
+		CreateTime: time.Now(),
+	}
+}
@@ -0,0 +1,179 @@
+package okx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+const (
+	okxRestURL = "https://www.okx.com"
+	// candlePageLimit is the max number of candles per history-candles request
+	candlePageLimit = 100
+)
+
+// candleBars maps intervals to OKX bars, daily and longer bars use UTC boundaries like the other exchanges
+var candleBars = map[core.CandleInterval]string{
+	core.CandleInterval1m:  "1m",
+	core.CandleInterval3m:  "3m",
+	core.CandleInterval5m:  "5m",
+	core.CandleInterval15m: "15m",
+	core.CandleInterval30m: "30m",
+	core.CandleInterval1h:  "1H",
+	core.CandleInterval2h:  "2H",
+	core.CandleInterval4h:  "4H",
+	core.CandleInterval6h:  "6Hutc",
+	core.CandleInterval12h: "12Hutc",
+	core.CandleInterval1d:  "1Dutc",
+	core.CandleInterval1w:  "1Wutc",
+	core.CandleInterval1M:  "1Mutc",
+}
+
+// FetchCandles implements core.PublicClient
+func (c *OKXClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	return FetchInstrumentCandles(symbol, interval, start, end, limit, false)
+}
+
+// FetchInstrumentCandles pages through history-candles of instID. For contracts the base
+// volume is taken from volCcy since vol counts contracts.
+func FetchInstrumentCandles(instID string, interval core.CandleInterval, start, end time.Time, limit int, contracts bool) ([]core.Candle, error) {
+	bar, ok := candleBars[interval]
+	if !ok {
+		return nil, core.ErrUnsupportedInterval("okx", interval)
+	}
+	volIdx := 5
+	if contracts {
+		volIdx = 6
+	}
+	return core.PageCandles(start, end, limit, candlePageLimit, func(start, end time.Time, limit int) ([]core.Candle, error) {
+		params := url.Values{}
+		params.Set("instId", instID)
+		params.Set("bar", bar)
+		params.Set("after", strconv.FormatInt(end.UnixMilli()+1, 10)) // after returns records older than ts
+		params.Set("limit", strconv.Itoa(limit))
+		if !start.IsZero() {
+			params.Set("before", strconv.FormatInt(start.UnixMilli()-1, 10))
+		}
+		resp, err := http.Get(okxRestURL + "/api/v5/market/history-candles?" + params.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch candles: %w", err)
+		}
+		defer resp.Body.Close()
+
+		var res struct {
+			Code string     `json:"code"`
+			Msg  string     `json:"msg"`
+			Data [][]string `json:"data"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+			return nil, fmt.Errorf("failed to decode candles: %w", err)
+		}
+		if res.Code != "0" {
+			return nil, ParseOKXError(res.Code, res.Msg)
+		}
+		// rows are [ts, o, h, l, c, vol, volCcy, volCcyQuote, confirm], newest first
+		candles := make([]core.Candle, 0, len(res.Data))
+		for _, row := range res.Data {
+			if len(row) < 8 {
+				return nil, fmt.Errorf("invalid candle row: %v", row)
+			}
+			ms, err := strconv.ParseInt(row[0], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid candle time %q: %w", row[0], err)
+			}
+			openTime := time.UnixMilli(ms)
+			candles = append(candles, core.Candle{
+				Symbol:      instID,
+				Interval:    interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(interval.Duration() - time.Millisecond),
+				Open:        ToDecimal(row[1]),
+				High:        ToDecimal(row[2]),
+				Low:         ToDecimal(row[3]),
+				Close:       ToDecimal(row[4]),
+				Volume:      ToDecimal(row[volIdx]),
+				QuoteVolume: ToDecimal(row[7]),
+			})
+		}
+		return candles, nil
+	})
+}
+
+// SubscribeCandles implements core.PublicClient
+func (c *OKXClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	return SubscribeCandleStream(ctx, symbols, interval, false, errHandler)
+}
+
+// SubscribeCandleStream streams bars from the business candle channel, each push carries
+// the confirm flag which is set once the bar is final
+func SubscribeCandleStream(ctx context.Context, symbols []string, interval core.CandleInterval, contracts bool, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	result := make(map[string]<-chan core.Candle)
+	bar, ok := candleBars[interval]
+	if !ok {
+		return result, core.ErrUnsupportedInterval("okx", interval)
+	}
+	volIdx := 5
+	if contracts {
+		volIdx = 6
+	}
+	chans := make(map[string]chan core.Candle)
+	args := make([]map[string]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Candle, 16)
+		result[symbol] = chans[symbol]
+		args[i] = map[string]string{
+			"channel": "candle" + bar,
+			"instId":  symbol,
+		}
+	}
+
+	stream := NewBusinessStream(args, func(msg []byte) {
+		var push struct {
+			Arg  OKXWSArg   `json:"arg"`
+			Data [][]string `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &push); err != nil {
+			return
+		}
+		ch, exists := chans[push.Arg.InstID]
+		if !exists {
+			return
+		}
+		for _, row := range push.Data {
+			if len(row) < 9 {
+				continue
+			}
+			openTime := ToTime(row[0])
+			core.SendCandle(ctx, ch, core.Candle{
+				Symbol:      push.Arg.InstID,
+				Interval:    interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(interval.Duration() - time.Millisecond),
+				Open:        ToDecimal(row[1]),
+				High:        ToDecimal(row[2]),
+				Low:         ToDecimal(row[3]),
+				Close:       ToDecimal(row[4]),
+				Volume:      ToDecimal(row[volIdx]),
+				QuoteVolume: ToDecimal(row[7]),
+				Closed:      row[8] == "1",
+			})
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return result, fmt.Errorf("failed to subscribe to candles: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+	return result, nil
+}
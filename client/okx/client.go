@@ -1,10 +1,12 @@
 package okx
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,9 +15,10 @@ import (
 )
 
 const (
-	okxWSURLPublic  = "wss://ws.okx.com:8443/ws/v5/public"
-	okxWSURLPrivate = "wss://ws.okx.com:8443/ws/v5/private"
-	wsLifetime      = 23*time.Hour + 50*time.Minute
+	okxWSURLPublic   = "wss://ws.okx.com:8443/ws/v5/public"
+	okxWSURLPrivate  = "wss://ws.okx.com:8443/ws/v5/private"
+	okxWSURLBusiness = "wss://ws.okx.com:8443/ws/v5/business" // candle channels
+	wsLifetime       = 23*time.Hour + 50*time.Minute
 )
 
 type OKXClient struct {
@@ -33,6 +36,16 @@ type OKXClient struct {
 	balancesMu   sync.RWMutex
 	ordersMu     sync.RWMutex
 	quoteChansMu sync.RWMutex
+
+	rules *core.RuleCache // rules orders are normalised to
+
+	// Private event subscriptions fed from the orders and account channels
+	fills              *FillDeduper
+	orderEventCh       chan core.OrderEvent
+	balanceEventCh     chan core.BalanceEvent
+	subscriptionCtx    context.Context
+	subscriptionCancel context.CancelFunc
+	subscriptionMu     sync.Mutex
 }
 
 func NewClient(apiKey, secretKey, passphrase string) *OKXClient {
@@ -43,6 +56,7 @@ func NewClient(apiKey, secretKey, passphrase string) *OKXClient {
 		balances:   make(map[string]*core.Wallet),
 		orders:     make(map[string]*core.OrderResponse),
 		quoteChans: make(map[string]chan core.Quote),
+		fills:      NewFillDeduper(),
 	}
 	
 	// Initialize persistent WebSocket for private operations
@@ -58,6 +72,8 @@ func NewClient(apiKey, secretKey, passphrase string) *OKXClient {
 		extractErrFn(),
 		client.afterConnect(),
 	)
+	client.SetRateLimiter(core.NewRateLimiter("okx", client.getDefaultRateLimits()), RateCostFn(), nil)
+	client.initRules()
 	
 	return client
 }
@@ -103,7 +119,7 @@ func (c *OKXClient) authFn() core.WsAuthFunc {
 		}
 		
 		if resp.Code != "0" {
-			return 0, fmt.Errorf("login failed: %s - %s", resp.Code, resp.Msg)
+			return 0, fmt.Errorf("login failed: %w", ParseOKXError(resp.Code, resp.Msg))
 		}
 		
 		// For OKX, we don't get server time in login response, so return 0
@@ -163,6 +179,13 @@ func (c *OKXClient) handleAccountUpdate(data interface{}) {
 				Locked: frozenBal,
 				Total:  bal,
 			}
+			c.forwardBalanceEvent(core.BalanceEvent{
+				Asset:      detail.Ccy,
+				Free:       availBal,
+				Locked:     frozenBal,
+				Total:      bal,
+				UpdateTime: ToTime(account.UTime),
+			})
 		}
 	}
 }
@@ -182,18 +205,20 @@ func (c *OKXClient) handleOrderUpdate(data interface{}) {
 	defer c.ordersMu.Unlock()
 	
 	for _, order := range orders {
-		status := c.mapOrderStatus(order.State)
+		status, reason := order.OrderStatus()
 		
 		c.orders[order.OrdID] = &core.OrderResponse{
 			OrderID:         order.OrdID,
 			Symbol:          order.InstID,
-			Side:            order.Side,
+			Side:            core.OrderSide(strings.ToUpper(order.Side)),
 			Status:          status,
+			Reason:          reason,
 			Price:           ToDecimal(order.Px),
 			Quantity:        ToDecimal(order.Sz),
 			IsQuoteQuantity: false,
 			CreateTime:      ToTime(order.CTime),
 		}
+		c.forwardOrderEvent(c.fills.Event(order))
 	}
 }
 
@@ -231,19 +256,6 @@ func (c *OKXClient) handleTickerUpdate(data interface{}) {
 	}
 }
 
-func (c *OKXClient) mapOrderStatus(state string) core.OrderStatus {
-	switch state {
-	case "live", "partially_filled":
-		return core.OrderStatusOpen
-	case "filled":
-		return core.OrderStatusFilled
-	case "canceled":
-		return core.OrderStatusCanceled
-	default:
-		return core.OrderStatusError
-	}
-}
-
 func (c *OKXClient) afterConnect() core.WsAfterConnectFunc {
 	return func(wsClient *core.WsClient) error {
 		// Subscribe to account updates
@@ -357,3 +369,87 @@ func extractErrFn() core.WsExtractErrFunc {
 	}
 }
 
+// SubscribeOrderEvents implements core.PrivateClient with the orders channel of the private
+// connection. Reconnects are reported to errHandler as core.StreamGapError.
+func (c *OKXClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
+	c.subscriptionMu.Lock()
+	defer c.subscriptionMu.Unlock()
+
+	if c.orderEventCh == nil {
+		c.orderEventCh = make(chan core.OrderEvent, 100)
+		c.subscriptionCtx, c.subscriptionCancel = context.WithCancel(ctx)
+		c.WatchGaps(c.subscriptionCtx, errHandler)
+	}
+
+	return c.orderEventCh, nil
+}
+
+// SubscribeBalanceEvents implements core.PrivateClient with the account channel
+func (c *OKXClient) SubscribeBalanceEvents(ctx context.Context, assets []string, errHandler func(err error)) (<-chan core.BalanceEvent, error) {
+	c.subscriptionMu.Lock()
+	defer c.subscriptionMu.Unlock()
+
+	if c.balanceEventCh == nil {
+		c.balanceEventCh = make(chan core.BalanceEvent, 100)
+		c.subscriptionCtx, c.subscriptionCancel = context.WithCancel(ctx)
+	}
+
+	return c.balanceEventCh, nil
+}
+
+// UnsubscribeOrderEvents implements core.PrivateClient interface
+func (c *OKXClient) UnsubscribeOrderEvents() error {
+	c.subscriptionMu.Lock()
+	defer c.subscriptionMu.Unlock()
+
+	if c.subscriptionCancel != nil {
+		c.subscriptionCancel()
+	}
+	if c.orderEventCh != nil {
+		close(c.orderEventCh)
+		c.orderEventCh = nil
+	}
+	return nil
+}
+
+// UnsubscribeBalanceEvents implements core.PrivateClient interface
+func (c *OKXClient) UnsubscribeBalanceEvents() error {
+	c.subscriptionMu.Lock()
+	defer c.subscriptionMu.Unlock()
+
+	if c.subscriptionCancel != nil {
+		c.subscriptionCancel()
+	}
+	if c.balanceEventCh != nil {
+		close(c.balanceEventCh)
+		c.balanceEventCh = nil
+	}
+	return nil
+}
+
+// forwardOrderEvent sends an order event to the subscription, dropped when it is full. The
+// lock is held so that an unsubscribe cannot close the channel under the send.
+func (c *OKXClient) forwardOrderEvent(event core.OrderEvent) {
+	c.subscriptionMu.Lock()
+	defer c.subscriptionMu.Unlock()
+
+	if c.orderEventCh != nil && c.subscriptionCtx.Err() == nil {
+		select {
+		case c.orderEventCh <- event:
+		default:
+		}
+	}
+}
+
+// forwardBalanceEvent sends a balance event to the subscription, dropped when it is full
+func (c *OKXClient) forwardBalanceEvent(event core.BalanceEvent) {
+	c.subscriptionMu.Lock()
+	defer c.subscriptionMu.Unlock()
+
+	if c.balanceEventCh != nil && c.subscriptionCtx.Err() == nil {
+		select {
+		case c.balanceEventCh <- event:
+		default:
+		}
+	}
+}
@@ -0,0 +1,23 @@
+package okx
+
+import (
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// PlaceConditional implements core.ConditionalOrderClient with OKX algo orders
+func (c *OKXClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	args, err := AlgoOrderArgs(req, "cash")
+	if err != nil {
+		return nil, err
+	}
+	algoId, err := PlaceAlgoOrder(c.apiKey, c.secretKey, c.passphrase, args)
+	if err != nil {
+		return nil, err
+	}
+	return AlgoOrderResponse(req, algoId), nil
+}
+
+// CancelConditional implements core.ConditionalOrderClient, orderId is the algoId
+func (c *OKXClient) CancelConditional(symbol, orderId string) error {
+	return CancelAlgoOrder(c.apiKey, c.secretKey, c.passphrase, symbol, orderId)
+}
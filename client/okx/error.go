@@ -1,19 +1,9 @@
 package okx
 
 import (
-	"fmt"
+	"github.com/ljm2ya/quickex-go/core"
 )
 
-// OKXError represents an error from OKX API
-type OKXError struct {
-	Code    string `json:"code"`
-	Message string `json:"msg"`
-}
-
-func (e OKXError) Error() string {
-	return fmt.Sprintf("OKX API Error %s: %s", e.Code, e.Message)
-}
-
 // Common OKX error codes and their meanings
 var OKXErrorCodes = map[string]string{
 	"0":     "Success",
@@ -55,7 +45,7 @@ var OKXErrorCodes = map[string]string{
 	"51005": "Order amount should be less than the max available amount",
 	"51006": "Order price is out of the available range",
 	"51007": "Order placement failed. Order amount should be at least 1 contract (showing up when placing an order with less than 1 contract)",
-	"51008": "Order placement failed. Order amount should be greater than {param0} (showing up when placing an order with less than the minimum amount)",
+	"51008": "Order failed. Insufficient {param0} balance in account",
 	"51009": "Order placement failed. Order amount should be less than {param0} (showing up when placing an order with more than the maximum amount)",
 	"51010": "Order placement failed. The price should be better than {param0}",
 	"51011": "Order placement failed. The price should be {param0}",
@@ -78,6 +68,7 @@ var OKXErrorCodes = map[string]string{
 	"51028": "Parameter {param0} should be greater than {param1}",
 	"51029": "Parameter {param0} should be less than {param1}",
 	"51030": "Parameter {param0} is invalid",
+	"51603": "Order does not exist",
 	"58000": "Account configuration retrieving failed",
 	"58001": "Account {param0} does not exist",
 	"58002": "Account {param0} is suspended",
@@ -179,21 +170,62 @@ var OKXErrorCodes = map[string]string{
 	"60039": "Order placement failed. The order price does not meet the accuracy requirements. The decimal part can be at most {param0} digits",
 }
 
-// ParseOKXError creates an OKXError from response data
-func ParseOKXError(code, message string) *OKXError {
+// okxErrorCategories maps OKX error codes into core error categories
+var okxErrorCategories = map[string]core.ErrorCategory{
+	"50001": core.ErrCategoryMaintenance,
+	"50013": core.ErrCategoryRateLimited,
+	"50004": core.ErrCategoryExecutionUnknown,
+	"50005": core.ErrCategoryAuthFailed,
+	"50006": core.ErrCategoryAuthFailed,
+	"50007": core.ErrCategoryAuthFailed,
+	"50008": core.ErrCategoryAuthFailed,
+	"50009": core.ErrCategoryAuthFailed,
+	"50010": core.ErrCategoryAuthFailed,
+	"51001": core.ErrCategoryInvalidSymbol,
+	"60013": core.ErrCategoryInvalidSymbol,
+	"51004": core.ErrCategoryMinNotional,
+	"60016": core.ErrCategoryMinNotional,
+	"60021": core.ErrCategoryMinNotional,
+	"60037": core.ErrCategoryMinNotional,
+	"51006": core.ErrCategoryPriceFilter,
+	"51022": core.ErrCategoryPriceFilter,
+	"51023": core.ErrCategoryPriceFilter,
+	"59108": core.ErrCategoryPriceFilter,
+	"60017": core.ErrCategoryPriceFilter,
+	"60031": core.ErrCategoryPriceFilter,
+	"60034": core.ErrCategoryPriceFilter,
+	"60035": core.ErrCategoryPriceFilter,
+	"60039": core.ErrCategoryPriceFilter,
+	"51015": core.ErrCategoryOrderNotFound,
+	"51603": core.ErrCategoryOrderNotFound,
+	"60001": core.ErrCategoryOrderNotFound,
+	"60008": core.ErrCategoryOrderNotFound,
+	"60010": core.ErrCategoryOrderNotFound,
+	"51008": core.ErrCategoryInsufficientBalance,
+	"58101": core.ErrCategoryInsufficientBalance,
+	"58102": core.ErrCategoryInsufficientBalance,
+	"58103": core.ErrCategoryInsufficientBalance,
+	"58104": core.ErrCategoryInsufficientBalance,
+	"58105": core.ErrCategoryInsufficientBalance,
+	"59200": core.ErrCategoryInsufficientBalance,
+}
+
+// ParseOKXError creates a core.ExchangeError from response data, nil on success
+func ParseOKXError(code, message string) error {
 	if code == "0" {
 		return nil // Success
 	}
 	
 	// Use predefined message if available, otherwise use provided message
-	if knownMsg, exists := OKXErrorCodes[code]; exists && knownMsg != "Success" {
+	if knownMsg, exists := OKXErrorCodes[code]; exists && knownMsg != "Success" && message == "" {
 		message = knownMsg
 	}
 	
-	return &OKXError{
-		Code:    code,
-		Message: message,
+	category, ok := okxErrorCategories[code]
+	if !ok {
+		category = core.ErrCategoryUnknown
 	}
+	return core.NewExchangeError("okx", category, code, message)
 }
 
 // IsRateLimitError checks if the error is related to rate limiting
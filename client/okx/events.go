@@ -0,0 +1,76 @@
+package okx
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// OrderEvent converts a push of the orders channel. The last fill fields are set when the push
+// carries a fill, fees are flipped to positive when charged.
+func (o OKXOrder) OrderEvent() core.OrderEvent {
+	status, reason := o.OrderStatus()
+	event := core.OrderEvent{
+		OrderID:         o.OrdID,
+		ClientOrderID:   o.ClOrdID,
+		Symbol:          o.InstID,
+		Side:            core.OrderSide(strings.ToUpper(o.Side)),
+		OrderType:       strings.ToUpper(o.OrdType),
+		Status:          status,
+		Reason:          reason,
+		Price:           ToDecimal(o.Px),
+		Quantity:        ToDecimal(o.Sz),
+		ExecutedQty:     ToDecimal(o.AccFillSz),
+		AvgPrice:        ToDecimal(o.AvgPx),
+		Commission:      ToDecimal(o.Fee).Neg(),
+		CommissionAsset: o.FeeCcy,
+		UpdateTime:      ToTime(o.UTime),
+	}
+	if fillSz := ToDecimal(o.FillSz); fillSz.IsPositive() && o.TradeID != "" {
+		event.TradeID = o.TradeID
+		event.LastQty = fillSz
+		event.LastPrice = ToDecimal(o.FillPx)
+		event.LastCommission = ToDecimal(o.FillFee).Neg()
+		event.IsMaker = o.ExecType == "M"
+		if o.FillFeeCcy != "" {
+			event.CommissionAsset = o.FillFeeCcy
+		}
+		if o.FillTime != "" {
+			event.UpdateTime = ToTime(o.FillTime)
+		}
+	}
+	return event
+}
+
+// FillDeduper drops the last fill fields that a push repeats from an earlier one, OKX keeps
+// them on every push of an order after its first fill
+type FillDeduper struct {
+	mu        sync.Mutex
+	lastTrade map[string]string // order id : trade id of the last fill reported
+}
+
+func NewFillDeduper() *FillDeduper {
+	return &FillDeduper{lastTrade: make(map[string]string)}
+}
+
+// Event converts o and clears the fill of a repeated trade
+func (d *FillDeduper) Event(o OKXOrder) core.OrderEvent {
+	event := o.OrderEvent()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if event.IsFill() {
+		if d.lastTrade[o.OrdID] == event.TradeID {
+			event.TradeID = ""
+			event.LastQty, event.LastPrice, event.LastCommission = decimal.Zero, decimal.Zero, decimal.Zero
+			event.IsMaker = false
+		} else {
+			d.lastTrade[o.OrdID] = event.TradeID
+		}
+	}
+	if !event.Status.IsOpen() {
+		delete(d.lastTrade, o.OrdID)
+	}
+	return event
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/ljm2ya/quickex-go/client/okx"
 	"github.com/ljm2ya/quickex-go/core"
@@ -52,7 +54,7 @@ func (c *OKXFuturesClient) getFuturesPositionSize(asset string) (decimal.Decimal
 	// Sum up all position sizes for the given asset
 	for instID, position := range c.positions {
 		if extractBaseCurrency(instID) == asset {
-			posAmount := decimal.NewFromFloat(position.Amount)
+			posAmount := position.Amount
 			if posAmount.IsPositive() {
 				totalPosition = totalPosition.Add(posAmount.Abs())
 			}
@@ -149,15 +151,29 @@ func (c *OKXFuturesClient) FetchOrder(symbol, orderId string) (*core.OrderRespon
 		return nil, fmt.Errorf("order not found: %s", orderId)
 	}
 	
-	order := response.Data[0]
-	status := c.mapOrderStatus(order.State)
-	
+	return c.orderFull(response.Data[0]), nil
+}
+
+// FetchOrderByClientID implements core.PrivateClient. It queries REST, the ws api has no
+// order query op.
+func (c *OKXFuturesClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	order, err := okx.FetchOrderByClOrdID(c.apiKey, c.secretKey, c.passphrase, symbol, clientOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order: %w", err)
+	}
+	return c.orderFull(*order), nil
+}
+
+func (c *OKXFuturesClient) orderFull(order okx.OKXOrder) *core.OrderResponseFull {
+	status, reason := order.OrderStatus()
 	return &core.OrderResponseFull{
 		OrderResponse: core.OrderResponse{
 			OrderID:         order.OrdID,
+			ClientOrderID:   order.ClOrdID,
 			Symbol:          order.InstID,
-			Side:            order.Side,
+			Side:            core.OrderSide(strings.ToUpper(order.Side)),
 			Status:          status,
+			Reason:          reason,
 			Price:           okx.ToDecimal(order.Px),
 			Quantity:        okx.ToDecimal(order.Sz),
 			IsQuoteQuantity: false,
@@ -165,8 +181,59 @@ func (c *OKXFuturesClient) FetchOrder(symbol, orderId string) (*core.OrderRespon
 		},
 		AvgPrice:        okx.ToDecimal(order.AvgPx),
 		ExecutedQty:     okx.ToDecimal(order.AccFillSz),
-		Commission:      okx.ToDecimal(order.Fee),
+		Commission:      okx.ToDecimal(order.Fee).Neg(), // negative when charged
 		CommissionAsset: order.FeeCcy,
 		UpdateTime:      okx.ToTime(order.UTime),
-	}, nil
-}
\ No newline at end of file
+	}
+}
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all symbols
+func (c *OKXFuturesClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	orders, err := okx.FetchPendingOrders(c.apiKey, c.secretKey, c.passphrase, "SWAP", symbol)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch open orders: %w", err)
+	}
+	return c.ordersFull(orders), nil
+}
+
+// FetchOrderHistory implements core.PrivateClient from the history archive of three months
+func (c *OKXFuturesClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, okx.HistoryWindow, 0, func(start, end time.Time, _ int) ([]core.OrderResponseFull, error) {
+		orders, err := okx.FetchOrderHistory(c.apiKey, c.secretKey, c.passphrase, "SWAP", symbol, start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch order history: %w", err)
+		}
+		return c.ordersFull(orders), nil
+	})
+}
+
+// FetchFills implements core.PrivateClient from the fills history of three months, sizes are in contracts
+func (c *OKXFuturesClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	return core.PageFills(since, until, okx.HistoryWindow, 0, func(start, end time.Time, _ int) ([]core.OrderFill, error) {
+		fills, err := okx.FetchFills(c.apiKey, c.secretKey, c.passphrase, "SWAP", symbol, "", start, end)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch fills: %w", err)
+		}
+		return fills, nil
+	})
+}
+
+// FetchOrderFills implements core.PrivateClient
+func (c *OKXFuturesClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	fills, err := okx.FetchFills(c.apiKey, c.secretKey, c.passphrase, "SWAP", symbol, orderId, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch order fills: %w", err)
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// ordersFull converts a listing oldest first
+func (c *OKXFuturesClient) ordersFull(orders []okx.OKXOrder) []core.OrderResponseFull {
+	out := make([]core.OrderResponseFull, len(orders))
+	for i, order := range orders {
+		out[i] = *c.orderFull(order)
+	}
+	core.SortOrders(out)
+	return out
+}
@@ -6,12 +6,14 @@ import (
 	"fmt"
 	"math/rand"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/ljm2ya/quickex-go/client/okx"
 	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
 )
 
 const (
@@ -34,6 +36,16 @@ type OKXFuturesClient struct {
 	positionsMu  sync.RWMutex
 	ordersMu     sync.RWMutex
 	quoteChansMu sync.RWMutex
+
+	rules *core.RuleCache // rules orders are normalised to
+
+	// Private event subscriptions fed from the orders and account channels
+	fills              *okx.FillDeduper
+	orderEventCh       chan core.OrderEvent
+	balanceEventCh     chan core.BalanceEvent
+	subscriptionCtx    context.Context
+	subscriptionCancel context.CancelFunc
+	subscriptionMu     sync.Mutex
 }
 
 func NewClient(apiKey, secretKey, passphrase string) *OKXFuturesClient {
@@ -45,6 +57,7 @@ func NewClient(apiKey, secretKey, passphrase string) *OKXFuturesClient {
 		positions:  make(map[string]*core.Position),
 		orders:     make(map[string]*core.OrderResponse),
 		quoteChans: make(map[string]chan core.Quote),
+		fills:      okx.NewFillDeduper(),
 	}
 	
 	client.WsClient = core.NewWsClient(
@@ -57,6 +70,8 @@ func NewClient(apiKey, secretKey, passphrase string) *OKXFuturesClient {
 		extractErrFn(),
 		client.afterConnect(),
 	)
+	client.SetRateLimiter(core.NewRateLimiter("okx", client.getDefaultRateLimits()), okx.RateCostFn(), nil)
+	client.initRules()
 	
 	return client
 }
@@ -102,7 +117,7 @@ func (c *OKXFuturesClient) authFn() core.WsAuthFunc {
 		}
 		
 		if resp.Code != "0" {
-			return 0, fmt.Errorf("login failed: %s - %s", resp.Code, resp.Msg)
+			return 0, fmt.Errorf("login failed: %w", okx.ParseOKXError(resp.Code, resp.Msg))
 		}
 		
 		return 0, nil
@@ -163,6 +178,13 @@ func (c *OKXFuturesClient) handleAccountUpdate(data interface{}) {
 				Locked: frozenBal,
 				Total:  bal,
 			}
+			c.forwardBalanceEvent(core.BalanceEvent{
+				Asset:      detail.Ccy,
+				Free:       availBal,
+				Locked:     frozenBal,
+				Total:      bal,
+				UpdateTime: okx.ToTime(account.UTime),
+			})
 		}
 	}
 }
@@ -187,13 +209,13 @@ func (c *OKXFuturesClient) handlePositionUpdate(data interface{}) {
 		c.positions[pos.InstID] = &core.Position{
 			Symbol:         pos.InstID,
 			Side:           positionSide,
-			Amount:         okx.ToFloat64(pos.Pos),
-			UrlProfit:      okx.ToFloat64(pos.UPL),
-			IsolatedMargin: 0, // OKX doesn't directly provide this
-			Notional:       okx.ToFloat64(pos.NotionalUsd),
-			IsolatedWallet: 0, // OKX doesn't directly provide this
-			InitialMargin:  0, // Would need to calculate based on leverage
-			MaintMargin:    0, // Would need to calculate
+			Amount:         okx.ToDecimal(pos.Pos),
+			UrlProfit:      okx.ToDecimal(pos.UPL),
+			IsolatedMargin: decimal.Zero, // OKX doesn't directly provide this
+			Notional:       okx.ToDecimal(pos.NotionalUsd),
+			IsolatedWallet: decimal.Zero, // OKX doesn't directly provide this
+			InitialMargin:  decimal.Zero, // Would need to calculate based on leverage
+			MaintMargin:    decimal.Zero, // Would need to calculate
 			UpdatedTime:    okx.ToTime(pos.UTime),
 		}
 	}
@@ -214,18 +236,20 @@ func (c *OKXFuturesClient) handleOrderUpdate(data interface{}) {
 	defer c.ordersMu.Unlock()
 	
 	for _, order := range orders {
-		status := c.mapOrderStatus(order.State)
+		status, reason := order.OrderStatus()
 		
 		c.orders[order.OrdID] = &core.OrderResponse{
 			OrderID:         order.OrdID,
 			Symbol:          order.InstID,
-			Side:            order.Side,
+			Side:            core.OrderSide(strings.ToUpper(order.Side)),
 			Status:          status,
+			Reason:          reason,
 			Price:           okx.ToDecimal(order.Px),
 			Quantity:        okx.ToDecimal(order.Sz),
 			IsQuoteQuantity: false,
 			CreateTime:      okx.ToTime(order.CTime),
 		}
+		c.forwardOrderEvent(c.fills.Event(order))
 	}
 }
 
@@ -276,19 +300,6 @@ func (c *OKXFuturesClient) mapPositionSide(posSide string) core.PositionSide {
 	}
 }
 
-func (c *OKXFuturesClient) mapOrderStatus(state string) core.OrderStatus {
-	switch state {
-	case "live", "partially_filled":
-		return core.OrderStatusOpen
-	case "filled":
-		return core.OrderStatusFilled
-	case "canceled":
-		return core.OrderStatusCanceled
-	default:
-		return core.OrderStatusError
-	}
-}
-
 func (c *OKXFuturesClient) afterConnect() core.WsAfterConnectFunc {
 	return func(wsClient *core.WsClient) error {
 		// Subscribe to account updates
@@ -379,7 +390,8 @@ func extractErrFn() core.WsExtractErrFunc {
 		// Check for 'code' field
 		if codeRaw, ok := root["code"]; ok {
 			var code string
-			if err := json.Unmarshal(codeRaw, &code); err == nil && code != "0" {
+			// 1 and 2 are all or some items failed, the caller checks the sCode of each
+			if err := json.Unmarshal(codeRaw, &code); err == nil && code != "0" && (code != "1" && code != "2" || !hasItems(root)) {
 				var msg string
 				if msgRaw, ok := root["msg"]; ok {
 					_ = json.Unmarshal(msgRaw, &msg)
@@ -407,24 +419,94 @@ func extractErrFn() core.WsExtractErrFunc {
 	}
 }
 
-// SubscribeOrderEvents implements core.PrivateClient interface
-// Note: OKX Futures real-time order events not implemented yet
+// SubscribeOrderEvents implements core.PrivateClient with the orders channel of the private
+// connection, sizes are in contracts. Reconnects are reported to errHandler as
+// core.StreamGapError.
 func (o *OKXFuturesClient) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan core.OrderEvent, error) {
-	return nil, fmt.Errorf("real-time order events not implemented for OKX Futures")
+	o.subscriptionMu.Lock()
+	defer o.subscriptionMu.Unlock()
+
+	if o.orderEventCh == nil {
+		o.orderEventCh = make(chan core.OrderEvent, 100)
+		o.subscriptionCtx, o.subscriptionCancel = context.WithCancel(ctx)
+		o.WatchGaps(o.subscriptionCtx, errHandler)
+	}
+
+	return o.orderEventCh, nil
 }
 
-// SubscribeBalanceEvents implements core.PrivateClient interface
-// Note: OKX Futures real-time balance events not implemented yet
+// SubscribeBalanceEvents implements core.PrivateClient with the account channel
 func (o *OKXFuturesClient) SubscribeBalanceEvents(ctx context.Context, assets []string, errHandler func(err error)) (<-chan core.BalanceEvent, error) {
-	return nil, fmt.Errorf("real-time balance events not implemented for OKX Futures")
+	o.subscriptionMu.Lock()
+	defer o.subscriptionMu.Unlock()
+
+	if o.balanceEventCh == nil {
+		o.balanceEventCh = make(chan core.BalanceEvent, 100)
+		o.subscriptionCtx, o.subscriptionCancel = context.WithCancel(ctx)
+	}
+
+	return o.balanceEventCh, nil
 }
 
 // UnsubscribeOrderEvents implements core.PrivateClient interface
 func (o *OKXFuturesClient) UnsubscribeOrderEvents() error {
-	return fmt.Errorf("real-time order events not implemented for OKX Futures")
+	o.subscriptionMu.Lock()
+	defer o.subscriptionMu.Unlock()
+
+	if o.subscriptionCancel != nil {
+		o.subscriptionCancel()
+	}
+	if o.orderEventCh != nil {
+		close(o.orderEventCh)
+		o.orderEventCh = nil
+	}
+	return nil
 }
 
 // UnsubscribeBalanceEvents implements core.PrivateClient interface
 func (o *OKXFuturesClient) UnsubscribeBalanceEvents() error {
-	return fmt.Errorf("real-time balance events not implemented for OKX Futures")
-}
\ No newline at end of file
+	o.subscriptionMu.Lock()
+	defer o.subscriptionMu.Unlock()
+
+	if o.subscriptionCancel != nil {
+		o.subscriptionCancel()
+	}
+	if o.balanceEventCh != nil {
+		close(o.balanceEventCh)
+		o.balanceEventCh = nil
+	}
+	return nil
+}
+
+// forwardOrderEvent sends an order event to the subscription, dropped when it is full. The
+// lock is held so that an unsubscribe cannot close the channel under the send.
+func (o *OKXFuturesClient) forwardOrderEvent(event core.OrderEvent) {
+	o.subscriptionMu.Lock()
+	defer o.subscriptionMu.Unlock()
+
+	if o.orderEventCh != nil && o.subscriptionCtx.Err() == nil {
+		select {
+		case o.orderEventCh <- event:
+		default:
+		}
+	}
+}
+
+// forwardBalanceEvent sends a balance event to the subscription, dropped when it is full
+func (o *OKXFuturesClient) forwardBalanceEvent(event core.BalanceEvent) {
+	o.subscriptionMu.Lock()
+	defer o.subscriptionMu.Unlock()
+
+	if o.balanceEventCh != nil && o.subscriptionCtx.Err() == nil {
+		select {
+		case o.balanceEventCh <- event:
+		default:
+		}
+	}
+}
+
+// hasItems reports whether a response carries per item results in data
+func hasItems(root map[string]json.RawMessage) bool {
+	var data []json.RawMessage
+	return json.Unmarshal(root["data"], &data) == nil && len(data) > 0
+}
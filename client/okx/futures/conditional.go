@@ -0,0 +1,24 @@
+package futures
+
+import (
+	"github.com/ljm2ya/quickex-go/client/okx"
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// PlaceConditional implements core.ConditionalOrderClient with OKX algo orders
+func (c *OKXFuturesClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	args, err := okx.AlgoOrderArgs(req, "cross")
+	if err != nil {
+		return nil, err
+	}
+	algoId, err := okx.PlaceAlgoOrder(c.apiKey, c.secretKey, c.passphrase, args)
+	if err != nil {
+		return nil, err
+	}
+	return okx.AlgoOrderResponse(req, algoId), nil
+}
+
+// CancelConditional implements core.ConditionalOrderClient, orderId is the algoId
+func (c *OKXFuturesClient) CancelConditional(symbol, orderId string) error {
+	return okx.CancelAlgoOrder(c.apiKey, c.secretKey, c.passphrase, symbol, orderId)
+}
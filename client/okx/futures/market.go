@@ -14,46 +14,71 @@ import (
 
 // SubscribeQuotes implements core.PublicClient
 func (c *OKXFuturesClient) SubscribeQuotes(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]chan core.Quote, error) {
-	result := make(map[string]chan core.Quote)
-	
-	c.quoteChansMu.Lock()
-	defer c.quoteChansMu.Unlock()
-	
-	// Create channels for each symbol
-	for _, symbol := range symbols {
-		quoteChan := make(chan core.Quote, 100) // Buffer to prevent blocking
-		result[symbol] = quoteChan
-		c.quoteChans[symbol] = quoteChan
+	return okx.SubscribeQuoteStream(ctx, symbols, errHandler)
+}
+
+// SubscribeOrderbook implements core.PublicClient
+func (c *OKXFuturesClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	return okx.SubscribeOrderbookStream(ctx, symbols, depth, errHandler)
+}
+
+// SubscribeTrades implements core.PublicClient, sizes are in contracts like the orderbook
+func (c *OKXFuturesClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	return okx.SubscribeTradeStream(ctx, symbols, errHandler)
+}
+
+// FetchCandles implements core.PublicClient
+func (c *OKXFuturesClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	return okx.FetchInstrumentCandles(symbol, interval, start, end, limit, true)
+}
+
+// SubscribeCandles implements core.PublicClient
+func (c *OKXFuturesClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	return okx.SubscribeCandleStream(ctx, symbols, interval, true, errHandler)
+}
+
+// FetchQuotes implements core.PublicClient from the tickers, sizes are in contracts like
+// the orderbook
+func (c *OKXFuturesClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
+	tickers, err := c.FetchTickers(symbols)
+	if err != nil {
+		return nil, err
 	}
-	
-	// Subscribe to tickers for all symbols
-	args := make([]map[string]string, len(symbols))
-	for i, symbol := range symbols {
-		args[i] = map[string]string{
-			"channel": "tickers",
-			"instId":  symbol,
-		}
+	quotes := make(map[string]core.Quote, len(tickers))
+	for symbol, t := range tickers {
+		quotes[symbol] = t.Quote()
 	}
-	
-	req := map[string]interface{}{
-		"op":   "subscribe",
-		"args": args,
+	return quotes, core.MissingSymbols("okx", symbols, quotes)
+}
+
+// FetchTickers implements core.PublicClient, one request per instrument type of symbols
+func (c *OKXFuturesClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	instTypes := map[string]bool{"SWAP": true}
+	if len(symbols) > 0 {
+		instTypes = make(map[string]bool)
+		for _, symbol := range symbols {
+			instTypes[c.determineInstType(symbol)] = true
+		}
 	}
-	
-	// Use WebSocket connection for market data
-	_, err := c.WsClient.SendRequest(req)
-	if err != nil {
-		// Clean up channels on error
-		for _, ch := range result {
-			close(ch)
+	out := make(map[string]core.Ticker)
+	for instType := range instTypes {
+		tickers, err := okx.FetchInstrumentTickers(instType, symbols)
+		if err != nil {
+			return nil, err
 		}
-		for _, symbol := range symbols {
-			delete(c.quoteChans, symbol)
+		for symbol, t := range tickers {
+			out[symbol] = t
 		}
-		return nil, fmt.Errorf("failed to subscribe to quotes: %w", err)
 	}
-	
-	return result, nil
+	return out, nil
+}
+
+// initRules sets up the rule cache that orders are rounded and validated against,
+// FetchMarketRules takes instrument ids
+func (c *OKXFuturesClient) initRules() {
+	c.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		return c.FetchMarketRules([]string{symbol})
+	}, core.DefaultRuleTTL)
 }
 
 // FetchMarketRules implements core.PublicClient
@@ -148,6 +173,7 @@ func (c *OKXFuturesClient) convertToMarketRule(instrument okx.OKXInstrument) cor
 		MaxQty:         maxLmtSize,
 		TickSize:       tickSize,
 		StepSize:       lotSize,
+		MarketMaxQty:   okx.ToDecimal(instrument.MaxMktSz), // contracts like MaxQty
 		RateLimits:     c.getDefaultRateLimits(),
 	}
 }
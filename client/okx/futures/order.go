@@ -1,8 +1,10 @@
 package futures
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ljm2ya/quickex-go/client/okx"
@@ -32,6 +34,96 @@ func (c *OKXFuturesClient) MarketSell(symbol string, quantity decimal.Decimal) (
 	return c.placeFuturesMarketOrder(symbol, "sell", quantity)
 }
 
+// PlaceOrder implements core.PrivateClient, the client order id is sent as clOrdId.
+// Futures orders are sized in base, a quote quantity is not supported.
+func (c *OKXFuturesClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := c.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	if !req.QuoteQuantity.IsZero() {
+		return nil, core.ErrUnsupportedOrder("okx futures", "quote quantity")
+	}
+	args := okx.OrderArgs(req, "cross")
+	args["posSide"] = "net"
+	root, err := c.WsClient.SendRequestCtx(ctx, map[string]interface{}{
+		"id":   nextWSID(),
+		"op":   "order",
+		"args": []map[string]interface{}{args},
+	})
+	if err != nil {
+		return core.ResolvePlacement(c, req, fmt.Errorf("failed to place futures order: %w", err))
+	}
+	resp, err := c.parseOrderResponse(root, req.Symbol, string(req.Side), req.Quantity, req.Price)
+	if err != nil {
+		return nil, err
+	}
+	resp.Tif = req.TimeInForce
+	return resp, nil
+}
+
+// PlaceOrders implements core.PrivateClient with batch-orders
+func (c *OKXFuturesClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return c.rules.PlaceBatches(ctx, reqs, okx.BatchSize, func(ctx context.Context, reqs []core.OrderRequest) []core.OrderResult {
+		results := make([]core.OrderResult, len(reqs))
+		var sent []core.OrderRequest
+		var index []int
+		var args []map[string]interface{}
+		for i, req := range reqs {
+			if !req.QuoteQuantity.IsZero() {
+				results[i].Err = core.ErrUnsupportedOrder("okx futures", "quote quantity")
+				continue
+			}
+			arg := okx.OrderArgs(req, "cross")
+			arg["posSide"] = "net"
+			args = append(args, arg)
+			sent = append(sent, req)
+			index = append(index, i)
+		}
+		if len(args) == 0 {
+			return results
+		}
+		batchResults, err := c.sendBatch(ctx, "batch-orders", args)
+		if err != nil {
+			batchResults = core.ResolveBatch(c, sent, fmt.Errorf("failed to place futures orders: %w", err))
+		}
+		for j, res := range batchResults {
+			if res.Order != nil && err == nil {
+				okx.FillOrderResponse(res.Order, sent[j])
+			}
+			results[index[j]] = res
+		}
+		return results
+	})
+}
+
+// CancelOrders implements core.PrivateClient with batch-cancel-orders
+func (c *OKXFuturesClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelBatches(ctx, orderIds, okx.BatchSize, func(ctx context.Context, orderIds []string) []core.OrderResult {
+		results, err := c.sendBatch(ctx, "batch-cancel-orders", okx.CancelArgs(symbol, orderIds))
+		if err != nil {
+			return core.FailBatch(len(orderIds), fmt.Errorf("failed to cancel futures orders: %w", err))
+		}
+		okx.CancelledOrders(results, symbol)
+		return results
+	})
+}
+
+// sendBatch sends a batch op and returns the result of every item of args
+func (c *OKXFuturesClient) sendBatch(ctx context.Context, op string, args []map[string]interface{}) ([]core.OrderResult, error) {
+	root, err := c.WsClient.SendRequestCtx(ctx, map[string]interface{}{
+		"id":   nextWSID(),
+		"op":   op,
+		"args": args,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var code, msg string
+	_ = json.Unmarshal(root["code"], &code)
+	_ = json.Unmarshal(root["msg"], &msg)
+	return okx.BatchResults(code, msg, root["data"], len(args))
+}
+
 // placeFuturesOrder places a futures limit order
 func (c *OKXFuturesClient) placeFuturesOrder(symbol, side, ordType string, quantity, price decimal.Decimal, tif string) (*core.OrderResponse, error) {
 	// Map time in force
@@ -108,9 +200,10 @@ func (c *OKXFuturesClient) placeFuturesMarketOrder(symbol, side string, quantity
 func (c *OKXFuturesClient) parseOrderResponse(root map[string]json.RawMessage, symbol, side string, quantity, price decimal.Decimal) (*core.OrderResponse, error) {
 	var response struct {
 		Data []struct {
-			OrdID string `json:"ordId"`
-			SCode string `json:"sCode"`
-			SMsg  string `json:"sMsg"`
+			OrdID   string `json:"ordId"`
+			ClOrdID string `json:"clOrdId"`
+			SCode   string `json:"sCode"`
+			SMsg    string `json:"sMsg"`
 		} `json:"data"`
 	}
 	
@@ -137,8 +230,9 @@ func (c *OKXFuturesClient) parseOrderResponse(root map[string]json.RawMessage, s
 	
 	return &core.OrderResponse{
 		OrderID:         orderData.OrdID,
+		ClientOrderID:   orderData.ClOrdID,
 		Symbol:          symbol,
-		Side:            side,
+		Side:            core.OrderSide(strings.ToUpper(side)),
 		Status:          core.OrderStatusOpen, // New orders start as open
 		Price:           price,
 		Quantity:        quantity,
@@ -167,9 +261,10 @@ func (c *OKXFuturesClient) CancelOrder(symbol, orderId string) (*core.OrderRespo
 	
 	var response struct {
 		Data []struct {
-			OrdID string `json:"ordId"`
-			SCode string `json:"sCode"`
-			SMsg  string `json:"sMsg"`
+			OrdID   string `json:"ordId"`
+			ClOrdID string `json:"clOrdId"`
+			SCode   string `json:"sCode"`
+			SMsg    string `json:"sMsg"`
 		} `json:"data"`
 	}
 	
@@ -251,4 +346,55 @@ func (c *OKXFuturesClient) mapTimeInForce(tif string) string {
 	default:
 		return "limit" // Default to limit/GTC
 	}
-}
\ No newline at end of file
+}
+// AmendOrder implements core.PrivateClient with amend-order, okx keeps queue priority
+// when only the quantity is reduced
+func (c *OKXFuturesClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	if err := core.ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	c.ordersMu.RLock()
+	cached, ok := c.orders[orderId]
+	c.ordersMu.RUnlock()
+	var prev core.OrderResponse
+	if ok {
+		prev = *cached
+	} else {
+		ord, err := c.FetchOrder(symbol, orderId)
+		if err != nil {
+			return nil, err
+		}
+		prev = ord.OrderResponse
+	}
+
+	req := map[string]interface{}{
+		"id": nextWSID(),
+		"op": "amend-order",
+		"args": []map[string]interface{}{
+			{
+				"instId": symbol,
+				"ordId":  orderId,
+				"newSz":  newQty.String(),
+				"newPx":  newPrice.String(),
+			},
+		},
+	}
+	root, err := c.WsClient.SendRequest(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to amend futures order: %w", err)
+	}
+	amended, err := c.parseOrderResponse(root, symbol, string(prev.Side), newQty, newPrice)
+	if err != nil {
+		return nil, err
+	}
+	amended.CreateTime = prev.CreateTime
+
+	c.ordersMu.Lock()
+	c.orders[orderId] = amended
+	c.ordersMu.Unlock()
+	return &core.AmendResult{
+		Order:           amended,
+		OriginalOrderID: orderId,
+		KeptPriority:    core.AmendKeepsPriority(prev.Quantity, prev.Price, newQty, newPrice),
+	}, nil
+}
@@ -0,0 +1,146 @@
+package okx
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// order listings are REST only, the history archive keeps three months
+const (
+	pendingOrdersPath = "/api/v5/trade/orders-pending"
+	orderHistoryPath  = "/api/v5/trade/orders-history-archive"
+	fillsHistoryPath  = "/api/v5/trade/fills-history"
+	orderListLimit    = 100
+)
+
+// HistoryWindow is the span of one order history request
+const HistoryWindow = 90 * 24 * time.Hour
+
+// FetchPendingOrders lists the open orders of instType, of all instruments when instId is
+// empty
+func FetchPendingOrders(apiKey, secretKey, passphrase, instType, instId string) ([]OKXOrder, error) {
+	params := url.Values{"instType": {instType}}
+	if instId != "" {
+		params.Set("instId", instId)
+	}
+	return listOrders(apiKey, secretKey, passphrase, pendingOrdersPath, params)
+}
+
+// FetchOrderHistory lists the closed orders of instType created in [begin, end], of all
+// instruments when instId is empty
+func FetchOrderHistory(apiKey, secretKey, passphrase, instType, instId string, begin, end time.Time) ([]OKXOrder, error) {
+	params := url.Values{
+		"instType": {instType},
+		"begin":    {strconv.FormatInt(begin.UnixMilli(), 10)},
+		"end":      {strconv.FormatInt(end.UnixMilli(), 10)},
+	}
+	if instId != "" {
+		params.Set("instId", instId)
+	}
+	return listOrders(apiKey, secretKey, passphrase, orderHistoryPath, params)
+}
+
+// listOrders follows the pages of an order listing, newest first, by the after cursor
+func listOrders(apiKey, secretKey, passphrase, path string, params url.Values) ([]OKXOrder, error) {
+	params.Set("limit", strconv.Itoa(orderListLimit))
+	var orders []OKXOrder
+	for {
+		page, err := getOrders(apiKey, secretKey, passphrase, path, params)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, page...)
+		if len(page) < orderListLimit {
+			return orders, nil
+		}
+		params.Set("after", page[len(page)-1].OrdID)
+	}
+}
+
+// FetchFills lists the fills of instType in [begin, end] of the last three months, of one
+// order when ordId is set and of all instruments when instId is empty. Zero times leave
+// the bound open.
+func FetchFills(apiKey, secretKey, passphrase, instType, instId, ordId string, begin, end time.Time) ([]core.OrderFill, error) {
+	params := url.Values{
+		"instType": {instType},
+		"limit":    {strconv.Itoa(orderListLimit)},
+	}
+	if instId != "" {
+		params.Set("instId", instId)
+	}
+	if ordId != "" {
+		params.Set("ordId", ordId)
+	}
+	if !begin.IsZero() {
+		params.Set("begin", strconv.FormatInt(begin.UnixMilli(), 10))
+	}
+	if !end.IsZero() {
+		params.Set("end", strconv.FormatInt(end.UnixMilli(), 10))
+	}
+	var fills []core.OrderFill
+	for {
+		page, err := getList[OKXFill](apiKey, secretKey, passphrase, fillsHistoryPath, params)
+		if err != nil {
+			return nil, err
+		}
+		for _, fill := range page {
+			fills = append(fills, fill.OrderFill())
+		}
+		if len(page) < orderListLimit {
+			return fills, nil
+		}
+		params.Set("after", page[len(page)-1].BillID)
+	}
+}
+
+// OrderFill converts a fill, the fee sign is flipped to positive when charged
+func (f OKXFill) OrderFill() core.OrderFill {
+	side := core.OrderSide(strings.ToUpper(f.Side))
+	return core.OrderFill{
+		TradeID:   f.TradeID,
+		OrderID:   f.OrdID,
+		Symbol:    f.InstID,
+		Side:      side,
+		Price:     ToDecimal(f.FillPx),
+		Quantity:  ToDecimal(f.FillSz),
+		Fee:       ToDecimal(f.Fee).Neg(),
+		FeeAsset:  f.FeeCcy,
+		IsMaker:   f.ExecType == "M",
+		TradeTime: ToTime(f.Ts),
+		IsBuyer:   side == core.OrderSideBuy,
+	}
+}
+
+// OrderStatus maps the state of an order, the cancel source tells the orders cancelled by
+// the user from those expired or rejected by the exchange and is passed on as the reason
+func (o OKXOrder) OrderStatus() (core.OrderStatus, string) {
+	reason := o.CancelSourceReason
+	if reason == "" {
+		reason = o.CancelSource
+	}
+	switch o.State {
+	case "live":
+		return core.OrderStatusOpen, ""
+	case "partially_filled":
+		return core.OrderStatusPartiallyFilled, ""
+	case "filled":
+		return core.OrderStatusFilled, ""
+	case "mmp_canceled":
+		return core.OrderStatusExpired, reason
+	case "canceled":
+		switch o.CancelSource {
+		case "", "1", "20": // by the user or their cancel-all-after timer
+			return core.OrderStatusCanceled, reason
+		case "31": // post only order would have taken liquidity
+			return core.OrderStatusRejected, reason
+		default:
+			return core.OrderStatusExpired, reason
+		}
+	default:
+		return core.OrderStatusError, reason
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -13,47 +15,93 @@ import (
 
 // SubscribeQuotes implements core.PublicClient
 func (c *OKXClient) SubscribeQuotes(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]chan core.Quote, error) {
-	result := make(map[string]chan core.Quote)
-	
-	c.quoteChansMu.Lock()
-	defer c.quoteChansMu.Unlock()
-	
-	// Create channels for each symbol
-	for _, symbol := range symbols {
-		quoteChan := make(chan core.Quote, 100) // Buffer to prevent blocking
-		result[symbol] = quoteChan
-		c.quoteChans[symbol] = quoteChan
-	}
-	
-	// Subscribe to tickers for all symbols
-	args := make([]map[string]string, len(symbols))
-	for i, symbol := range symbols {
-		args[i] = map[string]string{
-			"channel":  "tickers",
-			"instId":   symbol,
-		}
+	return SubscribeQuoteStream(ctx, symbols, errHandler)
+}
+
+// SubscribeOrderbook implements core.PublicClient
+func (c *OKXClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	return SubscribeOrderbookStream(ctx, symbols, depth, errHandler)
+}
+
+// SubscribeTrades implements core.PublicClient
+func (c *OKXClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	return SubscribeTradeStream(ctx, symbols, errHandler)
+}
+
+// FetchQuotes implements core.PublicClient from the tickers of all spot instruments
+func (c *OKXClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
+	tickers, err := FetchInstrumentTickers("SPOT", symbols)
+	if err != nil {
+		return nil, err
 	}
-	
-	req := map[string]interface{}{
-		"op":   "subscribe",
-		"args": args,
+	quotes := make(map[string]core.Quote, len(tickers))
+	for symbol, t := range tickers {
+		quotes[symbol] = t.Quote()
 	}
-	
-	// Use a separate public WebSocket connection for market data
-	// For now, we'll use the private connection, but in production you might want separate connections
-	_, err := c.WsClient.SendRequest(req)
+	return quotes, core.MissingSymbols("okx", symbols, quotes)
+}
+
+// FetchTickers implements core.PublicClient
+func (c *OKXClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	return FetchInstrumentTickers("SPOT", symbols)
+}
+
+// FetchInstrumentTickers fetches the tickers of all instruments of instType in one request
+// and keeps those of symbols, all when it is empty. Contracts count their volume in
+// contracts and give no quote volume, so Volume is taken from volCcy24h and QuoteVolume is
+// estimated from it at the last price.
+func FetchInstrumentTickers(instType string, symbols []string) (map[string]core.Ticker, error) {
+	params := url.Values{}
+	params.Set("instType", instType)
+	resp, err := http.Get(okxRestURL + "/api/v5/market/tickers?" + params.Encode())
 	if err != nil {
-		// Clean up channels on error
-		for _, ch := range result {
-			close(ch)
+		return nil, fmt.Errorf("failed to fetch tickers: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var res struct {
+		Code string      `json:"code"`
+		Msg  string      `json:"msg"`
+		Data []OKXTicker `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return nil, fmt.Errorf("failed to decode tickers: %w", err)
+	}
+	if res.Code != "0" {
+		return nil, ParseOKXError(res.Code, res.Msg)
+	}
+	tickers := make([]core.Ticker, len(res.Data))
+	for i, t := range res.Data {
+		last, open := ToDecimal(t.Last), ToDecimal(t.Open24h)
+		volume, quoteVolume := ToDecimal(t.Vol24h), ToDecimal(t.VolCcy24h)
+		if instType != "SPOT" {
+			volume, quoteVolume = quoteVolume, quoteVolume.Mul(last)
 		}
-		for _, symbol := range symbols {
-			delete(c.quoteChans, symbol)
+		tickers[i] = core.Ticker{
+			Symbol:        t.InstID,
+			BidPrice:      ToDecimal(t.BidPx),
+			BidQty:        ToDecimal(t.BidSz),
+			AskPrice:      ToDecimal(t.AskPx),
+			AskQty:        ToDecimal(t.AskSz),
+			LastPrice:     last,
+			OpenPrice:     open,
+			HighPrice:     ToDecimal(t.High24h),
+			LowPrice:      ToDecimal(t.Low24h),
+			Volume:        volume,
+			QuoteVolume:   quoteVolume,
+			ChangePercent: core.ChangePercent(open, last),
+			Time:          ToTime(t.Ts),
 		}
-		return nil, fmt.Errorf("failed to subscribe to quotes: %w", err)
 	}
-	
-	return result, nil
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// initRules sets up the rule cache that orders are rounded and validated against,
+// FetchMarketRules takes instrument ids
+func (c *OKXClient) initRules() {
+	c.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		return c.FetchMarketRules([]string{symbol})
+	}, core.DefaultRuleTTL)
 }
 
 // FetchMarketRules implements core.PublicClient
@@ -124,6 +172,7 @@ func (c *OKXClient) convertToMarketRule(instrument OKXInstrument) core.MarketRul
 		MaxQty:         maxLmtSize,
 		TickSize:       tickSize,
 		StepSize:       lotSize,
+		MaxNotional:    ToDecimal(instrument.MaxLmtAmt), // in USD
 		RateLimits:     c.getDefaultRateLimits(),
 	}
 }
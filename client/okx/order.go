@@ -1,8 +1,10 @@
 package okx
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ljm2ya/quickex-go/core"
@@ -31,6 +33,199 @@ func (c *OKXClient) MarketSell(symbol string, quantity decimal.Decimal) (*core.O
 	return c.placeMarketOrderViaWebSocket(symbol, "sell", quantity.String(), false)
 }
 
+// PlaceOrder implements core.PrivateClient, the client order id is sent as clOrdId
+func (c *OKXClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := c.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	response, err := c.persistentWS.SendRequestCtx(ctx, map[string]interface{}{
+		"op":   "order",
+		"args": []map[string]interface{}{OrderArgs(req, "cash")},
+	})
+	if err != nil {
+		return core.ResolvePlacement(c, req, fmt.Errorf("failed to place order: %w", err))
+	}
+	resp, err := c.parseWSOrderResponse(response)
+	if err != nil {
+		return nil, err
+	}
+	FillOrderResponse(resp, req)
+	return resp, nil
+}
+
+// FillOrderResponse completes the response of a placed order from its request, the order
+// op only answers with the ids
+func FillOrderResponse(resp *core.OrderResponse, req core.OrderRequest) {
+	resp.Symbol = req.Symbol
+	resp.Side = req.Side
+	resp.Tif = req.TimeInForce
+	resp.Price = req.Price
+	resp.Quantity = req.Quantity
+	if req.QuoteQuantity.IsPositive() {
+		resp.Quantity = req.QuoteQuantity
+		resp.IsQuoteQuantity = true
+	}
+}
+
+// BatchSize is the max number of orders of batch-orders and batch-cancel-orders
+const BatchSize = 20
+
+// PlaceOrders implements core.PrivateClient with batch-orders
+func (c *OKXClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return c.rules.PlaceBatches(ctx, reqs, BatchSize, func(ctx context.Context, reqs []core.OrderRequest) []core.OrderResult {
+		args := make([]map[string]interface{}, len(reqs))
+		for i, req := range reqs {
+			args[i] = OrderArgs(req, "cash")
+		}
+		response, err := c.persistentWS.SendRequestCtx(ctx, map[string]interface{}{
+			"op":   "batch-orders",
+			"args": args,
+		})
+		var results []core.OrderResult
+		if err == nil {
+			results, err = parseBatchResponse(response, len(reqs))
+		}
+		if err != nil {
+			return core.ResolveBatch(c, reqs, fmt.Errorf("failed to place orders: %w", err))
+		}
+		for i, res := range results {
+			if res.Order != nil {
+				FillOrderResponse(res.Order, reqs[i])
+			}
+		}
+		return results
+	})
+}
+
+// CancelOrders implements core.PrivateClient with batch-cancel-orders
+func (c *OKXClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelBatches(ctx, orderIds, BatchSize, func(ctx context.Context, orderIds []string) []core.OrderResult {
+		response, err := c.persistentWS.SendRequestCtx(ctx, map[string]interface{}{
+			"op":   "batch-cancel-orders",
+			"args": CancelArgs(symbol, orderIds),
+		})
+		var results []core.OrderResult
+		if err == nil {
+			results, err = parseBatchResponse(response, len(orderIds))
+		}
+		if err != nil {
+			return core.FailBatch(len(orderIds), fmt.Errorf("failed to cancel orders: %w", err))
+		}
+		CancelledOrders(results, symbol)
+		return results
+	})
+}
+
+func parseBatchResponse(responseBytes []byte, n int) ([]core.OrderResult, error) {
+	var response struct {
+		Code string          `json:"code"`
+		Msg  string          `json:"msg"`
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(responseBytes, &response); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response: %w", err)
+	}
+	return BatchResults(response.Code, response.Msg, response.Data, n)
+}
+
+// BatchResults parses the items of a batch op response, code and msg are the ones of the
+// request. Code 1 and 2 mean that all or some items failed, their sCode tells which.
+func BatchResults(code, msg string, data json.RawMessage, n int) ([]core.OrderResult, error) {
+	var items []struct {
+		OrdID   string `json:"ordId"`
+		ClOrdID string `json:"clOrdId"`
+		SCode   string `json:"sCode"`
+		SMsg    string `json:"sMsg"`
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal batch data: %w", err)
+		}
+	}
+	if len(items) != n {
+		if err := ParseOKXError(code, msg); err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("batch response has %d items for %d orders", len(items), n)
+	}
+	results := make([]core.OrderResult, n)
+	for i, item := range items {
+		if item.SCode != "" && item.SCode != "0" {
+			results[i].Err = ParseOKXError(item.SCode, item.SMsg)
+			continue
+		}
+		results[i].Order = &core.OrderResponse{
+			OrderID:       item.OrdID,
+			ClientOrderID: item.ClOrdID,
+			Status:        core.OrderStatusOpen,
+			CreateTime:    time.Now(),
+		}
+	}
+	return results, nil
+}
+
+// CancelArgs builds the batch-cancel-orders args
+func CancelArgs(symbol string, orderIds []string) []map[string]interface{} {
+	args := make([]map[string]interface{}, len(orderIds))
+	for i, orderId := range orderIds {
+		args[i] = map[string]interface{}{"instId": symbol, "ordId": orderId}
+	}
+	return args
+}
+
+// CancelledOrders completes the results of a batch cancel
+func CancelledOrders(results []core.OrderResult, symbol string) {
+	for _, res := range results {
+		if res.Order != nil {
+			res.Order.Symbol = symbol
+			res.Order.Status = core.OrderStatusCanceled
+		}
+	}
+}
+
+// stpModes maps self-trade prevention modes to the okx stpMode
+var stpModes = map[core.STPMode]string{
+	core.STPExpireTaker: "cancel_taker",
+	core.STPExpireMaker: "cancel_maker",
+	core.STPExpireBoth:  "cancel_both",
+}
+
+// OrderArgs builds the order op args of a prepared request, tdMode is "cash" for spot and
+// the margin mode for swaps. Spot market orders are sized by tgtCcy, swaps only in base.
+func OrderArgs(req core.OrderRequest, tdMode string) map[string]interface{} {
+	args := map[string]interface{}{
+		"instId":  req.Symbol,
+		"tdMode":  tdMode,
+		"side":    strings.ToLower(string(req.Side)),
+		"ordType": "market",
+		"sz":      req.Quantity.String(),
+		"clOrdId": req.ClientOrderID,
+	}
+	if req.Type == core.OrderTypeLimit {
+		args["px"] = req.Price.String()
+		switch {
+		case req.PostOnly:
+			args["ordType"] = "post_only"
+		case req.TimeInForce == core.TimeInForceGTC:
+			args["ordType"] = "limit"
+		default:
+			args["ordType"] = strings.ToLower(string(req.TimeInForce)) // ioc or fok
+		}
+	} else if tdMode == "cash" {
+		args["tgtCcy"] = "base_ccy"
+		if req.QuoteQuantity.IsPositive() {
+			args["sz"] = req.QuoteQuantity.String()
+			args["tgtCcy"] = "quote_ccy"
+		}
+	}
+	if tdMode != "cash" && req.ReduceOnly {
+		args["reduceOnly"] = true
+	}
+	if mode, ok := stpModes[req.STPMode]; ok {
+		args["stpMode"] = mode
+	}
+	return args
+}
 
 // CancelOrder implements core.PrivateClient
 func (c *OKXClient) CancelOrder(symbol, orderId string) (*core.OrderResponse, error) {
@@ -178,7 +373,7 @@ func (c *OKXClient) parseWSOrderResponse(responseBytes []byte) (*core.OrderRespo
 		if msgVal, ok := response["msg"].(string); ok {
 			msg = msgVal
 		}
-		return nil, fmt.Errorf("order failed: %s - %s", code, msg)
+		return nil, ParseOKXError(code, msg)
 	}
 	
 	// Extract order data
@@ -198,7 +393,7 @@ func (c *OKXClient) parseWSOrderResponse(responseBytes []byte) (*core.OrderRespo
 		if sMsgVal, ok := orderData["sMsg"].(string); ok {
 			sMsg = sMsgVal
 		}
-		return nil, fmt.Errorf("order failed: %s - %s", sCode, sMsg)
+		return nil, ParseOKXError(sCode, sMsg)
 	}
 	
 	// Extract order ID
@@ -207,11 +402,14 @@ func (c *OKXClient) parseWSOrderResponse(responseBytes []byte) (*core.OrderRespo
 		return nil, fmt.Errorf("missing order ID")
 	}
 	
+	clientOrderID, _ := orderData["clOrdId"].(string)
+
 	return &core.OrderResponse{
-		OrderID:    orderID,
-		Symbol:     "", // Will be filled by caller if needed
-		Status:     core.OrderStatusOpen, // OKX returns order as created
-		CreateTime: time.Now(),
+		OrderID:       orderID,
+		ClientOrderID: clientOrderID,
+		Symbol:        "",                   // Will be filled by caller if needed
+		Status:        core.OrderStatusOpen, // OKX returns order as created
+		CreateTime:    time.Now(),
 	}, nil
 }
 
@@ -229,4 +427,60 @@ func (c *OKXClient) mapTimeInForce(tif string) string {
 	default:
 		return "limit" // Default to limit/GTC
 	}
-}
\ No newline at end of file
+}
+// AmendOrder implements core.PrivateClient with amend-order, okx keeps queue priority
+// when only the quantity is reduced
+func (c *OKXClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	if err := core.ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	c.ordersMu.RLock()
+	cached, ok := c.orders[orderId]
+	c.ordersMu.RUnlock()
+	var prev core.OrderResponse
+	if ok {
+		prev = *cached
+	} else {
+		ord, err := c.FetchOrder(symbol, orderId)
+		if err != nil {
+			return nil, err
+		}
+		prev = ord.OrderResponse
+	}
+
+	if !c.persistentWS.IsConnected() {
+		if err := c.persistentWS.Connect(); err != nil {
+			return nil, fmt.Errorf("failed to connect persistent WebSocket: %w", err)
+		}
+	}
+	amendMsg := map[string]interface{}{
+		"op": "amend-order",
+		"args": []map[string]interface{}{
+			{
+				"instId": symbol,
+				"ordId":  orderId,
+				"newSz":  newQty.String(),
+				"newPx":  newPrice.String(),
+			},
+		},
+	}
+	response, err := c.persistentWS.SendRequest(amendMsg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to amend order: %w", err)
+	}
+	if _, err := c.parseWSOrderResponse(response); err != nil {
+		return nil, err
+	}
+
+	amended := prev
+	amended.OrderID = orderId
+	amended.Symbol = symbol
+	amended.Quantity = newQty
+	amended.Price = newPrice
+	amended.Status = core.OrderStatusOpen
+	return &core.AmendResult{
+		Order:           &amended,
+		OriginalOrderID: orderId,
+		KeptPriority:    core.AmendKeepsPriority(prev.Quantity, prev.Price, newQty, newPrice),
+	}, nil
+}
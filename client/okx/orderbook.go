@@ -0,0 +1,209 @@
+package okx
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strings"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// wsBooksMessage is a push of the books channel
+type wsBooksMessage struct {
+	Arg struct {
+		Channel string `json:"channel"`
+		InstID  string `json:"instId"`
+	} `json:"arg"`
+	Action string `json:"action"`
+	Data   []struct {
+		Asks      [][]string `json:"asks"`
+		Bids      [][]string `json:"bids"`
+		Ts        string     `json:"ts"`
+		SeqID     int64      `json:"seqId"`
+		PrevSeqID int64      `json:"prevSeqId"`
+		Checksum  int32      `json:"checksum"`
+	} `json:"data"`
+}
+
+// SubscribeOrderbookStream keeps local books in sync with the public books channel.
+// Shared by spot and futures clients since both use the same public endpoint.
+// Every update is verified against the checksum of the channel, a mismatch resubscribes
+// for a new snapshot like a sequence gap.
+func SubscribeOrderbookStream(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	books := make(map[string]*core.LocalOrderbook)
+	raws := make(map[string]*rawBook)
+	chans := make(map[string]chan core.Orderbook)
+	out := make(map[string]<-chan core.Orderbook)
+	args := make([]map[string]string, len(symbols))
+	for i, symbol := range symbols {
+		books[symbol] = core.NewLocalOrderbook(symbol, depth)
+		raws[symbol] = newRawBook()
+		chans[symbol] = make(chan core.Orderbook, 1)
+		out[symbol] = chans[symbol]
+		args[i] = map[string]string{"channel": "books", "instId": symbol}
+	}
+
+	var stream *core.Stream
+	stream = NewPublicStream(args, func(message []byte) {
+		var msg wsBooksMessage
+		if err := json.Unmarshal(message, &msg); err != nil || len(msg.Data) == 0 {
+			return
+		}
+		book, ok := books[msg.Arg.InstID]
+		if !ok {
+			return
+		}
+		raw := raws[msg.Arg.InstID]
+		data := msg.Data[0]
+		bids := core.ParseOrderbookLevels(data.Bids)
+		asks := core.ParseOrderbookLevels(data.Asks)
+		// resubscribe to receive a fresh snapshot
+		resync := func(err error) {
+			book.Invalidate()
+			if errHandler != nil {
+				errHandler(fmt.Errorf("%s orderbook resync: %w", book.Symbol, err))
+			}
+			arg := []map[string]string{{"channel": "books", "instId": book.Symbol}}
+			stream.WriteJSON(map[string]interface{}{"op": "unsubscribe", "args": arg})
+			stream.WriteJSON(map[string]interface{}{"op": "subscribe", "args": arg})
+		}
+
+		if msg.Action == "snapshot" {
+			book.ApplySnapshot(data.SeqID, bids, asks)
+			raw.reset()
+		} else {
+			var applied bool
+			var err error
+			if data.SeqID < data.PrevSeqID {
+				// the sequence was reset, e.g. by maintenance, and continues from seqId
+				applied, err = book.ApplySeqReset(data.PrevSeqID, data.SeqID, bids, asks)
+			} else {
+				applied, err = book.ApplyDiff(data.PrevSeqID+1, data.SeqID, bids, asks)
+			}
+			if err != nil {
+				if errors.Is(err, core.ErrOrderbookGap) {
+					resync(err)
+				}
+				return
+			}
+			if !applied {
+				return
+			}
+		}
+		raw.apply(data.Bids, data.Asks)
+		if sum := raw.checksum(); sum != data.Checksum {
+			resync(fmt.Errorf("checksum %d of the book, %d expected", sum, data.Checksum))
+			return
+		}
+		core.SendOrderbook(chans[msg.Arg.InstID], book.Orderbook())
+	}, errHandler)
+	// the replayed subscriptions start with a fresh snapshot
+	stream.OnReconnect = func() {
+		for _, book := range books {
+			book.Invalidate()
+		}
+	}
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to connect to public WebSocket: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
+
+// checksumDepth is the number of levels per side the checksum of the books channel covers
+const checksumDepth = 25
+
+// rawBook keeps the levels of a book as sent, the checksum is computed over the original
+// strings which the parsed decimals may not reproduce, e.g. trailing zeros
+type rawBook struct {
+	bids map[string]rawLevel // price : level
+	asks map[string]rawLevel
+}
+
+type rawLevel struct {
+	price decimal.Decimal
+	text  string // price:size as sent
+}
+
+func newRawBook() *rawBook {
+	return &rawBook{bids: make(map[string]rawLevel), asks: make(map[string]rawLevel)}
+}
+
+func (r *rawBook) reset() {
+	r.bids = make(map[string]rawLevel)
+	r.asks = make(map[string]rawLevel)
+}
+
+// apply sets the levels of a snapshot or diff, a zero size removes the level
+func (r *rawBook) apply(bids, asks [][]string) {
+	applyRawLevels(r.bids, bids)
+	applyRawLevels(r.asks, asks)
+}
+
+func applyRawLevels(side map[string]rawLevel, levels [][]string) {
+	for _, lv := range levels {
+		if len(lv) < 2 {
+			continue
+		}
+		price, err := decimal.NewFromString(lv[0])
+		if err != nil {
+			continue
+		}
+		key := price.String()
+		if core.ParseStringDecimal(lv[1]).IsZero() {
+			delete(side, key)
+			continue
+		}
+		side[key] = rawLevel{price: price, text: lv[0] + ":" + lv[1]}
+	}
+}
+
+// checksum is the CRC32 of the best checksumDepth bids and asks interleaved as
+// bid:size:ask:size, the side with fewer levels is continued by the other
+func (r *rawBook) checksum() int32 {
+	bids := topRawLevels(r.bids, true)
+	asks := topRawLevels(r.asks, false)
+	parts := make([]string, 0, len(bids)+len(asks))
+	for i := 0; i < checksumDepth; i++ {
+		if i < len(bids) {
+			parts = append(parts, bids[i])
+		}
+		if i < len(asks) {
+			parts = append(parts, asks[i])
+		}
+	}
+	return int32(crc32.ChecksumIEEE([]byte(strings.Join(parts, ":"))))
+}
+
+func topRawLevels(side map[string]rawLevel, desc bool) []string {
+	levels := make([]rawLevel, 0, len(side))
+	for _, lv := range side {
+		levels = append(levels, lv)
+	}
+	sort.Slice(levels, func(i, j int) bool {
+		if desc {
+			return levels[i].price.GreaterThan(levels[j].price)
+		}
+		return levels[i].price.LessThan(levels[j].price)
+	})
+	if len(levels) > checksumDepth {
+		levels = levels[:checksumDepth]
+	}
+	out := make([]string, len(levels))
+	for i, lv := range levels {
+		out[i] = lv.text
+	}
+	return out
+}
@@ -138,7 +138,8 @@ func (pws *PersistentWebSocket) authenticate() error {
 	}
 	
 	if code, ok := response["code"].(string); ok && code != "0" {
-		return fmt.Errorf("login failed: %s", response["msg"])
+		msg, _ := response["msg"].(string)
+		return fmt.Errorf("login failed: %w", ParseOKXError(code, msg))
 	}
 	
 	return nil
@@ -293,6 +294,11 @@ func (pws *PersistentWebSocket) handleMessage(message []byte) {
 
 // SendRequest sends a request and waits for response
 func (pws *PersistentWebSocket) SendRequest(request map[string]interface{}) ([]byte, error) {
+	return pws.SendRequestCtx(context.Background(), request)
+}
+
+// SendRequestCtx sends a request and waits for its response until ctx is done
+func (pws *PersistentWebSocket) SendRequestCtx(ctx context.Context, request map[string]interface{}) ([]byte, error) {
 	// Ensure connection
 	if err := pws.ensureConnected(); err != nil {
 		return nil, err
@@ -330,6 +336,8 @@ func (pws *PersistentWebSocket) SendRequest(request map[string]interface{}) ([]b
 	case pws.messageQueue <- requestBytes:
 	case <-time.After(5 * time.Second):
 		return nil, fmt.Errorf("request queue timeout")
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 	
 	// Wait for response
@@ -340,6 +348,8 @@ func (pws *PersistentWebSocket) SendRequest(request map[string]interface{}) ([]b
 		return nil, fmt.Errorf("request timeout")
 	case <-pws.ctx.Done():
 		return nil, fmt.Errorf("context cancelled")
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	}
 }
 
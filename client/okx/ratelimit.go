@@ -0,0 +1,37 @@
+package okx
+
+import (
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// orderOps count against the ORDER limit, once per order in args
+var orderOps = map[string]bool{
+	"order":               true,
+	"batch-orders":        true,
+	"cancel-order":        true,
+	"batch-cancel-orders": true,
+	"amend-order":         true,
+	"batch-amend-orders":  true,
+	"mass-cancel":         true,
+}
+
+// RateCostFn returns the weights of an OKX ws request, shared with the futures client
+func RateCostFn() core.WsRequestCostFunc {
+	return func(req map[string]interface{}) []core.RateCost {
+		op, _ := req["op"].(string)
+		if !orderOps[op] {
+			return []core.RateCost{{Category: core.RateLimitRequest, Weight: 1}}
+		}
+		weight := int64(1)
+		switch args := req["args"].(type) {
+		case []map[string]interface{}:
+			weight = int64(len(args))
+		case []interface{}:
+			weight = int64(len(args))
+		}
+		if weight < 1 {
+			weight = 1
+		}
+		return []core.RateCost{{Category: core.RateLimitOrder, Weight: weight}}
+	}
+}
@@ -0,0 +1,145 @@
+package okx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// NewPublicStream creates a reconnecting stream on the public endpoint that (re)subscribes args
+func NewPublicStream(args []map[string]string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	return newStream(okxWSURLPublic, args, handler, errHandler)
+}
+
+// NewBusinessStream is NewPublicStream for channels served on the business endpoint, e.g. candles
+func NewBusinessStream(args []map[string]string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	return newStream(okxWSURLBusiness, args, handler, errHandler)
+}
+
+func newStream(url string, args []map[string]string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	stream := core.NewStream(url, func(s *core.Stream) error {
+		return s.WriteJSON(map[string]interface{}{"op": "subscribe", "args": args})
+	}, func(msg []byte) {
+		if string(msg) == "pong" {
+			return
+		}
+		handler(msg)
+	}, errHandler)
+	// OKX closes connections idle for 30 seconds
+	stream.PingMessage = []byte("ping")
+	stream.PingInterval = 25 * time.Second
+	return stream
+}
+
+// SubscribeQuoteStream streams best bid/ask from the public tickers channel.
+// Shared by spot and futures clients since both use the same public endpoint.
+func SubscribeQuoteStream(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]chan core.Quote, error) {
+	result := make(map[string]chan core.Quote)
+	args := make([]map[string]string, len(symbols))
+	for i, symbol := range symbols {
+		result[symbol] = make(chan core.Quote, 100) // Buffer to prevent blocking
+		args[i] = map[string]string{
+			"channel": "tickers",
+			"instId":  symbol,
+		}
+	}
+
+	stream := NewPublicStream(args, func(msg []byte) {
+		var push struct {
+			Arg  OKXWSArg    `json:"arg"`
+			Data []OKXTicker `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &push); err != nil || push.Arg.Channel != "tickers" {
+			return
+		}
+		for _, ticker := range push.Data {
+			if quoteChan, exists := result[ticker.InstID]; exists {
+				select {
+				case quoteChan <- core.Quote{
+					Symbol:   ticker.InstID,
+					BidPrice: ToDecimal(ticker.BidPx),
+					BidQty:   ToDecimal(ticker.BidSz),
+					AskPrice: ToDecimal(ticker.AskPx),
+					AskQty:   ToDecimal(ticker.AskSz),
+					Time:     ToTime(ticker.Ts),
+				}:
+				default:
+					// Channel full, skip this update
+				}
+			}
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to quotes: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range result {
+			close(ch)
+		}
+	}()
+	return result, nil
+}
+
+// SubscribeTradeStream streams the public trades channel, side is the taker side.
+// Sizes are in the native unit of the instrument, i.e. contracts for swaps.
+func SubscribeTradeStream(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	result := make(map[string]<-chan core.Trade)
+	chans := make(map[string]chan core.Trade)
+	args := make([]map[string]string, len(symbols))
+	for i, symbol := range symbols {
+		chans[symbol] = make(chan core.Trade, 256)
+		result[symbol] = chans[symbol]
+		args[i] = map[string]string{
+			"channel": "trades",
+			"instId":  symbol,
+		}
+	}
+
+	stream := NewPublicStream(args, func(msg []byte) {
+		var push struct {
+			Arg  OKXWSArg `json:"arg"`
+			Data []struct {
+				InstID  string `json:"instId"`
+				TradeID string `json:"tradeId"`
+				Px      string `json:"px"`
+				Sz      string `json:"sz"`
+				Side    string `json:"side"`
+				Ts      string `json:"ts"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(msg, &push); err != nil || push.Arg.Channel != "trades" {
+			return
+		}
+		for _, t := range push.Data {
+			ch, exists := chans[t.InstID]
+			if !exists {
+				continue
+			}
+			core.SendTrade(ctx, ch, core.Trade{
+				Symbol:   t.InstID,
+				TradeID:  t.TradeID,
+				Price:    ToDecimal(t.Px),
+				Quantity: ToDecimal(t.Sz),
+				Side:     core.OrderSide(strings.ToUpper(t.Side)),
+				Time:     ToTime(t.Ts),
+			})
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to trades: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+	return result, nil
+}
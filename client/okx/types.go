@@ -25,6 +25,8 @@ type OKXInstrument struct {
 	MinSz     string `json:"minSz"`     // Minimum order size
 	MaxLmtSz  string `json:"maxLmtSz"`  // Maximum limit order size
 	MaxMktSz  string `json:"maxMktSz"`  // Maximum market order size
+	MaxLmtAmt string `json:"maxLmtAmt"` // Maximum limit order value in USD
+	MaxMktAmt string `json:"maxMktAmt"` // Maximum market order value in USD
 	State     string `json:"state"`     // live, suspend, preopen
 }
 
@@ -69,41 +71,63 @@ type OKXPosition struct {
 }
 
 type OKXOrder struct {
-	InstType    string `json:"instType"`    // SPOT, SWAP, FUTURES
-	InstID      string `json:"instId"`      // Instrument ID
-	TdMode      string `json:"tdMode"`      // cash, isolated, cross
-	Ccy         string `json:"ccy"`         // Currency
-	OrdID       string `json:"ordId"`       // Order ID
-	ClOrdID     string `json:"clOrdId"`     // Client order ID
-	Tag         string `json:"tag"`         // Order tag
-	Px          string `json:"px"`          // Price
-	Sz          string `json:"sz"`          // Size
-	NotionalUsd string `json:"notionalUsd"` // Notional value in USD
-	OrdType     string `json:"ordType"`     // limit, market, post_only, fok, ioc
-	Side        string `json:"side"`        // buy, sell
-	PosSide     string `json:"posSide"`     // long, short, net
-	TgtCcy      string `json:"tgtCcy"`      // base_ccy, quote_ccy
-	AccFillSz   string `json:"accFillSz"`   // Accumulated fill size
-	FillPx      string `json:"fillPx"`      // Fill price
-	TradeID     string `json:"tradeId"`     // Trade ID
-	FillSz      string `json:"fillSz"`      // Fill size
-	FillTime    string `json:"fillTime"`    // Fill time
-	AvgPx       string `json:"avgPx"`       // Average price
-	State       string `json:"state"`       // live, partially_filled, filled, canceled
-	Lever       string `json:"lever"`       // Leverage
-	TpTriggerPx string `json:"tpTriggerPx"` // Take profit trigger price
-	TpOrdPx     string `json:"tpOrdPx"`     // Take profit order price
-	SlTriggerPx string `json:"slTriggerPx"` // Stop loss trigger price
-	SlOrdPx     string `json:"slOrdPx"`     // Stop loss order price
-	FeeCcy      string `json:"feeCcy"`      // Fee currency
-	Fee         string `json:"fee"`         // Fee
-	RebateCcy   string `json:"rebateCcy"`   // Rebate currency
-	Rebate      string `json:"rebate"`      // Rebate
-	Pnl         string `json:"pnl"`         // PnL
-	Source      string `json:"source"`      // Order source
-	Category    string `json:"category"`    // normal, twap, adl, full_liquidation
-	UTime       string `json:"uTime"`       // Update time
-	CTime       string `json:"cTime"`       // Creation time
+	InstType           string `json:"instType"`           // SPOT, SWAP, FUTURES
+	InstID             string `json:"instId"`             // Instrument ID
+	TdMode             string `json:"tdMode"`             // cash, isolated, cross
+	Ccy                string `json:"ccy"`                // Currency
+	OrdID              string `json:"ordId"`              // Order ID
+	ClOrdID            string `json:"clOrdId"`            // Client order ID
+	Tag                string `json:"tag"`                // Order tag
+	Px                 string `json:"px"`                 // Price
+	Sz                 string `json:"sz"`                 // Size
+	NotionalUsd        string `json:"notionalUsd"`        // Notional value in USD
+	OrdType            string `json:"ordType"`            // limit, market, post_only, fok, ioc
+	Side               string `json:"side"`               // buy, sell
+	PosSide            string `json:"posSide"`            // long, short, net
+	TgtCcy             string `json:"tgtCcy"`             // base_ccy, quote_ccy
+	AccFillSz          string `json:"accFillSz"`          // Accumulated fill size
+	FillPx             string `json:"fillPx"`             // Fill price
+	TradeID            string `json:"tradeId"`            // Trade ID
+	FillSz             string `json:"fillSz"`             // Fill size
+	FillTime           string `json:"fillTime"`           // Fill time
+	FillFee            string `json:"fillFee"`            // Fee of the last fill, negative when charged
+	FillFeeCcy         string `json:"fillFeeCcy"`         // Fee currency of the last fill
+	ExecType           string `json:"execType"`           // Liquidity of the last fill, T taker, M maker
+	AvgPx              string `json:"avgPx"`              // Average price
+	State              string `json:"state"`              // live, partially_filled, filled, canceled, mmp_canceled
+	CancelSource       string `json:"cancelSource"`       // Code of who cancelled the order
+	CancelSourceReason string `json:"cancelSourceReason"` // Reason of the cancel source
+	Lever              string `json:"lever"`              // Leverage
+	TpTriggerPx        string `json:"tpTriggerPx"`        // Take profit trigger price
+	TpOrdPx            string `json:"tpOrdPx"`            // Take profit order price
+	SlTriggerPx        string `json:"slTriggerPx"`        // Stop loss trigger price
+	SlOrdPx            string `json:"slOrdPx"`            // Stop loss order price
+	FeeCcy             string `json:"feeCcy"`             // Fee currency
+	Fee                string `json:"fee"`                // Fee
+	RebateCcy          string `json:"rebateCcy"`          // Rebate currency
+	Rebate             string `json:"rebate"`             // Rebate
+	Pnl                string `json:"pnl"`                // PnL
+	Source             string `json:"source"`             // Order source
+	Category           string `json:"category"`           // normal, twap, adl, full_liquidation
+	UTime              string `json:"uTime"`              // Update time
+	CTime              string `json:"cTime"`              // Creation time
+}
+
+// OKXFill is a transaction of the fills history
+type OKXFill struct {
+	InstType string `json:"instType"` // SPOT, SWAP, FUTURES
+	InstID   string `json:"instId"`   // Instrument ID
+	TradeID  string `json:"tradeId"`  // Trade ID
+	OrdID    string `json:"ordId"`    // Order ID
+	ClOrdID  string `json:"clOrdId"`  // Client order ID
+	BillID   string `json:"billId"`   // Bill ID, the paging cursor
+	Side     string `json:"side"`     // buy, sell
+	FillPx   string `json:"fillPx"`   // Fill price
+	FillSz   string `json:"fillSz"`   // Fill size, in contracts for derivatives
+	Fee      string `json:"fee"`      // Fee, negative when charged and positive for a rebate
+	FeeCcy   string `json:"feeCcy"`   // Fee currency
+	ExecType string `json:"execType"` // T taker, M maker
+	Ts       string `json:"ts"`       // Fill time
 }
 
 type OKXTicker struct {
@@ -118,8 +142,8 @@ type OKXTicker struct {
 	Open24h   string `json:"open24h"`   // 24h open price
 	High24h   string `json:"high24h"`   // 24h high price
 	Low24h    string `json:"low24h"`    // 24h low price
-	VolCcy24h string `json:"volCcy24h"` // 24h volume in quote currency
-	Vol24h    string `json:"vol24h"`    // 24h volume in base currency
+	VolCcy24h string `json:"volCcy24h"` // 24h volume in quote currency, base currency for contracts
+	Vol24h    string `json:"vol24h"`    // 24h volume in base currency, contracts for contracts
 	SodUtc0   string `json:"sodUtc0"`   // Start of day UTC0
 	SodUtc8   string `json:"sodUtc8"`   // Start of day UTC8
 	Ts        string `json:"ts"`        // Timestamp
@@ -178,7 +202,7 @@ func ToTime(ts string) time.Time {
 	if len(ts) > 10 {
 		// Milliseconds timestamp
 		ms, _ := decimal.NewFromString(ts)
-		sec := ms.DivRound(decimal.NewFromInt(1000), 0)
+		sec := ms.Div(decimal.NewFromInt(1000)).Floor() // rounding would move half of the times a second ahead
 		nsec := ms.Mod(decimal.NewFromInt(1000)).Mul(decimal.NewFromInt(1000000))
 		secInt, _ := sec.Float64()
 		nsecInt, _ := nsec.Float64()
@@ -0,0 +1,166 @@
+package upbit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// candlePageLimit is the max count of a candles request
+const candlePageLimit = 200
+
+// candlePaths maps intervals to the upbit candle endpoints
+var candlePaths = map[core.CandleInterval]string{
+	core.CandleInterval1m:  "/v1/candles/minutes/1",
+	core.CandleInterval3m:  "/v1/candles/minutes/3",
+	core.CandleInterval5m:  "/v1/candles/minutes/5",
+	core.CandleInterval15m: "/v1/candles/minutes/15",
+	core.CandleInterval30m: "/v1/candles/minutes/30",
+	core.CandleInterval1h:  "/v1/candles/minutes/60",
+	core.CandleInterval4h:  "/v1/candles/minutes/240",
+	core.CandleInterval1d:  "/v1/candles/days",
+	core.CandleInterval1w:  "/v1/candles/weeks",
+	core.CandleInterval1M:  "/v1/candles/months",
+}
+
+type upbitCandle struct {
+	Market               string      `json:"market"`
+	CandleDateTimeUTC    string      `json:"candle_date_time_utc"`
+	OpeningPrice         json.Number `json:"opening_price"`
+	HighPrice            json.Number `json:"high_price"`
+	LowPrice             json.Number `json:"low_price"`
+	TradePrice           json.Number `json:"trade_price"`
+	CandleAccTradePrice  json.Number `json:"candle_acc_trade_price"`
+	CandleAccTradeVolume json.Number `json:"candle_acc_trade_volume"`
+}
+
+// FetchCandles implements core.PublicClient
+func (u *UpbitClient) FetchCandles(symbol string, interval core.CandleInterval, start, end time.Time, limit int) ([]core.Candle, error) {
+	path, ok := candlePaths[interval]
+	if !ok {
+		return nil, core.ErrUnsupportedInterval("upbit", interval)
+	}
+	return core.PageCandles(start, end, limit, candlePageLimit, func(start, end time.Time, limit int) ([]core.Candle, error) {
+		params := url.Values{}
+		params.Set("market", symbol)
+		// to is exclusive and has second precision
+		params.Set("to", end.Add(time.Second).UTC().Format("2006-01-02T15:04:05Z"))
+		params.Set("count", strconv.Itoa(limit))
+		resp, err := quotationClient.Get(baseURL + path + "?" + params.Encode())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch candles: %w", err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read candles: %w", err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, wrapUpbitError(resp.StatusCode, body)
+		}
+
+		var rows []upbitCandle
+		if err := json.Unmarshal(body, &rows); err != nil {
+			return nil, fmt.Errorf("failed to decode candles: %w", err)
+		}
+		candles := make([]core.Candle, 0, len(rows))
+		for _, row := range rows {
+			openTime, err := time.Parse("2006-01-02T15:04:05", row.CandleDateTimeUTC)
+			if err != nil {
+				return nil, fmt.Errorf("invalid candle time %q: %w", row.CandleDateTimeUTC, err)
+			}
+			candles = append(candles, core.Candle{
+				Symbol:      symbol,
+				Interval:    interval,
+				OpenTime:    openTime,
+				CloseTime:   openTime.Add(interval.Duration() - time.Millisecond),
+				Open:        decimal.RequireFromString(row.OpeningPrice.String()),
+				High:        decimal.RequireFromString(row.HighPrice.String()),
+				Low:         decimal.RequireFromString(row.LowPrice.String()),
+				Close:       decimal.RequireFromString(row.TradePrice.String()),
+				Volume:      decimal.RequireFromString(row.CandleAccTradeVolume.String()),
+				QuoteVolume: decimal.RequireFromString(row.CandleAccTradePrice.String()),
+			})
+		}
+		return candles, nil
+	})
+}
+
+// SubscribeCandles implements core.PublicClient
+// Upbit has no kline stream, bars are built from the trade stream. The current bar is
+// seeded from REST so that it is not missing the trades before the subscription.
+func (u *UpbitClient) SubscribeCandles(ctx context.Context, symbols []string, interval core.CandleInterval, errHandler func(err error)) (map[string]<-chan core.Candle, error) {
+	if _, ok := candlePaths[interval]; !ok {
+		return nil, core.ErrUnsupportedInterval("upbit", interval)
+	}
+	// seed every bar before creating the channels, nothing is left open on a failure
+	builders := make(map[string]*core.CandleBuilder)
+	builderList := make([]*core.CandleBuilder, 0, len(symbols))
+	for _, symbol := range symbols {
+		builder := core.NewCandleBuilder(symbol, interval)
+		last, err := u.FetchCandles(symbol, interval, time.Time{}, time.Time{}, 1)
+		if err != nil {
+			return nil, fmt.Errorf("failed to seed %s candle: %w", symbol, err)
+		}
+		if len(last) > 0 && !last[0].Closed {
+			builder.Update(last[0])
+		}
+		builders[symbol] = builder
+		builderList = append(builderList, builder)
+	}
+	chans := make(map[string]chan core.Candle)
+	out := make(map[string]<-chan core.Candle)
+	for _, symbol := range symbols {
+		chans[symbol] = make(chan core.Candle, 16)
+		out[symbol] = chans[symbol]
+	}
+	emit := func(candle core.Candle) {
+		core.SendCandle(ctx, chans[candle.Symbol], candle)
+	}
+
+	stream := newPublicStream("trade", symbols, func(message []byte) {
+		var trade WsTrade
+		if err := json.Unmarshal(message, &trade); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("unmarshal error: %w", err))
+			}
+			return
+		}
+		builder, ok := builders[trade.Code]
+		// the snapshot repeats the last trade, which is already in the seeded bar
+		if trade.Type != "trade" || trade.StreamType == "SNAPSHOT" || !ok {
+			return
+		}
+		for _, candle := range builder.AddTrade(
+			decimal.NewFromFloat(trade.TradePrice),
+			decimal.NewFromFloat(trade.TradeVolume),
+			time.UnixMilli(trade.TradeTimestamp),
+		) {
+			emit(candle)
+		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		for _, ch := range chans {
+			close(ch)
+		}
+		return nil, fmt.Errorf("websocket dial error: %w", err)
+	}
+
+	go func() {
+		// quiet markets have no trade to close the bar
+		core.WatchCandleCloses(stream.Done(), builderList, emit)
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
@@ -11,7 +11,6 @@ import (
 	"net/url"
 	"strings"
 	"sync"
-	"time"
 
 	"github.com/dgrijalva/jwt-go"
 	"github.com/google/uuid"
@@ -35,17 +34,22 @@ type UpbitClient struct {
 	balanceEventCh  chan core.BalanceEvent
 	subscriptionCtx context.Context
 	subscriptionCancel context.CancelFunc
+
+	conditionals *core.ConditionalEmulator // upbit has no conditional orders
+	rules        *core.RuleCache           // rules orders are normalised to
 }
 
 func NewUpbitClient(accessKey, secretKey string) *UpbitClient {
 	client := &UpbitClient{
 		apiKey:      accessKey,
 		secretKey:   secretKey,
-		client:      &http.Client{Timeout: 30 * time.Second},
+		client:      newExchangeHTTPClient(),
 		wsConnected: false,
 		wsMu:        sync.Mutex{},
 	}
 	client.privateWS = NewUpbitPrivateWS(client)
+	client.conditionals = core.NewConditionalEmulator(client, core.PlaceTriggered(client))
+	client.initRules()
 	return client
 }
 
@@ -118,6 +122,11 @@ func (u *UpbitClient) Token(query map[string]string) (string, error) {
 
 // makeRequest makes authenticated HTTP request to Upbit API
 func (u *UpbitClient) makeRequest(method, endpoint string, params map[string]string) ([]byte, error) {
+	return u.makeRequestCtx(context.Background(), method, endpoint, params)
+}
+
+// makeRequestCtx makes authenticated HTTP request to Upbit API, bounded by ctx
+func (u *UpbitClient) makeRequestCtx(ctx context.Context, method, endpoint string, params map[string]string) ([]byte, error) {
 	fullURL := baseURL + endpoint
 
 	token, err := u.Token(params)
@@ -133,19 +142,19 @@ func (u *UpbitClient) makeRequest(method, endpoint string, params map[string]str
 			urlValues.Add(key, value)
 		}
 		fullURL += "?" + urlValues.Encode()
-		req, err = http.NewRequest(method, fullURL, nil)
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, nil)
 	} else if method == "POST" && len(params) > 0 {
 		// For POST requests, send parameters as JSON in body
 		jsonBody, err := json.Marshal(params)
 		if err != nil {
 			return nil, err
 		}
-		req, err = http.NewRequest(method, fullURL, strings.NewReader(string(jsonBody)))
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, strings.NewReader(string(jsonBody)))
 		if err != nil {
 			return nil, err
 		}
 	} else {
-		req, err = http.NewRequest(method, fullURL, nil)
+		req, err = http.NewRequestWithContext(ctx, method, fullURL, nil)
 	}
 
 	if err != nil {
@@ -167,7 +176,7 @@ func (u *UpbitClient) makeRequest(method, endpoint string, params map[string]str
 	}
 
 	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		return nil, fmt.Errorf("API error: %d - %s", resp.StatusCode, string(body))
+		return nil, wrapUpbitError(resp.StatusCode, body)
 	}
 
 	return body, nil
@@ -0,0 +1,16 @@
+package upbit
+
+import (
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// PlaceConditional implements core.ConditionalOrderClient, upbit has no conditional
+// orders so every one is emulated on the last price
+func (u *UpbitClient) PlaceConditional(req core.ConditionalOrderRequest) (*core.OrderResponse, error) {
+	return u.conditionals.Place(req)
+}
+
+// CancelConditional implements core.ConditionalOrderClient
+func (u *UpbitClient) CancelConditional(symbol, orderId string) error {
+	return u.conditionals.Cancel(orderId)
+}
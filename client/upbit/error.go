@@ -1,6 +1,13 @@
 package upbit
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/ljm2ya/quickex-go/core"
 	"github.com/pkg/errors"
 )
 
@@ -35,3 +42,50 @@ func NewUpbitError(err error) error {
 	}
 	return err
 }
+
+// upbitErrorCategories maps upbit error names into core error categories
+var upbitErrorCategories = map[string]core.ErrorCategory{
+	"insufficient_funds_bid":     core.ErrCategoryInsufficientBalance,
+	"insufficient_funds_ask":     core.ErrCategoryInsufficientBalance,
+	"under_min_total_bid":        core.ErrCategoryMinNotional,
+	"under_min_total_ask":        core.ErrCategoryMinNotional,
+	"under_min_total_market_ask": core.ErrCategoryMinNotional,
+	"invalid_price_bid":          core.ErrCategoryPriceFilter,
+	"invalid_price_ask":          core.ErrCategoryPriceFilter,
+	"order_not_found":            core.ErrCategoryOrderNotFound,
+	"market_does_not_exist":      core.ErrCategoryInvalidSymbol,
+	"invalid_market":             core.ErrCategoryInvalidSymbol,
+	"jwt_verification":           core.ErrCategoryAuthFailed,
+	"expired_access_key":         core.ErrCategoryAuthFailed,
+	"invalid_access_key":         core.ErrCategoryAuthFailed,
+	"nonce_used":                 core.ErrCategoryAuthFailed,
+	"no_authorization_i_p":       core.ErrCategoryAuthFailed,
+	"out_of_scope":               core.ErrCategoryAuthFailed,
+	"too_many_requests":          core.ErrCategoryRateLimited,
+}
+
+// wrapUpbitError maps a non-2xx upbit REST response into core.ExchangeError
+func wrapUpbitError(status int, body []byte) error {
+	var upErr UpbitError
+	if err := json.Unmarshal(body, &upErr); err != nil || upErr.Err.Name == "" {
+		upErr.Err.Message = string(body)
+	}
+	category, ok := upbitErrorCategories[upErr.Err.Name]
+	if !ok {
+		switch {
+		case status == http.StatusTooManyRequests || status == 418:
+			category = core.ErrCategoryRateLimited
+		case status == http.StatusUnauthorized:
+			category = core.ErrCategoryAuthFailed
+		case status == http.StatusServiceUnavailable || strings.Contains(upErr.Err.Name, "maintenance"):
+			category = core.ErrCategoryMaintenance
+		default:
+			category = core.ErrCategoryUnknown
+		}
+	}
+	code := upErr.Err.Name
+	if code == "" {
+		code = strconv.Itoa(status)
+	}
+	return core.NewExchangeError("upbit", category, code, fmt.Sprintf("%s (status %d)", upErr.Err.Message, status)).WithStatus(status)
+}
@@ -11,7 +11,6 @@ import (
 	"strings"
 	"time"
 
-	"github.com/gorilla/websocket"
 	"github.com/ljm2ya/quickex-go/core"
 	"github.com/shopspring/decimal"
 )
@@ -19,7 +18,7 @@ import (
 // GetTicker gets ticker data for a symbol
 func (u *UpbitClient) GetTicker(symbol string) (*UpbitTickerOfMarket, error) {
 	// This is a public endpoint, no authentication needed
-	resp, err := http.Get(baseURL + "/v1/ticker?markets=" + symbol)
+	resp, err := quotationClient.Get(baseURL + "/v1/ticker?markets=" + symbol)
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +43,7 @@ func (u *UpbitClient) GetTicker(symbol string) (*UpbitTickerOfMarket, error) {
 
 func (u *UpbitClient) GetTickers(quote string) (*[]UpbitTickerOfMarket, error) {
 	// This is a public endpoint, no authentication needed
-	resp, err := http.Get(baseURL + "/v1/ticker/all?quote_currencies=" + quote)
+	resp, err := quotationClient.Get(baseURL + "/v1/ticker/all?quote_currencies=" + quote)
 	if err != nil {
 		return nil, err
 	}
@@ -70,7 +69,7 @@ func (u *UpbitClient) GetTickers(quote string) (*[]UpbitTickerOfMarket, error) {
 // GetMarketRules gets market rules for a quote currency
 func (u *UpbitClient) GetMarketRules(quote string) ([]UpbitMarket, error) {
 	// This is a public endpoint, no authentication needed
-	resp, err := http.Get(baseURL + "/v1/market/all")
+	resp, err := quotationClient.Get(baseURL + "/v1/market/all")
 	if err != nil {
 		return nil, err
 	}
@@ -106,21 +105,6 @@ func (u *UpbitClient) SubscribeQuotes(ctx context.Context, symbols []string, err
 		quoteChans[symbol] = make(chan core.Quote, 100)
 	}
 
-	// Connect to Upbit WebSocket
-	ws, _, err := websocket.DefaultDialer.Dial("wss://api.upbit.com/websocket/v1", nil)
-	if err != nil {
-		return nil, fmt.Errorf("websocket dial error: %w", err)
-	}
-
-	// Set ping handler
-	ws.SetPingHandler(func(pingData string) error {
-		return ws.WriteControl(
-			websocket.PongMessage,
-			[]byte(pingData),
-			time.Now().Add(10*time.Second),
-		)
-	})
-
 	// Create subscription request for orderbook with 1 unit
 	// Append .1 to each symbol to request only 1 orderbook unit
 	codes := make([]string, len(symbols))
@@ -128,110 +112,111 @@ func (u *UpbitClient) SubscribeQuotes(ctx context.Context, symbols []string, err
 		codes[i] = symbol + ".1"
 	}
 
-	// Upbit WebSocket subscription format
-	subscribeMsg := []map[string]interface{}{
-		{
-			"ticket": fmt.Sprintf("quickex-%d", time.Now().UnixNano()),
-		},
-		{
-			"type":  "orderbook",
-			"codes": codes,
-		},
-		{
-			"format": "SIMPLE", // Use SIMPLE format for easier parsing
-		},
-	}
+	stream := newOrderbookStream(codes, func(message []byte) {
+		// Parse orderbook message
+		var orderbook WsOrderbook
+		if err := json.Unmarshal(message, &orderbook); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("unmarshal error: %w", err))
+			}
+			return
+		}
 
-	// Send subscription message
-	if err := ws.WriteJSON(subscribeMsg); err != nil {
-		ws.Close()
-		return nil, fmt.Errorf("websocket write error: %w", err)
-	}
+		// Check if we have orderbook data
+		if orderbook.Type == "orderbook" && len(orderbook.OrderbookUnits) > 0 {
+			bestUnit := orderbook.OrderbookUnits[0]
 
-	// Start goroutine to handle WebSocket messages
-	go func() {
-		defer func() {
-			ws.Close()
-			// Close all channels when WebSocket closes
-			for _, ch := range quoteChans {
-				close(ch)
+			// Create quote from orderbook data
+			quote := core.Quote{
+				Symbol:   orderbook.Code,
+				BidPrice: decimal.NewFromFloat(bestUnit.BidPrice),
+				BidQty:   decimal.NewFromFloat(bestUnit.BidSize),
+				AskPrice: decimal.NewFromFloat(bestUnit.AskPrice),
+				AskQty:   decimal.NewFromFloat(bestUnit.AskSize),
+				Time:     time.Unix(orderbook.Timestamp/1000, (orderbook.Timestamp%1000)*1000000),
 			}
-		}()
-
-		closed := false
-
-		for {
-			select {
-			case <-ctx.Done():
-				closed = true
-				return
-			default:
-				// Set read deadline
-				ws.SetReadDeadline(time.Now().Add(30 * time.Second))
-
-				_, message, err := ws.ReadMessage()
-				if err != nil {
-					if !closed && errHandler != nil {
-						errHandler(fmt.Errorf("websocket read error: %w", err))
-					}
-					return
-				}
-
-				// Parse orderbook message
-				var orderbook WsOrderbook
-				if err := json.Unmarshal(message, &orderbook); err != nil {
-					if errHandler != nil {
-						errHandler(fmt.Errorf("unmarshal error: %w", err))
-					}
-					continue
-				}
 
-				// Check if we have orderbook data
-				if orderbook.Type == "orderbook" && len(orderbook.OrderbookUnits) > 0 {
-					bestUnit := orderbook.OrderbookUnits[0]
-
-					// Create quote from orderbook data
-					quote := core.Quote{
-						Symbol:   orderbook.Code,
-						BidPrice: decimal.NewFromFloat(bestUnit.BidPrice),
-						BidQty:   decimal.NewFromFloat(bestUnit.BidSize),
-						AskPrice: decimal.NewFromFloat(bestUnit.AskPrice),
-						AskQty:   decimal.NewFromFloat(bestUnit.AskSize),
-						Time:     time.Unix(orderbook.Timestamp/1000, (orderbook.Timestamp%1000)*1000000),
+			// Send to appropriate channel
+			if ch, ok := quoteChans[orderbook.Code]; ok {
+				select {
+				case ch <- quote:
+					// Successfully sent
+				default:
+					// Channel full, drop oldest and send new
+					select {
+					case <-ch:
+						// Dropped oldest
+					default:
+						// Channel was empty
 					}
-
-					// Send to appropriate channel
-					if ch, ok := quoteChans[orderbook.Code]; ok {
-						select {
-						case ch <- quote:
-							// Successfully sent
-						default:
-							// Channel full, drop oldest and send new
-							select {
-							case <-ch:
-								// Dropped oldest
-							default:
-								// Channel was empty
-							}
-							// Try to send again
-							select {
-							case ch <- quote:
-								// Successfully sent
-							default:
-								if errHandler != nil {
-									errHandler(fmt.Errorf("channel full for symbol %s", orderbook.Code))
-								}
-							}
+					// Try to send again
+					select {
+					case ch <- quote:
+						// Successfully sent
+					default:
+						if errHandler != nil {
+							errHandler(fmt.Errorf("channel full for symbol %s", orderbook.Code))
 						}
 					}
 				}
 			}
 		}
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("websocket dial error: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		// Close all channels when the stream stops
+		for _, ch := range quoteChans {
+			close(ch)
+		}
 	}()
 
 	return quoteChans, nil
 }
 
+// newOrderbookStream creates a reconnecting orderbook stream for the given codes
+func newOrderbookStream(codes []string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	return newPublicStream("orderbook", codes, handler, errHandler)
+}
+
+// newPublicStream creates a reconnecting stream of the given type (orderbook, trade, ticker) for codes
+func newPublicStream(streamType string, codes []string, handler core.StreamMessageHandler, errHandler func(err error)) *core.Stream {
+	stream := core.NewStream("wss://api.upbit.com/websocket/v1", func(s *core.Stream) error {
+		// Upbit WebSocket subscription format
+		subscribeMsg := []map[string]interface{}{
+			{
+				"ticket": fmt.Sprintf("quickex-%d", time.Now().UnixNano()),
+			},
+			{
+				"type":  streamType,
+				"codes": codes,
+			},
+			{
+				"format": "SIMPLE", // Use SIMPLE format for easier parsing
+			},
+		}
+		if err := s.WriteJSON(subscribeMsg); err != nil {
+			return fmt.Errorf("websocket write error: %w", err)
+		}
+		return nil
+	}, handler, errHandler)
+	stream.ReadTimeout = 30 * time.Second
+	return stream
+}
+
+// initRules sets up the rule cache that orders are rounded and validated against, the
+// quote leads the symbol
+func (u *UpbitClient) initRules() {
+	u.rules = core.NewRuleCache(func(symbol string) ([]core.MarketRule, error) {
+		// QUOTE-BASE
+		quote, _, _ := strings.Cut(symbol, "-")
+		return u.FetchMarketRules([]string{quote})
+	}, core.DefaultRuleTTL)
+}
+
 // FetchMarketRules implements core.PublicClient interface
 func (u *UpbitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, error) {
 	var allRules []core.MarketRule
@@ -249,6 +234,7 @@ func (u *UpbitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, erro
 		// Convert to core types
 		for _, rule := range rules {
 			var tickSize decimal.Decimal
+			var tickFunc func(price decimal.Decimal) decimal.Decimal
 
 			// For KRW markets, fetch current price to determine tick size
 			if quote == "KRW" {
@@ -262,6 +248,7 @@ func (u *UpbitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, erro
 				if price != 0.0 {
 					// Use dynamic tick size based on current price
 					tickSize = getTickSizeByPrice(price)
+					tickFunc = krwTickSize
 				} else {
 					panic("ticker error")
 				}
@@ -289,7 +276,10 @@ func (u *UpbitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, erro
 				MaxQty:         decimal.NewFromFloat(math.MaxInt64),
 				TickSize:       tickSize,
 				StepSize:       stepSize,
+				MinNotional:    minOrderTotal[quote],
+				MaxNotional:    maxOrderTotal[quote],
 				RateLimits:     []core.RateLimit{},
+				TickFunc:       tickFunc,
 			})
 		}
 		time.Sleep(time.Millisecond * 100) // for ip limit
@@ -298,48 +288,171 @@ func (u *UpbitClient) FetchMarketRules(quotes []string) ([]core.MarketRule, erro
 	return allRules, nil
 }
 
-func (u *UpbitClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
-	quotes := make(map[string]core.Quote)
-
-	// Join symbols for batch request
-	markets := strings.Join(symbols, ",")
+// orderbookMarketsLimit bounds the markets of one orderbook request
+const orderbookMarketsLimit = 100
 
-	// Use orderbook API directly with count=1 to get best bid/ask
-	resp, err := http.Get(baseURL + "/v1/orderbook?markets=" + markets + "&count=1")
+// FetchQuotes implements core.PublicClient with the best level of the orderbook. Upbit
+// rejects a request naming an unlisted market as a whole, it is then sent again for the
+// listed markets only and the others are reported in a core.SymbolErrors.
+func (u *UpbitClient) FetchQuotes(symbols []string) (map[string]core.Quote, error) {
+	markets := symbols
+	if len(markets) == 0 {
+		listed, err := u.listedMarkets()
+		if err != nil {
+			return nil, err
+		}
+		markets = listed
+	}
+	quotes, err := u.orderbookQuotes(markets)
+	if err != nil && len(symbols) > 0 && !core.IsTemporary(err) {
+		listed, listErr := u.listedMarkets()
+		if listErr != nil {
+			return nil, listErr
+		}
+		isListed := make(map[string]bool, len(listed))
+		for _, market := range listed {
+			isListed[market] = true
+		}
+		markets = nil
+		for _, symbol := range symbols {
+			if isListed[symbol] {
+				markets = append(markets, symbol)
+			}
+		}
+		quotes, err = u.orderbookQuotes(markets)
+	}
 	if err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
+	return quotes, core.MissingSymbols("upbit", symbols, quotes)
+}
 
+// listedMarkets returns the codes of all listed markets
+func (u *UpbitClient) listedMarkets() ([]string, error) {
+	resp, err := quotationClient.Get(baseURL + "/v1/market/all")
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch markets: %w", err)
+	}
+	defer resp.Body.Close()
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to read markets: %w", err)
 	}
-
-	var orderbooks []UpbitOrderbook
-	if err := json.Unmarshal(body, &orderbooks); err != nil {
-		return nil, err
+	if resp.StatusCode != http.StatusOK {
+		return nil, wrapUpbitError(resp.StatusCode, body)
 	}
+	var markets []UpbitMarket
+	if err := json.Unmarshal(body, &markets); err != nil {
+		return nil, fmt.Errorf("failed to decode markets: %w", err)
+	}
+	codes := make([]string, len(markets))
+	for i, market := range markets {
+		codes[i] = market.Market
+	}
+	return codes, nil
+}
 
-	// Convert orderbook data to quotes
-	for _, orderbook := range orderbooks {
-		if len(orderbook.OrderbookUnits) > 0 {
-			// Use best bid/ask from orderbook
-			bestUnit := orderbook.OrderbookUnits[0]
-			quotes[orderbook.Market] = core.Quote{
-				Symbol:   orderbook.Market,
-				BidPrice: decimal.NewFromFloat(bestUnit.BidPrice),
-				BidQty:   decimal.NewFromFloat(bestUnit.BidSize),
-				AskPrice: decimal.NewFromFloat(bestUnit.AskPrice),
-				AskQty:   decimal.NewFromFloat(bestUnit.AskSize),
-				Time:     time.Unix(int64(orderbook.Timestamp/1000), int64((orderbook.Timestamp%1000)*1000000)),
+// orderbookQuotes fetches the best level of markets, orderbookMarketsLimit per request
+func (u *UpbitClient) orderbookQuotes(markets []string) (map[string]core.Quote, error) {
+	quotes := make(map[string]core.Quote, len(markets))
+	for start := 0; start < len(markets); start += orderbookMarketsLimit {
+		end := min(start+orderbookMarketsLimit, len(markets))
+		resp, err := quotationClient.Get(baseURL + "/v1/orderbook?markets=" + strings.Join(markets[start:end], ",") + "&count=1")
+		if err != nil {
+			return nil, err
+		}
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, wrapUpbitError(resp.StatusCode, body)
+		}
+
+		var orderbooks []UpbitOrderbook
+		if err := json.Unmarshal(body, &orderbooks); err != nil {
+			return nil, err
+		}
+		for _, orderbook := range orderbooks {
+			if len(orderbook.OrderbookUnits) > 0 {
+				// Use best bid/ask from orderbook
+				bestUnit := orderbook.OrderbookUnits[0]
+				quotes[orderbook.Market] = core.Quote{
+					Symbol:   orderbook.Market,
+					BidPrice: decimal.NewFromFloat(bestUnit.BidPrice),
+					BidQty:   decimal.NewFromFloat(bestUnit.BidSize),
+					AskPrice: decimal.NewFromFloat(bestUnit.AskPrice),
+					AskQty:   decimal.NewFromFloat(bestUnit.AskSize),
+					Time:     time.Unix(int64(orderbook.Timestamp/1000), int64((orderbook.Timestamp%1000)*1000000)),
+				}
 			}
 		}
 	}
-
 	return quotes, nil
 }
 
+// FetchTickers implements core.PublicClient, all markets of the KRW, BTC and USDT quotes
+// when symbols is empty. Upbit keeps the open, high and low of the day since 00:00 UTC
+// instead of the last 24h, the volumes are of the last 24h and there is no top of book.
+func (u *UpbitClient) FetchTickers(symbols []string) (map[string]core.Ticker, error) {
+	path := "/v1/ticker/all?quote_currencies=KRW,BTC,USDT"
+	if len(symbols) > 0 {
+		path = "/v1/ticker?markets=" + strings.Join(symbols, ",")
+	}
+	resp, err := quotationClient.Get(baseURL + path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tickers: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tickers: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, wrapUpbitError(resp.StatusCode, body)
+	}
+
+	var rows []UpbitTickerOfMarket
+	if err := json.Unmarshal(body, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode tickers: %w", err)
+	}
+	tickers := make([]core.Ticker, len(rows))
+	for i, t := range rows {
+		open, last := decimal.NewFromFloat(t.OpeningPrice), decimal.NewFromFloat(t.TradePrice)
+		tickers[i] = core.Ticker{
+			Symbol:        t.Market,
+			LastPrice:     last,
+			OpenPrice:     open,
+			HighPrice:     decimal.NewFromFloat(t.HighPrice),
+			LowPrice:      decimal.NewFromFloat(t.LowPrice),
+			Volume:        decimal.NewFromFloat(t.AccTradeVolume24h),
+			QuoteVolume:   decimal.NewFromFloat(t.AccTradePrice24h),
+			ChangePercent: core.ChangePercent(open, last),
+			Time:          time.UnixMilli(t.Timestamp),
+		}
+	}
+	return core.TickerMap(tickers, symbols), nil
+}
+
+// minOrderTotal and maxOrderTotal bound the order value of the markets of a quote, the
+// market info of Upbit does not carry them
+var (
+	minOrderTotal = map[string]decimal.Decimal{
+		"KRW":  decimal.NewFromInt(5000),
+		"BTC":  decimal.RequireFromString("0.00005"),
+		"USDT": decimal.RequireFromString("0.5"),
+	}
+	maxOrderTotal = map[string]decimal.Decimal{
+		"KRW": decimal.NewFromInt(1000000000),
+	}
+)
+
+// krwTickSize is the KRW tick table as a core.MarketRule TickFunc
+func krwTickSize(price decimal.Decimal) decimal.Decimal {
+	return getTickSizeByPrice(price.InexactFloat64())
+}
+
 // getTickSizeByPrice returns the tick size based on the current price according to Upbit rules
 // Based on https://docs.upbit.com/kr/docs/krw-market-info
 func getTickSizeByPrice(price float64) decimal.Decimal {
@@ -375,3 +488,62 @@ func getTickSizeByPrice(price float64) decimal.Decimal {
 		return decimal.NewFromFloat(0.00000001)
 	}
 }
+
+// SubscribeOrderbook implements core.PublicClient interface
+// Upbit pushes the full orderbook on every update, each push replaces the local book
+func (u *UpbitClient) SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan core.Orderbook, error) {
+	// Upbit supports 1, 5, 15 and 30 orderbook units
+	units := 30
+	for _, n := range []int{1, 5, 15} {
+		if depth <= n {
+			units = n
+			break
+		}
+	}
+
+	books := make(map[string]*core.LocalOrderbook)
+	chans := make(map[string]chan core.Orderbook)
+	out := make(map[string]<-chan core.Orderbook)
+	codes := make([]string, len(symbols))
+	for i, symbol := range symbols {
+		books[symbol] = core.NewLocalOrderbook(symbol, depth)
+		chans[symbol] = make(chan core.Orderbook, 1)
+		out[symbol] = chans[symbol]
+		codes[i] = fmt.Sprintf("%s.%d", symbol, units)
+	}
+
+	stream := newOrderbookStream(codes, func(message []byte) {
+		var orderbook WsOrderbook
+		if err := json.Unmarshal(message, &orderbook); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("unmarshal error: %w", err))
+			}
+			return
+		}
+		book, ok := books[orderbook.Code]
+		if !ok || orderbook.Type != "orderbook" {
+			return
+		}
+
+		bids := make([]core.OrderbookEntry, 0, len(orderbook.OrderbookUnits))
+		asks := make([]core.OrderbookEntry, 0, len(orderbook.OrderbookUnits))
+		for _, unit := range orderbook.OrderbookUnits {
+			bids = append(bids, core.OrderbookEntry{Price: decimal.NewFromFloat(unit.BidPrice), Quantity: decimal.NewFromFloat(unit.BidSize)})
+			asks = append(asks, core.OrderbookEntry{Price: decimal.NewFromFloat(unit.AskPrice), Quantity: decimal.NewFromFloat(unit.AskSize)})
+		}
+		book.ApplySnapshot(orderbook.Timestamp, bids, asks)
+		core.SendOrderbook(chans[orderbook.Code], book.Orderbook())
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("websocket dial error: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
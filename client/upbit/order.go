@@ -1,9 +1,11 @@
 package upbit
 
 import (
+	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -11,17 +13,20 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-// parseOrderStatus converts Upbit order state to core.OrderStatus
-func parseOrderStatus(state string) core.OrderStatus {
+// parseOrderStatus converts Upbit order state to core.OrderStatus, an order waiting or
+// trading is open or partially filled by its executed volume
+func parseOrderStatus(state string, executedVolume decimal.Decimal) core.OrderStatus {
 	switch state {
-	case "wait", "watch":
-		return core.OrderStatusOpen
+	case "wait", "watch", "trade":
+		return core.OpenStatus(executedVolume)
 	case "done":
 		return core.OrderStatusFilled
 	case "cancel":
 		return core.OrderStatusCanceled
+	case "prevented":
+		return core.OrderStatusExpired // self-trade prevention
 	default:
-		return core.OrderStatusOpen
+		return core.OrderStatusError
 	}
 }
 
@@ -45,7 +50,8 @@ func parseOrderResponse(order UpbitOrder, tif string) *core.OrderResponse {
 		side = core.OrderSideSell
 	}
 
-	status := parseOrderStatus(order.State)
+	executedVolume, _ := decimal.NewFromString(order.ExecutedVolume)
+	status := parseOrderStatus(order.State, executedVolume)
 	price, _ := decimal.NewFromString(order.Price)
 	quantity, _ := decimal.NewFromString(order.Volume)
 	createdAt, _ := time.Parse(time.RFC3339, order.CreatedAt)
@@ -58,6 +64,7 @@ func parseOrderResponse(order UpbitOrder, tif string) *core.OrderResponse {
 
 	return &core.OrderResponse{
 		OrderID:         order.UUID,
+		ClientOrderID:   order.Identifier,
 		Symbol:          order.Market,
 		Side:            side,
 		Tif:             core.TimeInForce(tif),
@@ -74,19 +81,31 @@ func parseOrderResponse(order UpbitOrder, tif string) *core.OrderResponse {
 func (u *UpbitClient) FetchOrder(symbol, orderId string) (*core.OrderResponseFull, error) {
 	// FetchOrder should use REST API to get current order state
 	// WebSocket is used for real-time order updates, not fetching existing orders
-	return u.fetchOrderFromREST(symbol, orderId)
-}
-
-
-// fetchOrderFromREST fetches order using REST API (fallback method)
-func (u *UpbitClient) fetchOrderFromREST(symbol, orderId string) (*core.OrderResponseFull, error) {
 	params := make(map[string]string)
 	if len(orderId) <= 10 { // identifier
 		params["identifier"] = orderId
 	} else { // uuid
 		params["uuid"] = orderId
 	}
+	return u.fetchOrderFromREST(params)
+}
+
+// FetchOrderByClientID implements core.PrivateClient, the client order id is the identifier
+func (u *UpbitClient) FetchOrderByClientID(symbol, clientOrderID string) (*core.OrderResponseFull, error) {
+	return u.fetchOrderFromREST(map[string]string{"identifier": clientOrderID})
+}
+
+// fetchOrderFromREST fetches order using REST API (fallback method)
+func (u *UpbitClient) fetchOrderFromREST(params map[string]string) (*core.OrderResponseFull, error) {
+	order, err := u.getOrder(params)
+	if err != nil {
+		return nil, err
+	}
+	return orderFull(*order), nil
+}
 
+// getOrder fetches an order with its trades
+func (u *UpbitClient) getOrder(params map[string]string) (*UpbitOrder, error) {
 	body, err := u.makeRequest("GET", "/v1/order", params)
 	if err != nil {
 		return nil, err
@@ -96,7 +115,12 @@ func (u *UpbitClient) fetchOrderFromREST(symbol, orderId string) (*core.OrderRes
 	if err := json.Unmarshal(body, &order); err != nil {
 		return nil, err
 	}
+	return &order, nil
+}
 
+// orderFull converts an order with its execution, the average price is taken from the
+// trades when the order carries them
+func orderFull(order UpbitOrder) *core.OrderResponseFull {
 	// Convert to core types
 	// Use helper function for basic order response
 	baseResponse := parseOrderResponse(order, string(core.TimeInForceGTC))
@@ -105,7 +129,7 @@ func (u *UpbitClient) fetchOrderFromREST(symbol, orderId string) (*core.OrderRes
 	paidFee, _ := decimal.NewFromString(order.PaidFee)
 
 	// Calculate average price from trades array
-	avgPrice := decimal.Zero
+	avgPrice, _ := decimal.NewFromString(order.AvgPrice)
 	if len(order.Trades) > 0 {
 		totalValue := decimal.Zero
 		totalVolume := decimal.Zero
@@ -129,7 +153,155 @@ func (u *UpbitClient) fetchOrderFromREST(symbol, orderId string) (*core.OrderRes
 		UpdateTime:      baseResponse.CreateTime, // Upbit doesn't provide separate update time
 		Commission:      paidFee,
 		CommissionAsset: "KRW", // Upbit doesn't specify commission asset
-	}, nil
+	}
+}
+
+// order listing limits, the closed orders span at most seven days
+const (
+	openOrdersLimit   = 100
+	closedOrdersLimit = 1000
+	closedOrderWindow = 7 * 24 * time.Hour
+)
+
+// FetchOpenOrders implements core.PrivateClient, an empty symbol lists all markets
+func (u *UpbitClient) FetchOpenOrders(symbol string) ([]core.OrderResponseFull, error) {
+	params := map[string]string{"limit": strconv.Itoa(openOrdersLimit)}
+	if symbol != "" {
+		params["market"] = symbol
+	}
+	var orders []core.OrderResponseFull
+	for page := 1; ; page++ {
+		params["page"] = strconv.Itoa(page)
+		list, err := u.listOrders("/v1/orders/open", params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch open orders: %w", err)
+		}
+		orders = append(orders, list...)
+		if len(list) < openOrdersLimit {
+			break
+		}
+	}
+	core.SortOrders(orders)
+	return orders, nil
+}
+
+// FetchOrderHistory implements core.PrivateClient, an empty symbol lists all markets
+func (u *UpbitClient) FetchOrderHistory(symbol string, since, until time.Time) ([]core.OrderResponseFull, error) {
+	return core.PageOrders(since, until, closedOrderWindow, closedOrdersLimit, func(start, end time.Time, limit int) ([]core.OrderResponseFull, error) {
+		params := map[string]string{
+			"start_time": start.UTC().Format(time.RFC3339),
+			"end_time":   end.UTC().Format(time.RFC3339),
+			"limit":      strconv.Itoa(limit),
+		}
+		if symbol != "" {
+			params["market"] = symbol
+		}
+		orders, err := u.listOrders("/v1/orders/closed", params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch order history: %w", err)
+		}
+		return orders, nil
+	})
+}
+
+func (u *UpbitClient) listOrders(endpoint string, params map[string]string) ([]core.OrderResponseFull, error) {
+	body, err := u.makeRequest("GET", endpoint, params)
+	if err != nil {
+		return nil, err
+	}
+	var list []UpbitOrder
+	if err := json.Unmarshal(body, &list); err != nil {
+		return nil, err
+	}
+	orders := make([]core.OrderResponseFull, len(list))
+	for i, order := range list {
+		orders[i] = *orderFull(order)
+	}
+	return orders, nil
+}
+
+// FetchFills implements core.PrivateClient. Upbit lists no account trades, so these are
+// the trades of the orders created in [since, until], fetched order by order. The paid fee
+// of an order is split over its trades by funds, and whether a trade was maker is unknown.
+func (u *UpbitClient) FetchFills(symbol string, since, until time.Time) ([]core.OrderFill, error) {
+	closed, err := u.FetchOrderHistory(symbol, since, until)
+	if err != nil {
+		return nil, err
+	}
+	open, err := u.FetchOpenOrders(symbol)
+	if err != nil {
+		return nil, err
+	}
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if since.IsZero() {
+		since = until.Add(-core.OrderHistoryLookback)
+	}
+	var fills []core.OrderFill
+	for _, order := range append(closed, open...) {
+		if !order.ExecutedQty.IsPositive() || order.CreateTime.Before(since) || order.CreateTime.After(until) {
+			continue
+		}
+		full, err := u.getOrder(map[string]string{"uuid": order.OrderID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch trades of order %s: %w", order.OrderID, err)
+		}
+		fills = append(fills, orderFills(*full)...)
+	}
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// FetchOrderFills implements core.PrivateClient from the trades of the order, see FetchFills
+func (u *UpbitClient) FetchOrderFills(symbol, orderId string) ([]core.OrderFill, error) {
+	params := map[string]string{"uuid": orderId}
+	if len(orderId) <= 10 { // identifier, like FetchOrder
+		params = map[string]string{"identifier": orderId}
+	}
+	order, err := u.getOrder(params)
+	if err != nil {
+		return nil, err
+	}
+	fills := orderFills(*order)
+	core.SortFills(fills)
+	return fills, nil
+}
+
+// orderFills converts the trades of an order, fees are in the quote currency
+func orderFills(order UpbitOrder) []core.OrderFill {
+	paidFee, _ := decimal.NewFromString(order.PaidFee)
+	totalFunds := decimal.Zero
+	for _, trade := range order.Trades {
+		funds, _ := decimal.NewFromString(trade.Funds)
+		totalFunds = totalFunds.Add(funds)
+	}
+	feeAsset, _, _ := strings.Cut(order.Market, "-")
+	side := parseOrderResponse(order, "").Side
+	fills := make([]core.OrderFill, len(order.Trades))
+	for i, trade := range order.Trades {
+		price, _ := decimal.NewFromString(trade.Price)
+		volume, _ := decimal.NewFromString(trade.Volume)
+		funds, _ := decimal.NewFromString(trade.Funds)
+		fee := decimal.Zero
+		if totalFunds.IsPositive() {
+			fee = paidFee.Mul(funds).Div(totalFunds)
+		}
+		tradeTime, _ := time.Parse(time.RFC3339, trade.CreatedAt)
+		fills[i] = core.OrderFill{
+			TradeID:   trade.UUID,
+			OrderID:   order.UUID,
+			Symbol:    order.Market,
+			Side:      side,
+			Price:     price,
+			Quantity:  volume,
+			Fee:       fee,
+			FeeAsset:  feeAsset,
+			TradeTime: tradeTime,
+			IsBuyer:   side == core.OrderSideBuy,
+		}
+	}
+	return fills
 }
 
 // LimitBuy implements core.PrivateClient interface
@@ -209,14 +381,103 @@ func (u *UpbitClient) MarketSell(symbol string, quantity decimal.Decimal) (*core
 	return parseOrderResponse(order, string(core.TimeInForceGTC)), nil
 }
 
-// StopLossSell implements core.PrivateClient interface
+// smpTypes maps self-trade prevention modes to the upbit smp_type
+var smpTypes = map[core.STPMode]string{
+	core.STPExpireTaker: "cancel_taker",
+	core.STPExpireMaker: "cancel_maker",
+}
+
+// PlaceOrder implements core.PrivateClient, the client order id is sent as identifier.
+// Upbit sizes market buys in quote and market sells in base only, and has no post-only.
+func (u *UpbitClient) PlaceOrder(ctx context.Context, req core.OrderRequest) (*core.OrderResponse, error) {
+	if err := u.rules.Prepare(&req); err != nil {
+		return nil, err
+	}
+	if req.PostOnly {
+		return nil, core.ErrUnsupportedOrder("upbit", "post-only")
+	}
+	side := "bid"
+	if req.Side == core.OrderSideSell {
+		side = "ask"
+	}
+	var params map[string]string
+	switch {
+	case req.Type == core.OrderTypeLimit:
+		params = createOrderParams(req.Symbol, side, "limit", req.ClientOrderID)
+		params["price"] = req.Price.String()
+		params["volume"] = req.Quantity.String()
+		if req.TimeInForce != core.TimeInForceGTC {
+			params["time_in_force"] = strings.ToLower(string(req.TimeInForce))
+		}
+	case req.Side == core.OrderSideBuy:
+		if !req.QuoteQuantity.IsPositive() {
+			return nil, core.ErrUnsupportedOrder("upbit", "market buy in base quantity")
+		}
+		params = createOrderParams(req.Symbol, side, "price", req.ClientOrderID)
+		params["price"] = req.QuoteQuantity.String()
+	default:
+		if !req.Quantity.IsPositive() {
+			return nil, core.ErrUnsupportedOrder("upbit", "market sell in quote quantity")
+		}
+		params = createOrderParams(req.Symbol, side, "market", req.ClientOrderID)
+		params["volume"] = req.Quantity.String()
+	}
+	if req.STPMode != core.STPNone {
+		smpType, ok := smpTypes[req.STPMode]
+		if !ok {
+			return nil, core.ErrUnsupportedOrder("upbit", "self-trade prevention "+string(req.STPMode))
+		}
+		params["smp_type"] = smpType
+	}
+
+	body, err := u.makeRequestCtx(ctx, "POST", "/v1/orders", params)
+	if err != nil {
+		return core.ResolvePlacement(u, req, err)
+	}
+	var order UpbitOrder
+	if err := json.Unmarshal(body, &order); err != nil {
+		return nil, err
+	}
+	tif := req.TimeInForce
+	if tif == "" {
+		tif = core.TimeInForceGTC // market orders, as in MarketBuy and MarketSell
+	}
+	return parseOrderResponse(order, string(tif)), nil
+}
+
+// PlaceOrders implements core.PrivateClient, upbit has no batch orders so they are placed
+// one by one
+func (u *UpbitClient) PlaceOrders(ctx context.Context, reqs []core.OrderRequest) ([]core.OrderResult, error) {
+	return core.PlaceEach(ctx, reqs, u.PlaceOrder)
+}
+
+// CancelOrders implements core.PrivateClient, orders are cancelled one by one
+func (u *UpbitClient) CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]core.OrderResult, error) {
+	return core.CancelEach(ctx, orderIds, func(orderId string) (*core.OrderResponse, error) {
+		return u.CancelOrder(symbol, orderId)
+	})
+}
+
+// StopLossSell places an emulated stop-loss market sell, see PlaceConditional
 func (u *UpbitClient) StopLossSell(symbol string, quantity, triggerPrice decimal.Decimal) (*core.OrderResponse, error) {
-	return nil, errors.New("upbit does not support stop-loss orders")
+	return u.PlaceConditional(core.ConditionalOrderRequest{
+		Symbol:       symbol,
+		Side:         core.OrderSideSell,
+		Type:         core.ConditionalStopLoss,
+		Quantity:     quantity,
+		TriggerPrice: triggerPrice,
+	})
 }
 
-// TakeProfitSell implements core.PrivateClient interface
+// TakeProfitSell places an emulated take-profit market sell, see PlaceConditional
 func (u *UpbitClient) TakeProfitSell(symbol string, quantity, triggerPrice decimal.Decimal) (*core.OrderResponse, error) {
-	return nil, errors.New("upbit does not support take-profit orders")
+	return u.PlaceConditional(core.ConditionalOrderRequest{
+		Symbol:       symbol,
+		Side:         core.OrderSideSell,
+		Type:         core.ConditionalTakeProfit,
+		Quantity:     quantity,
+		TriggerPrice: triggerPrice,
+	})
 }
 
 // CancelOrder implements core.PrivateClient interface
@@ -265,3 +526,8 @@ func (u *UpbitClient) CancelAll(symbol string) error {
 
 	return nil
 }
+
+// AmendOrder implements core.PrivateClient, the order is cancelled and placed again
+func (u *UpbitClient) AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*core.AmendResult, error) {
+	return core.CancelReplace(u, symbol, orderId, newQty, newPrice)
+}
@@ -0,0 +1,79 @@
+package upbit
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+)
+
+// exchangeRateLimits are the per second limits of the exchange (private) api, orders have
+// their own group
+var exchangeRateLimits = []core.RateLimit{
+	{Category: core.RateLimitRequest, Interval: time.Second, Limit: 30},
+	{Category: core.RateLimitOrder, Interval: time.Second, Limit: 8},
+}
+
+// quotationRateLimits are the per second limits of the quotation (public) api
+var quotationRateLimits = []core.RateLimit{
+	{Category: core.RateLimitRequest, Interval: time.Second, Limit: 10},
+}
+
+// quotationClient sends the public requests, the quotation limits are per ip so it is shared
+// by all clients
+var quotationClient = &http.Client{
+	Timeout: 30 * time.Second,
+	Transport: &core.LimitedTransport{
+		Limiter: core.NewRateLimiter("upbit", quotationRateLimits),
+		Observe: observeRemainingReq,
+	},
+}
+
+// newExchangeHTTPClient returns the http client of the exchange api, order placement counts
+// against the order group
+func newExchangeHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &core.LimitedTransport{
+			Limiter: core.NewRateLimiter("upbit", exchangeRateLimits),
+			Cost:    exchangeRateCost,
+			Observe: observeRemainingReq,
+		},
+	}
+}
+
+func exchangeRateCost(req *http.Request) []core.RateCost {
+	if req.Method == http.MethodPost && req.URL.Path == "/v1/orders" {
+		return []core.RateCost{{Category: core.RateLimitOrder, Weight: 1}}
+	}
+	return []core.RateCost{{Category: core.RateLimitRequest, Weight: 1}}
+}
+
+// observeRemainingReq syncs the limiter with the Remaining-Req header,
+// e.g. "group=default; min=1800; sec=29"
+func observeRemainingReq(l *core.RateLimiter, h http.Header) {
+	header := h.Get("Remaining-Req")
+	if header == "" {
+		return
+	}
+	category := core.RateLimitRequest
+	remaining := int64(-1)
+	for _, field := range strings.Split(header, ";") {
+		key, value, _ := strings.Cut(strings.TrimSpace(field), "=")
+		switch key {
+		case "group":
+			if value == "order" {
+				category = core.RateLimitOrder
+			}
+		case "sec":
+			if v, err := strconv.ParseInt(value, 10, 64); err == nil {
+				remaining = v
+			}
+		}
+	}
+	if remaining >= 0 {
+		l.ObserveRemaining(category, time.Second, remaining)
+	}
+}
@@ -49,15 +49,17 @@ type UpbitOrder struct {
 	ExecutedVolume  string            `json:"executed_volume"`  // "0.0",
 	TradesCount     int               `json:"trades_count"`     // 0
 	Trades          []UpbitOrderTrade `json:"trades"`           // []
+	Identifier      string            `json:"identifier"`       // client order id
 }
 
 type UpbitOrderTrade struct {
-	Market string `json:"market"` // "KRW-BTC",
-	UUID   string `json:"uuid"`   // "9e8f8eba-7050-4837-8969-cfc272cbe083",
-	Price  string `json:"price"`  // "4280000.0",
-	Volume string `json:"volume"` // "1.0",
-	Funds  string `json:"funds"`  // "4280000.0",
-	Side   string `json:"side"`   // "ask"
+	Market    string `json:"market"`     // "KRW-BTC",
+	UUID      string `json:"uuid"`       // "9e8f8eba-7050-4837-8969-cfc272cbe083",
+	Price     string `json:"price"`      // "4280000.0",
+	Volume    string `json:"volume"`     // "1.0",
+	Funds     string `json:"funds"`      // "4280000.0",
+	Side      string `json:"side"`       // "ask"
+	CreatedAt string `json:"created_at"` // "2018-04-10T15:42:23+09:00",
 }
 
 type UpbitDepositAddress struct {
@@ -0,0 +1,62 @@
+package upbit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/ljm2ya/quickex-go/core"
+	"github.com/shopspring/decimal"
+)
+
+// SubscribeTrades implements core.PublicClient with the trade stream
+func (u *UpbitClient) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan core.Trade, error) {
+	chans := make(map[string]chan core.Trade)
+	out := make(map[string]<-chan core.Trade)
+	for _, symbol := range symbols {
+		chans[symbol] = make(chan core.Trade, 256)
+		out[symbol] = chans[symbol]
+	}
+
+	stream := newPublicStream("trade", symbols, func(message []byte) {
+		var trade WsTrade
+		if err := json.Unmarshal(message, &trade); err != nil {
+			if errHandler != nil {
+				errHandler(fmt.Errorf("unmarshal error: %w", err))
+			}
+			return
+		}
+		ch, ok := chans[trade.Code]
+		// the snapshot repeats the last trade before the subscription
+		if trade.Type != "trade" || trade.StreamType == "SNAPSHOT" || !ok {
+			return
+		}
+		// ask_bid is the taker side, ASK is a sell
+		side := core.OrderSideBuy
+		if trade.AskBid == "ASK" {
+			side = core.OrderSideSell
+		}
+		core.SendTrade(ctx, ch, core.Trade{
+			Symbol:   trade.Code,
+			TradeID:  strconv.FormatInt(trade.SequentialID, 10),
+			Price:    decimal.NewFromFloat(trade.TradePrice),
+			Quantity: decimal.NewFromFloat(trade.TradeVolume),
+			Side:     side,
+			Time:     time.UnixMilli(trade.TradeTimestamp),
+		})
+	}, errHandler)
+	if err := stream.Start(ctx); err != nil {
+		return nil, fmt.Errorf("websocket dial error: %w", err)
+	}
+
+	go func() {
+		<-stream.Done()
+		for _, ch := range chans {
+			close(ch)
+		}
+	}()
+
+	return out, nil
+}
@@ -81,6 +81,18 @@ type WsAsset struct {
 	StreamType     string        `json:"stream_type"`     // 스트림 타입 (예: "REALTIME")
 }
 
+type WsTrade struct {
+	Type           string  `json:"ty"`   // 타입: "trade"
+	Code           string  `json:"cd"`   // 마켓 코드 (ex. KRW-BTC)
+	TradePrice     float64 `json:"tp"`   // 체결 가격
+	TradeVolume    float64 `json:"tv"`   // 체결량
+	AskBid         string  `json:"ab"`   // 매수/매도 구분 ("ASK", "BID")
+	TradeTimestamp int64   `json:"ttms"` // 체결 타임스탬프 (millisecond)
+	SequentialID   int64   `json:"sid"`  // 체결 번호 (Unique)
+	Timestamp      int64   `json:"tms"`  // 타임스탬프 (millisecond)
+	StreamType     string  `json:"st"`   // 스트림 타입 (SNAPSHOT, REALTIME)
+}
+
 type WsOrderbookUnit struct {
 	AskPrice float64 `json:"ap"` // 매도 호가
 	BidPrice float64 `json:"bp"` // 매수 호가
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -214,21 +215,39 @@ func (pw *UpbitPrivateWS) convertWsOrderToEvent(wsOrder WsOrder) core.OrderEvent
 		side = "" // Invalid side
 	}
 
+	// on trade events price and volume are those of the fill, the order price is not sent
+	// and Price stays zero
+	price := decimal.NewFromFloat(wsOrder.Price)
+	quantity := decimal.NewFromFloat(wsOrder.Volume)
+	var lastQty, lastPrice, lastCommission decimal.Decimal
+	if wsOrder.State == "trade" {
+		lastQty = quantity
+		lastPrice = price
+		price = decimal.Zero
+		lastCommission = decimal.NewFromFloat(wsOrder.TradeFee)
+		quantity = decimal.NewFromFloat(wsOrder.ExecutedVolume).Add(decimal.NewFromFloat(wsOrder.RemainingVolume))
+	}
+	quote, _, _ := strings.Cut(wsOrder.Code, "-")
+
 	return core.OrderEvent{
 		OrderID:         wsOrder.UUID,
+		ClientOrderID:   wsOrder.Identifier,
 		Symbol:          wsOrder.Code,
 		Side:            side,
 		OrderType:       wsOrder.OrderType,
-		Status:          parseOrderStatus(wsOrder.State),
-		Price:           decimal.NewFromFloat(wsOrder.Price),
-		Quantity:        decimal.NewFromFloat(wsOrder.Volume),
+		Status:          parseOrderStatus(wsOrder.State, decimal.NewFromFloat(wsOrder.ExecutedVolume)),
+		Price:           price,
+		Quantity:        quantity,
 		ExecutedQty:     decimal.NewFromFloat(wsOrder.ExecutedVolume),
 		AvgPrice:        decimal.NewFromFloat(wsOrder.AvgPrice),
 		Commission:      decimal.NewFromFloat(wsOrder.PaidFee),
-		CommissionAsset: "KRW", // Upbit doesn't specify commission asset
+		CommissionAsset: quote, // fees are paid in the quote currency
 		UpdateTime:      time.Unix(0, wsOrder.Timestamp*int64(time.Millisecond)),
 		TradeID:         wsOrder.TradeUUID,
 		IsMaker:         wsOrder.IsMaker,
+		LastQty:         lastQty,
+		LastPrice:       lastPrice,
+		LastCommission:  lastCommission,
 	}
 }
 
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// AmendResult is the outcome of PrivateClient.AmendOrder
+type AmendResult struct {
+	Order           *OrderResponse // the amended order, or the new order when Replaced
+	OriginalOrderID string
+	Replaced        bool // the order was cancelled and placed again under a new order id
+	KeptPriority    bool // the order kept its place in the queue
+}
+
+// AmendKeepsPriority reports whether a native amend keeps queue priority. Exchanges keep it
+// when only the quantity is reduced, a new price or a larger quantity requeues the order.
+func AmendKeepsPriority(qty, price, newQty, newPrice decimal.Decimal) bool {
+	return newPrice.Equal(price) && newQty.LessThanOrEqual(qty)
+}
+
+// ValidateAmend checks the new values of an amend, both are the full new order values
+func ValidateAmend(newQty, newPrice decimal.Decimal) error {
+	if !newQty.IsPositive() || !newPrice.IsPositive() {
+		return fmt.Errorf("amend needs a positive quantity and price, got %s @ %s", newQty, newPrice)
+	}
+	return nil
+}
+
+// CancelReplace amends a limit order on exchanges without a native amend by cancelling it
+// and placing a new limit order for the unfilled rest of newQty at newPrice, with the time in
+// force and post-only flag of the order. The rest is taken from the order once the cancel is
+// final, so fills that came in while cancelling are not placed again. Queue priority is
+// always lost. When the cancel succeeds but the new order fails or nothing is left to place,
+// the result carries no Order and the error says so.
+func CancelReplace(c PrivateClient, symbol, orderID string, newQty, newPrice decimal.Decimal) (*AmendResult, error) {
+	if err := ValidateAmend(newQty, newPrice); err != nil {
+		return nil, err
+	}
+	ord, err := c.FetchOrder(symbol, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("amend %s: %w", orderID, err)
+	}
+	if !ord.Status.IsOpen() {
+		return nil, fmt.Errorf("amend %s: order is %s", orderID, ord.Status)
+	}
+	if !newQty.GreaterThan(ord.ExecutedQty) {
+		return nil, fmt.Errorf("amend %s: new quantity %s is not above the executed %s", orderID, newQty, ord.ExecutedQty)
+	}
+	if _, err := c.CancelOrder(symbol, orderID); err != nil {
+		return nil, fmt.Errorf("amend %s: %w", orderID, err)
+	}
+
+	result := &AmendResult{OriginalOrderID: orderID, Replaced: true}
+	final, err := fetchCancelled(c, symbol, orderID)
+	if err != nil {
+		return result, fmt.Errorf("amend %s: order was cancelled but its final fill is unknown, nothing was placed: %w", orderID, err)
+	}
+	rest := newQty.Sub(final.ExecutedQty)
+	if !rest.IsPositive() {
+		return result, fmt.Errorf("amend %s: order was cancelled after %s filled, nothing is left of %s", orderID, final.ExecutedQty, newQty)
+	}
+	req := OrderRequest{
+		Symbol:      symbol,
+		Side:        ord.Side,
+		Type:        OrderTypeLimit,
+		Quantity:    rest,
+		Price:       newPrice,
+		TimeInForce: ord.Tif,
+		PostOnly:    ord.PostOnly,
+	}
+	if req.TimeInForce == "" || req.PostOnly {
+		req.TimeInForce = TimeInForceGTC
+	}
+	newOrd, err := c.PlaceOrder(context.Background(), req)
+	if err != nil {
+		return result, fmt.Errorf("amend %s: order was cancelled but the replacement failed: %w", orderID, err)
+	}
+	result.Order = newOrd
+	return result, nil
+}
+
+// cancelSettleTime bounds the wait for a cancel to become final on exchanges that cancel
+// asynchronously
+const cancelSettleTime = 5 * time.Second
+
+// fetchCancelled fetches the order until it is no longer open after a cancel
+func fetchCancelled(c PrivateClient, symbol, orderID string) (*OrderResponseFull, error) {
+	deadline := time.Now().Add(cancelSettleTime)
+	backoff := NewBackoff(100*time.Millisecond, time.Second)
+	for {
+		ord, err := c.FetchOrder(symbol, orderID)
+		if err == nil && !ord.Status.IsOpen() {
+			return ord, nil
+		}
+		if !time.Now().Before(deadline) {
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("order is still %s", ord.Status)
+		}
+		time.Sleep(backoff.Next())
+	}
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+// cancelFillClient is a PrivateClient whose order fills executed more while it is cancelled,
+// the cancel becomes final on the second fetch after it
+type cancelFillClient struct {
+	PrivateClient
+	order     OrderResponseFull
+	cancelled bool
+	fetches   int
+	executed  decimal.Decimal
+	placed    []OrderRequest
+}
+
+func (c *cancelFillClient) FetchOrder(symbol, orderId string) (*OrderResponseFull, error) {
+	ord := c.order
+	if c.cancelled {
+		c.fetches++
+		ord.ExecutedQty = c.executed
+		if c.fetches > 1 {
+			ord.Status = OrderStatusCanceled
+		}
+	}
+	return &ord, nil
+}
+
+func (c *cancelFillClient) CancelOrder(symbol, orderId string) (*OrderResponse, error) {
+	c.cancelled = true
+	return &c.order.OrderResponse, nil
+}
+
+func (c *cancelFillClient) PlaceOrder(ctx context.Context, req OrderRequest) (*OrderResponse, error) {
+	c.placed = append(c.placed, req)
+	return &OrderResponse{OrderID: "2", Symbol: req.Symbol, Status: OrderStatusOpen}, nil
+}
+
+func TestCancelReplace(t *testing.T) {
+	open := OrderResponseFull{
+		OrderResponse: OrderResponse{OrderID: "1", Symbol: "BTCUSDT", Side: OrderSideBuy, Tif: TimeInForceGTC,
+			Status: OrderStatusPartiallyFilled, Price: d("100"), Quantity: d("5")},
+		ExecutedQty: d("1"),
+	}
+	tests := []struct {
+		name     string
+		executed string // when the cancel is final
+		newQty   string
+		wantQty  string // of the replacement, empty for none
+		wantErr  bool
+	}{
+		{name: "no fill while cancelling", executed: "1", newQty: "4", wantQty: "3"},
+		{name: "filled while cancelling", executed: "2.5", newQty: "4", wantQty: "1.5"},
+		{name: "filled up to the new quantity", executed: "4", newQty: "4", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &cancelFillClient{order: open, executed: d(tt.executed)}
+			result, err := CancelReplace(c, "BTCUSDT", "1", d(tt.newQty), d("101"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CancelReplace() error = %v, want error %v", err, tt.wantErr)
+			}
+			if result == nil || !result.Replaced || result.OriginalOrderID != "1" {
+				t.Fatalf("CancelReplace() = %+v", result)
+			}
+			if tt.wantQty == "" {
+				if len(c.placed) != 0 || result.Order != nil {
+					t.Errorf("placed %+v", c.placed)
+				}
+				return
+			}
+			if len(c.placed) != 1 || !c.placed[0].Quantity.Equal(d(tt.wantQty)) || !c.placed[0].Price.Equal(d("101")) {
+				t.Fatalf("placed %+v, want %s @ 101", c.placed, tt.wantQty)
+			}
+			if result.Order == nil || result.Order.OrderID != "2" {
+				t.Errorf("Order = %+v", result.Order)
+			}
+		})
+	}
+}
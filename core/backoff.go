@@ -0,0 +1,35 @@
+package core
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Backoff computes exponential reconnect delays with full jitter.
+type Backoff struct {
+	Min     time.Duration
+	Max     time.Duration
+	attempt int
+}
+
+func NewBackoff(min, max time.Duration) *Backoff {
+	return &Backoff{Min: min, Max: max}
+}
+
+// Next returns the delay before the next attempt, a random duration in [Min, min(Max, Min*2^attempt)]
+func (b *Backoff) Next() time.Duration {
+	ceil := b.Min << uint(b.attempt)
+	if ceil <= 0 || ceil > b.Max {
+		ceil = b.Max
+	} else {
+		b.attempt++
+	}
+	if ceil <= b.Min {
+		return b.Min
+	}
+	return b.Min + time.Duration(rand.Int63n(int64(ceil-b.Min)))
+}
+
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BatchConcurrency bounds the requests in flight of a batch sent as single requests
+const BatchConcurrency = 5
+
+// OrderResult is the outcome of one order of a batch, Order is nil when Err is set
+type OrderResult struct {
+	Order *OrderResponse
+	Err   error
+}
+
+// BatchError is returned by PlaceOrders and CancelOrders when some orders of a batch
+// failed, the results tell which
+type BatchError struct {
+	Failed int
+	Total  int
+	First  error // error of the first failed order
+}
+
+func (e *BatchError) Error() string {
+	return fmt.Sprintf("%d of %d orders failed, first: %v", e.Failed, e.Total, e.First)
+}
+
+// BatchErr returns a *BatchError for the failed results, nil when all succeeded
+func BatchErr(results []OrderResult) error {
+	var batchErr *BatchError
+	for _, res := range results {
+		if res.Err == nil {
+			continue
+		}
+		if batchErr == nil {
+			batchErr = &BatchError{Total: len(results), First: res.Err}
+		}
+		batchErr.Failed++
+	}
+	if batchErr == nil {
+		return nil
+	}
+	return batchErr
+}
+
+// PlaceBatchFunc places prepared orders in one native batch request and returns a result
+// for each of them
+type PlaceBatchFunc func(ctx context.Context, reqs []OrderRequest) []OrderResult
+
+// CancelBatchFunc cancels orders in one native batch request and returns a result for each
+// of them
+type CancelBatchFunc func(ctx context.Context, orderIds []string) []OrderResult
+
+// PlaceBatches prepares reqs and places them in batches of at most size orders, one after
+// the other. Orders failing Prepare are not sent. Results are in the order of reqs.
+func PlaceBatches(ctx context.Context, reqs []OrderRequest, size int, place PlaceBatchFunc) ([]OrderResult, error) {
+	return placeBatches(ctx, reqs, size, (*OrderRequest).Prepare, place)
+}
+
+// placeBatches is PlaceBatches with every order prepared by prepare
+func placeBatches(ctx context.Context, reqs []OrderRequest, size int, prepare func(req *OrderRequest) error, place PlaceBatchFunc) ([]OrderResult, error) {
+	results := make([]OrderResult, len(reqs))
+	prepared := make([]OrderRequest, 0, len(reqs))
+	index := make([]int, 0, len(reqs))
+	for i, req := range reqs {
+		if err := prepare(&req); err != nil {
+			results[i].Err = err
+			continue
+		}
+		prepared = append(prepared, req)
+		index = append(index, i)
+	}
+	for start := 0; start < len(prepared); start += size {
+		end := min(start+size, len(prepared))
+		var batch []OrderResult
+		if err := ctx.Err(); err != nil {
+			batch = FailBatch(end-start, err) // not sent
+		} else {
+			batch = place(ctx, prepared[start:end])
+		}
+		for j, res := range batch {
+			results[index[start+j]] = res
+		}
+	}
+	return results, BatchErr(results)
+}
+
+// CancelBatches cancels orderIds in batches of at most size orders, one after the other
+func CancelBatches(ctx context.Context, orderIds []string, size int, cancel CancelBatchFunc) ([]OrderResult, error) {
+	results := make([]OrderResult, 0, len(orderIds))
+	for start := 0; start < len(orderIds); start += size {
+		end := min(start+size, len(orderIds))
+		if err := ctx.Err(); err != nil {
+			results = append(results, FailBatch(end-start, err)...)
+			continue
+		}
+		results = append(results, cancel(ctx, orderIds[start:end])...)
+	}
+	return results, BatchErr(results)
+}
+
+// PlaceEach places reqs as single orders with at most BatchConcurrency in flight, for
+// exchanges without batch orders
+func PlaceEach(ctx context.Context, reqs []OrderRequest, place func(ctx context.Context, req OrderRequest) (*OrderResponse, error)) ([]OrderResult, error) {
+	results := forEachOrder(ctx, len(reqs), func(i int) (*OrderResponse, error) {
+		return place(ctx, reqs[i])
+	})
+	return results, BatchErr(results)
+}
+
+// CancelEach cancels orderIds one by one with at most BatchConcurrency in flight
+func CancelEach(ctx context.Context, orderIds []string, cancel func(orderId string) (*OrderResponse, error)) ([]OrderResult, error) {
+	results := forEachOrder(ctx, len(orderIds), func(i int) (*OrderResponse, error) {
+		return cancel(orderIds[i])
+	})
+	return results, BatchErr(results)
+}
+
+// ResolveBatch resolves the orders of a batch whose request failed, see ResolvePlacement
+func ResolveBatch(c ClientOrderFetcher, reqs []OrderRequest, placeErr error) []OrderResult {
+	if !PlacementUncertain(placeErr) {
+		return FailBatch(len(reqs), placeErr)
+	}
+	return forEachOrder(context.Background(), len(reqs), func(i int) (*OrderResponse, error) {
+		return ResolvePlacement(c, reqs[i], placeErr)
+	})
+}
+
+// forEachOrder runs fn for 0..n-1 with at most BatchConcurrency in flight. Calls that
+// have not started when ctx is done fail with its error.
+func forEachOrder(ctx context.Context, n int, fn func(i int) (*OrderResponse, error)) []OrderResult {
+	results := make([]OrderResult, n)
+	sem := make(chan struct{}, BatchConcurrency)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+			continue
+		}
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[i].Order, results[i].Err = fn(i)
+		}(i)
+	}
+	wg.Wait()
+	return results
+}
+
+// FailBatch returns n results failed with err, for a batch whose request failed
+func FailBatch(n int, err error) []OrderResult {
+	results := make([]OrderResult, n)
+	for i := range results {
+		results[i].Err = err
+	}
+	return results
+}
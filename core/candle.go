@@ -0,0 +1,298 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// CandleInterval is the exchange independent bar size, adapters map it to their own enum
+type CandleInterval string
+
+const (
+	CandleInterval1m  CandleInterval = "1m"
+	CandleInterval3m  CandleInterval = "3m"
+	CandleInterval5m  CandleInterval = "5m"
+	CandleInterval15m CandleInterval = "15m"
+	CandleInterval30m CandleInterval = "30m"
+	CandleInterval1h  CandleInterval = "1h"
+	CandleInterval2h  CandleInterval = "2h"
+	CandleInterval4h  CandleInterval = "4h"
+	CandleInterval6h  CandleInterval = "6h"
+	CandleInterval12h CandleInterval = "12h"
+	CandleInterval1d  CandleInterval = "1d"
+	CandleInterval1w  CandleInterval = "1w"
+	CandleInterval1M  CandleInterval = "1M"
+)
+
+// Duration returns the length of a bar, a month counts as 30 days
+func (i CandleInterval) Duration() time.Duration {
+	switch i {
+	case CandleInterval1m:
+		return time.Minute
+	case CandleInterval3m:
+		return 3 * time.Minute
+	case CandleInterval5m:
+		return 5 * time.Minute
+	case CandleInterval15m:
+		return 15 * time.Minute
+	case CandleInterval30m:
+		return 30 * time.Minute
+	case CandleInterval1h:
+		return time.Hour
+	case CandleInterval2h:
+		return 2 * time.Hour
+	case CandleInterval4h:
+		return 4 * time.Hour
+	case CandleInterval6h:
+		return 6 * time.Hour
+	case CandleInterval12h:
+		return 12 * time.Hour
+	case CandleInterval1d:
+		return 24 * time.Hour
+	case CandleInterval1w:
+		return 7 * 24 * time.Hour
+	case CandleInterval1M:
+		return 30 * 24 * time.Hour
+	}
+	return 0
+}
+
+// Truncate returns the open time of the bar containing t. Bars are aligned in UTC,
+// weeks start on Monday and months on the first day.
+func (i CandleInterval) Truncate(t time.Time) time.Time {
+	t = t.UTC()
+	if i == CandleInterval1M {
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	// Truncate counts from January 1 of year 1, which is a Monday
+	return t.Truncate(i.Duration())
+}
+
+// end returns the open time of the bar following the one opened at open
+func (i CandleInterval) end(open time.Time) time.Time {
+	if i == CandleInterval1M {
+		return open.AddDate(0, 1, 0)
+	}
+	return open.Add(i.Duration())
+}
+
+// Candle is an OHLCV bar, OpenTime is the start of the bar
+type Candle struct {
+	Symbol      string
+	Interval    CandleInterval
+	OpenTime    time.Time
+	CloseTime   time.Time
+	Open        decimal.Decimal
+	High        decimal.Decimal
+	Low         decimal.Decimal
+	Close       decimal.Decimal
+	Volume      decimal.Decimal // base asset volume
+	QuoteVolume decimal.Decimal
+	TradeCount  int64 // zero when the exchange does not report it
+	Closed      bool  // false while the bar is still in progress
+}
+
+// ErrUnsupportedInterval is returned for an interval the exchange has no candles for
+func ErrUnsupportedInterval(exchange string, interval CandleInterval) error {
+	return fmt.Errorf("%s: unsupported candle interval %q", exchange, interval)
+}
+
+// CandlePageFunc fetches the most recent candles, at most limit, with an open time in
+// [start, end]. start is zero for no lower bound, the order of the result is free.
+type CandlePageFunc func(start, end time.Time, limit int) ([]Candle, error)
+
+// PageCandles fetches candles in [start, end] oldest first by paging backwards from end
+// with at most pageLimit candles per request. A zero end means now, a zero start means no
+// lower bound. When limit is positive only the most recent limit candles are returned.
+func PageCandles(start, end time.Time, limit, pageLimit int, page CandlePageFunc) ([]Candle, error) {
+	if end.IsZero() {
+		end = time.Now()
+	}
+	if start.IsZero() && limit <= 0 {
+		return nil, fmt.Errorf("candles: either a start time or a limit is required")
+	}
+	var out []Candle
+	now := time.Now()
+	cursor := end
+	for limit <= 0 || len(out) < limit {
+		n := pageLimit
+		if limit > 0 && limit-len(out) < n {
+			n = limit - len(out)
+		}
+		candles, err := page(start, cursor, n)
+		if err != nil {
+			return nil, err
+		}
+		oldest := cursor
+		got := 0
+		for _, c := range candles {
+			if c.OpenTime.After(cursor) || (!start.IsZero() && c.OpenTime.Before(start)) {
+				continue
+			}
+			c.Closed = !c.Interval.end(c.OpenTime).After(now)
+			out = append(out, c)
+			got++
+			if c.OpenTime.Before(oldest) {
+				oldest = c.OpenTime
+			}
+		}
+		if got == 0 || got < n || (!start.IsZero() && !oldest.After(start)) {
+			break
+		}
+		cursor = oldest.Add(-time.Millisecond)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].OpenTime.Before(out[j].OpenTime) })
+	if limit > 0 && len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+	return out, nil
+}
+
+// SendCandle pushes a bar update to ch. In progress updates are dropped when the consumer
+// is behind, closed bars are never dropped and block until received or ctx is done.
+func SendCandle(ctx context.Context, ch chan Candle, c Candle) {
+	if !c.Closed {
+		select {
+		case ch <- c:
+		default:
+		}
+		return
+	}
+	select {
+	case ch <- c:
+	case <-ctx.Done():
+	}
+}
+
+// candleCloseGrace keeps a bar open after its end for late trades and updates
+const candleCloseGrace = 2 * time.Second
+
+// CandleBuilder tracks the current bar of one symbol. It aggregates trades for exchanges
+// without a kline stream and detects bar closes for exchanges that do not flag them.
+type CandleBuilder struct {
+	symbol   string
+	interval CandleInterval
+
+	mu          sync.Mutex
+	current     *Candle
+	closedUntil time.Time // updates for bars opened before are late and dropped
+}
+
+func NewCandleBuilder(symbol string, interval CandleInterval) *CandleBuilder {
+	return &CandleBuilder{symbol: symbol, interval: interval}
+}
+
+// AddTrade adds a trade to its bar and returns the updates to emit, a bar closed by the
+// trade comes first
+func (b *CandleBuilder) AddTrade(price, qty decimal.Decimal, t time.Time) []Candle {
+	open := b.interval.Truncate(t)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if open.Before(b.closedUntil) {
+		return nil
+	}
+	var out []Candle
+	if b.current != nil {
+		if open.Before(b.current.OpenTime) {
+			return nil
+		}
+		if open.After(b.current.OpenTime) {
+			out = append(out, b.closeCurrent())
+		}
+	}
+	if b.current == nil {
+		b.current = &Candle{
+			Symbol:      b.symbol,
+			Interval:    b.interval,
+			OpenTime:    open,
+			CloseTime:   b.interval.end(open).Add(-time.Millisecond),
+			Open:        price,
+			High:        price,
+			Low:         price,
+			Close:       price,
+			Volume:      decimal.Zero,
+			QuoteVolume: decimal.Zero,
+		}
+	}
+	c := b.current
+	if price.GreaterThan(c.High) {
+		c.High = price
+	}
+	if price.LessThan(c.Low) {
+		c.Low = price
+	}
+	c.Close = price
+	c.Volume = c.Volume.Add(qty)
+	c.QuoteVolume = c.QuoteVolume.Add(price.Mul(qty))
+	c.TradeCount++
+	return append(out, *c)
+}
+
+// Update replaces the current bar with a snapshot from the exchange and returns the
+// updates to emit, the previous bar is closed first when c opens a new one
+func (b *CandleBuilder) Update(c Candle) []Candle {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if c.OpenTime.Before(b.closedUntil) {
+		return nil
+	}
+	var out []Candle
+	if b.current != nil {
+		if c.OpenTime.Before(b.current.OpenTime) {
+			return nil
+		}
+		if c.OpenTime.After(b.current.OpenTime) {
+			out = append(out, b.closeCurrent())
+		}
+	}
+	if c.Closed {
+		b.current = nil
+		b.closedUntil = b.interval.end(c.OpenTime)
+	} else {
+		b.current = &c
+	}
+	return append(out, c)
+}
+
+// CloseDue closes the current bar once it ended before now, so that quiet markets
+// without a following update still see the close
+func (b *CandleBuilder) CloseDue(now time.Time) (Candle, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.current == nil || b.interval.end(b.current.OpenTime).After(now) {
+		return Candle{}, false
+	}
+	return b.closeCurrent(), true
+}
+
+func (b *CandleBuilder) closeCurrent() Candle {
+	c := *b.current
+	c.Closed = true
+	b.closedUntil = b.interval.end(c.OpenTime)
+	b.current = nil
+	return c
+}
+
+// WatchCandleCloses closes the bars of the builders once they are over and passes them
+// to emit, it returns when done is closed
+func WatchCandleCloses(done <-chan struct{}, builders []*CandleBuilder, emit func(c Candle)) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case now := <-ticker.C:
+			for _, b := range builders {
+				if c, ok := b.CloseDue(now.Add(-candleCloseGrace)); ok {
+					emit(c)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type ConditionalType string
+
+const (
+	ConditionalStopLoss     ConditionalType = "STOP_LOSS"
+	ConditionalTakeProfit   ConditionalType = "TAKE_PROFIT"
+	ConditionalTrailingStop ConditionalType = "TRAILING_STOP"
+)
+
+// TriggerSource is the price a conditional order is triggered by
+type TriggerSource string
+
+const (
+	TriggerSourceLast  TriggerSource = "LAST" // last traded price, the default
+	TriggerSourceMark  TriggerSource = "MARK"
+	TriggerSourceIndex TriggerSource = "INDEX"
+)
+
+// ConditionalOrderRequest describes a stop-loss, take-profit or trailing stop order.
+// Stop-loss sells and take-profit buys trigger when the price falls to TriggerPrice,
+// stop-loss buys and take-profit sells when it rises to it. A trailing sell triggers when
+// the price falls TrailingDelta percent below its high since activation, a trailing buy
+// when it rises that far above its low. TriggerPrice is the optional activation price of
+// a trailing stop, tracking starts right away without it.
+type ConditionalOrderRequest struct {
+	Symbol        string
+	Side          OrderSide
+	Type          ConditionalType
+	Quantity      decimal.Decimal // base quantity
+	TriggerPrice  decimal.Decimal
+	LimitPrice    decimal.Decimal // zero places a market order on trigger
+	TimeInForce   TimeInForce     // of the limit order, GTC when empty
+	TrailingDelta decimal.Decimal // percent, 1 for 1%
+	ReduceOnly    bool            // ignored on spot where there is no position to reduce
+	TriggerSource TriggerSource   // LAST when empty
+
+	// ErrHandler receives the failures of an emulated trigger: trade stream errors, the
+	// stream closing before the trigger and a failed placement. Unused by native orders.
+	ErrHandler func(err error)
+}
+
+// ConditionalOrderClient places conditional orders, natively where the exchange supports
+// the request and with an emulated trigger otherwise
+type ConditionalOrderClient interface {
+	PrivateClient
+	PlaceConditional(req ConditionalOrderRequest) (*OrderResponse, error)
+	// CancelConditional cancels a conditional order that has not triggered yet
+	CancelConditional(symbol, orderId string) error
+}
+
+// Validate checks a request is complete and consistent
+func (r ConditionalOrderRequest) Validate() error {
+	if r.Symbol == "" {
+		return fmt.Errorf("conditional order needs a symbol")
+	}
+	if r.Side != OrderSideBuy && r.Side != OrderSideSell {
+		return fmt.Errorf("invalid conditional order side %q", r.Side)
+	}
+	if !r.Quantity.IsPositive() {
+		return fmt.Errorf("conditional order needs a positive quantity, got %s", r.Quantity)
+	}
+	if r.LimitPrice.IsNegative() {
+		return fmt.Errorf("invalid limit price %s", r.LimitPrice)
+	}
+	switch r.Type {
+	case ConditionalStopLoss, ConditionalTakeProfit:
+		if !r.TriggerPrice.IsPositive() {
+			return fmt.Errorf("%s order needs a positive trigger price, got %s", r.Type, r.TriggerPrice)
+		}
+		if !r.TrailingDelta.IsZero() {
+			return fmt.Errorf("trailing delta is only valid for %s orders", ConditionalTrailingStop)
+		}
+	case ConditionalTrailingStop:
+		if !r.TrailingDelta.IsPositive() || r.TrailingDelta.GreaterThanOrEqual(decimal.NewFromInt(100)) {
+			return fmt.Errorf("trailing delta must be a percent in (0, 100), got %s", r.TrailingDelta)
+		}
+		if r.TriggerPrice.IsNegative() {
+			return fmt.Errorf("invalid activation price %s", r.TriggerPrice)
+		}
+	default:
+		return fmt.Errorf("invalid conditional order type %q", r.Type)
+	}
+	switch r.TriggerSource {
+	case "", TriggerSourceLast, TriggerSourceMark, TriggerSourceIndex:
+	default:
+		return fmt.Errorf("invalid trigger source %q", r.TriggerSource)
+	}
+	return nil
+}
+
+// TriggersOnRise reports whether the order triggers when the price rises to TriggerPrice.
+// For a trailing stop it is the direction of the move that arms it.
+func (r ConditionalOrderRequest) TriggersOnRise() bool {
+	switch r.Type {
+	case ConditionalStopLoss:
+		return r.Side == OrderSideBuy
+	case ConditionalTakeProfit:
+		return r.Side == OrderSideSell
+	default:
+		// a trailing sell is armed at or above its activation price
+		return r.Side == OrderSideSell
+	}
+}
+
+// Source returns the trigger source, LAST when unset
+func (r ConditionalOrderRequest) Source() TriggerSource {
+	if r.TriggerSource == "" {
+		return TriggerSourceLast
+	}
+	return r.TriggerSource
+}
+
+// ErrUnsupportedTriggerSource is returned for a trigger source the exchange cannot use
+func ErrUnsupportedTriggerSource(exchange string, source TriggerSource) error {
+	return fmt.Errorf("%s: unsupported trigger source %q", exchange, source)
+}
+
+// emulatedOrderPrefix marks order ids of emulated conditionals
+const emulatedOrderPrefix = "emu-"
+
+var emulatedOrderSeq int64
+
+// emulatedRetention is how long Triggered still answers for a conditional that triggered
+// or whose trade stream closed
+const emulatedRetention = time.Hour
+
+// IsEmulatedOrderID reports whether orderId belongs to an emulated conditional
+func IsEmulatedOrderID(orderId string) bool {
+	return strings.HasPrefix(orderId, emulatedOrderPrefix)
+}
+
+// ConditionalPlaceFunc places the order of a triggered emulated conditional, last is the
+// price that triggered it
+type ConditionalPlaceFunc func(req ConditionalOrderRequest, last decimal.Decimal) (*OrderResponse, error)
+
+// PlaceTriggered returns a ConditionalPlaceFunc placing plain limit and market orders.
+// Market buys are sized in quote at the triggering price. ReduceOnly is not passed on,
+// adapters that can honour it supply their own func.
+func PlaceTriggered(c PrivateClient) ConditionalPlaceFunc {
+	return func(req ConditionalOrderRequest, last decimal.Decimal) (*OrderResponse, error) {
+		if req.LimitPrice.IsPositive() {
+			tif := string(req.TimeInForce)
+			if tif == "" {
+				tif = string(TimeInForceGTC)
+			}
+			if req.Side == OrderSideBuy {
+				return c.LimitBuy(req.Symbol, req.Quantity, req.LimitPrice, tif)
+			}
+			return c.LimitSell(req.Symbol, req.Quantity, req.LimitPrice, tif)
+		}
+		if req.Side == OrderSideBuy {
+			return c.MarketBuy(req.Symbol, req.Quantity.Mul(last))
+		}
+		return c.MarketSell(req.Symbol, req.Quantity)
+	}
+}
+
+// ConditionalEmulator triggers conditional orders locally from the public trade stream,
+// for exchanges or order kinds without a native trigger. Only the last price is
+// available, and a trigger is only as reliable as the process and its connection.
+type ConditionalEmulator struct {
+	client PublicClient
+	place  ConditionalPlaceFunc
+
+	mu     sync.Mutex
+	orders map[string]*emulatedConditional
+}
+
+type emulatedConditional struct {
+	req    ConditionalOrderRequest
+	cancel context.CancelFunc
+
+	armed   bool
+	extreme decimal.Decimal // high of a trailing sell, low of a trailing buy
+
+	triggered bool
+	order     *OrderResponse
+	err       error
+	done      time.Time // triggered or stopped watching, zero while pending
+}
+
+func NewConditionalEmulator(client PublicClient, place ConditionalPlaceFunc) *ConditionalEmulator {
+	return &ConditionalEmulator{
+		client: client,
+		place:  place,
+		orders: make(map[string]*emulatedConditional),
+	}
+}
+
+// Place starts watching the trade stream for req and returns a pending order with an
+// emulated order id
+func (e *ConditionalEmulator) Place(req ConditionalOrderRequest) (*OrderResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.Source() != TriggerSourceLast {
+		return nil, ErrUnsupportedTriggerSource("emulated", req.Source())
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	order := &emulatedConditional{
+		req:    req,
+		cancel: cancel,
+		armed:  req.Type != ConditionalTrailingStop || req.TriggerPrice.IsZero(),
+	}
+	id := emulatedOrderPrefix + strconv.FormatInt(atomic.AddInt64(&emulatedOrderSeq, 1), 10) +
+		"-" + strconv.FormatInt(time.Now().UnixMilli(), 10)
+
+	report := func(err error) {
+		if req.ErrHandler != nil {
+			req.ErrHandler(err)
+		}
+	}
+	trades, err := e.client.SubscribeTrades(ctx, []string{req.Symbol}, func(err error) {
+		report(fmt.Errorf("emulated conditional %s trade stream: %w", id, err))
+	})
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to watch %s for emulated conditional: %w", req.Symbol, err)
+	}
+	e.mu.Lock()
+	e.prune()
+	e.orders[id] = order
+	e.mu.Unlock()
+
+	go func() {
+		defer cancel()
+		for trade := range trades[req.Symbol] {
+			if !order.hit(trade.Price) {
+				continue
+			}
+			e.mu.Lock()
+			if _, live := e.orders[id]; !live {
+				e.mu.Unlock()
+				return // cancelled meanwhile
+			}
+			order.triggered = true
+			e.mu.Unlock()
+
+			cancel() // stop watching before placing, an order must never be placed twice
+			resp, err := e.place(req, trade.Price)
+			e.mu.Lock()
+			order.order, order.err, order.done = resp, err, time.Now()
+			e.mu.Unlock()
+			if err != nil {
+				report(fmt.Errorf("emulated conditional %s triggered at %s but placing failed: %w", id, trade.Price, err))
+			}
+			return
+		}
+		if ctx.Err() != nil {
+			return // cancelled
+		}
+		err := fmt.Errorf("emulated conditional %s: %s trade stream closed before the trigger", id, req.Symbol)
+		e.mu.Lock()
+		order.err, order.done = err, time.Now()
+		e.mu.Unlock()
+		report(err)
+	}()
+
+	tif := req.TimeInForce
+	if tif == "" {
+		tif = TimeInForceGTC
+	}
+	return &OrderResponse{
+		OrderID:    id,
+		Symbol:     req.Symbol,
+		Side:       req.Side,
+		Tif:        tif,
+		Status:     OrderStatusOpen,
+		Price:      req.LimitPrice,
+		Quantity:   req.Quantity,
+		CreateTime: time.Now(),
+	}, nil
+}
+
+// hit feeds a trade price and reports whether the order triggers
+func (o *emulatedConditional) hit(price decimal.Decimal) bool {
+	req := o.req
+	reached := price.LessThanOrEqual(req.TriggerPrice)
+	if req.TriggersOnRise() {
+		reached = price.GreaterThanOrEqual(req.TriggerPrice)
+	}
+	if req.Type != ConditionalTrailingStop {
+		return reached
+	}
+
+	if !o.armed {
+		if !reached {
+			return false
+		}
+		o.armed = true
+	}
+	ratio := req.TrailingDelta.Div(decimal.NewFromInt(100))
+	if req.Side == OrderSideSell {
+		if o.extreme.IsZero() || price.GreaterThan(o.extreme) {
+			o.extreme = price
+		}
+		return price.LessThanOrEqual(o.extreme.Mul(decimal.NewFromInt(1).Sub(ratio)))
+	}
+	if o.extreme.IsZero() || price.LessThan(o.extreme) {
+		o.extreme = price
+	}
+	return price.GreaterThanOrEqual(o.extreme.Mul(decimal.NewFromInt(1).Add(ratio)))
+}
+
+// Cancel stops an emulated conditional that has not triggered yet
+func (e *ConditionalEmulator) Cancel(orderId string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	order, ok := e.orders[orderId]
+	if !ok {
+		return fmt.Errorf("emulated conditional %s not found", orderId)
+	}
+	if order.triggered {
+		return fmt.Errorf("emulated conditional %s already triggered", orderId)
+	}
+	order.cancel()
+	delete(e.orders, orderId)
+	return nil
+}
+
+// prune forgets the conditionals done for longer than emulatedRetention, e.mu is held
+func (e *ConditionalEmulator) prune() {
+	for id, order := range e.orders {
+		if !order.done.IsZero() && time.Since(order.done) > emulatedRetention {
+			delete(e.orders, id)
+		}
+	}
+}
+
+// Triggered returns the order placed by an emulated conditional, nil while it is pending,
+// and the error if placing it failed or its trade stream closed. It is answered for an
+// hour after that.
+func (e *ConditionalEmulator) Triggered(orderId string) (*OrderResponse, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	order, ok := e.orders[orderId]
+	if !ok {
+		return nil, fmt.Errorf("emulated conditional %s not found", orderId)
+	}
+	return order.order, order.err
+}
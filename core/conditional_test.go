@@ -0,0 +1,76 @@
+package core
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// tradeFeed is a PublicClient streaming the trades sent to ch
+type tradeFeed struct {
+	PublicClient
+	ch chan Trade
+}
+
+func (f tradeFeed) SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan Trade, error) {
+	return map[string]<-chan Trade{symbols[0]: f.ch}, nil
+}
+
+func TestConditionalEmulator(t *testing.T) {
+	feed := tradeFeed{ch: make(chan Trade)}
+	placed := make(chan decimal.Decimal, 1)
+	emulator := NewConditionalEmulator(feed, func(req ConditionalOrderRequest, last decimal.Decimal) (*OrderResponse, error) {
+		placed <- last
+		return &OrderResponse{OrderID: "1", Status: OrderStatusFilled}, nil
+	})
+
+	stop, err := emulator.Place(ConditionalOrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: ConditionalStopLoss,
+		Quantity: d("1"), TriggerPrice: d("90")})
+	if err != nil {
+		t.Fatalf("Place() error = %v", err)
+	}
+	feed.ch <- Trade{Symbol: "BTCUSDT", Price: d("95")}
+	feed.ch <- Trade{Symbol: "BTCUSDT", Price: d("89")}
+	select {
+	case last := <-placed:
+		if !last.Equal(d("89")) {
+			t.Errorf("placed at %s, want 89", last)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("not triggered")
+	}
+	// the result is stored once placing returned
+	var order *OrderResponse
+	for deadline := time.Now().Add(time.Second); order == nil && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		order, err = emulator.Triggered(stop.OrderID)
+	}
+	if err != nil || order == nil || order.OrderID != "1" {
+		t.Errorf("Triggered() = %+v, %v", order, err)
+	}
+}
+
+func TestConditionalEmulatorStreamClosed(t *testing.T) {
+	feed := tradeFeed{ch: make(chan Trade)}
+	errs := make(chan error, 1)
+	emulator := NewConditionalEmulator(feed, func(req ConditionalOrderRequest, last decimal.Decimal) (*OrderResponse, error) {
+		t.Error("placed without a trigger")
+		return nil, nil
+	})
+
+	stop, err := emulator.Place(ConditionalOrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: ConditionalStopLoss,
+		Quantity: d("1"), TriggerPrice: d("90"), ErrHandler: func(err error) { errs <- err }})
+	if err != nil {
+		t.Fatalf("Place() error = %v", err)
+	}
+	close(feed.ch)
+	select {
+	case <-errs:
+	case <-time.After(time.Second):
+		t.Fatal("stream closure not reported")
+	}
+	if _, err := emulator.Triggered(stop.OrderID); err == nil {
+		t.Error("Triggered() after the stream closed has no error")
+	}
+}
@@ -1,6 +1,12 @@
 package core
 
-import "github.com/pkg/errors"
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
 
 type temporary interface {
 	Temporary() bool
@@ -11,10 +17,155 @@ func IsTemporary(err error) bool {
 	return ok && te.Temporary()
 }
 
+// OrderAmountTooSmall is implemented by the errors of orders below a minimum of their market,
+// MinAmount is that minimum
 type OrderAmountTooSmall interface {
 	MinAmount() float64
 }
 
+// OrderTooSmallError is returned by ValidateOrder for an order below the min quantity or the
+// min notional of its market
+type OrderTooSmallError struct {
+	Symbol   string
+	Notional bool            // the order value is too small, else its quantity
+	Amount   decimal.Decimal // quantity or value of the order
+	Min      decimal.Decimal
+}
+
+func (e *OrderTooSmallError) Error() string {
+	if e.Notional {
+		return fmt.Sprintf("%s: order value %s is below the min notional %s", e.Symbol, e.Amount, e.Min)
+	}
+	return fmt.Sprintf("%s: quantity %s is below the min quantity %s", e.Symbol, e.Amount, e.Min)
+}
+
+// MinAmount implements OrderAmountTooSmall, in the quote asset for a notional and in the base
+// asset for a quantity
+func (e *OrderTooSmallError) MinAmount() float64 {
+	return e.Min.InexactFloat64()
+}
+
+// ErrorCategory classifies exchange errors independently of the exchange
+type ErrorCategory string
+
+const (
+	ErrCategoryUnknown             ErrorCategory = "UNKNOWN"
+	ErrCategoryInsufficientBalance ErrorCategory = "INSUFFICIENT_BALANCE"
+	ErrCategoryInvalidSymbol       ErrorCategory = "INVALID_SYMBOL"
+	ErrCategoryMinNotional         ErrorCategory = "MIN_NOTIONAL"
+	ErrCategoryPriceFilter         ErrorCategory = "PRICE_FILTER"
+	ErrCategoryRateLimited         ErrorCategory = "RATE_LIMITED"
+	ErrCategoryOrderNotFound       ErrorCategory = "ORDER_NOT_FOUND"
+	ErrCategoryPostOnlyRejected    ErrorCategory = "POST_ONLY_REJECTED"
+	ErrCategoryAuthFailed          ErrorCategory = "AUTH_FAILED"
+	ErrCategoryMaintenance         ErrorCategory = "MAINTENANCE"
+	// ErrCategoryExecutionUnknown is an answer that leaves open whether the request was
+	// executed, e.g. a timeout behind the gateway or a 5xx
+	ErrCategoryExecutionUnknown ErrorCategory = "EXECUTION_UNKNOWN"
+)
+
+// ExchangeError is an error returned by an exchange, every adapter maps its native errors into it.
+// Use errors.As to inspect the category, Code and Message keep the raw exchange values.
+type ExchangeError struct {
+	Exchange string
+	Category ErrorCategory
+	Code     string
+	Message  string
+	Status   int // HTTP status of the answer, zero when not known
+	// Min is the minimum a MinNotional reject fell below, zero when the exchange did not tell
+	Min decimal.Decimal
+}
+
+func NewExchangeError(exchange string, category ErrorCategory, code, message string) *ExchangeError {
+	return &ExchangeError{
+		Exchange: exchange,
+		Category: category,
+		Code:     code,
+		Message:  message,
+	}
+}
+
+// WithStatus sets the HTTP status of the answer and returns e
+func (e *ExchangeError) WithStatus(status int) *ExchangeError {
+	e.Status = status
+	return e
+}
+
+func (e *ExchangeError) Error() string {
+	return fmt.Sprintf("%s error %s (%s): %s", e.Exchange, e.Code, e.Category, e.Message)
+}
+
+// Temporary reports whether the request may succeed when retried later
+func (e *ExchangeError) Temporary() bool {
+	return e.Category == ErrCategoryRateLimited || e.Category == ErrCategoryMaintenance
+}
+
+// Is matches ErrApiTooMany for rate limit errors
+func (e *ExchangeError) Is(target error) bool {
+	return target == ErrApiTooMany && e.Category == ErrCategoryRateLimited
+}
+
+// As finds a MinNotional reject as an OrderAmountTooSmall, other categories are not one
+func (e *ExchangeError) As(target any) bool {
+	if t, ok := target.(*OrderAmountTooSmall); ok && e.Category == ErrCategoryMinNotional {
+		*t = minNotionalError{e}
+		return true
+	}
+	return false
+}
+
+// minNotionalError is a MinNotional reject as an OrderAmountTooSmall
+type minNotionalError struct {
+	*ExchangeError
+}
+
+// MinAmount implements OrderAmountTooSmall, zero when the exchange did not tell the minimum
+func (e minNotionalError) MinAmount() float64 {
+	return e.Min.InexactFloat64()
+}
+
+// ErrorCategoryOf returns the category of the ExchangeError in err's chain, ErrCategoryUnknown if none.
+// An OrderTooSmallError is ErrCategoryMinNotional like the rejects of the exchanges.
+func ErrorCategoryOf(err error) ErrorCategory {
+	var exErr *ExchangeError
+	if errors.As(err, &exErr) {
+		return exErr.Category
+	}
+	var tooSmall *OrderTooSmallError
+	if errors.As(err, &tooSmall) {
+		return ErrCategoryMinNotional
+	}
+	return ErrCategoryUnknown
+}
+
+// SymbolErrors is returned next to the results of a request for several symbols when some
+// of them failed, e.g. were delisted, keyed by symbol. The results of the others are valid.
+type SymbolErrors map[string]error
+
+func (e SymbolErrors) Error() string {
+	symbols := make([]string, 0, len(e))
+	for symbol := range e {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return fmt.Sprintf("%d symbols failed, %s: %v", len(e), symbols[0], e[symbols[0]])
+}
+
+// MissingSymbols returns a SymbolErrors of ErrCategoryInvalidSymbol for the symbols without
+// a result, nil when all have one
+func MissingSymbols[T any](exchange string, symbols []string, results map[string]T) error {
+	errs := make(SymbolErrors)
+	for _, symbol := range symbols {
+		if _, ok := results[symbol]; !ok {
+			errs[symbol] = NewExchangeError(exchange, ErrCategoryInvalidSymbol, "", "symbol not listed: "+symbol)
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
 var (
 	ErrApi          = errors.New("API error.")
 	ErrResponseRead = errors.New("Cannot read API Response.")
@@ -22,4 +173,19 @@ var (
 	ErrHttp         = errors.New("Http error.")
 	ErrUnmarshal    = errors.New("Json unmarshal error.")
 	ErrApiRequest   = errors.New("API request error")
+
+	ErrWsClosed       = errors.New("WebSocket client is closed.")
+	ErrWsNotConnected = errors.New("WebSocket is not connected.")
+	// ErrWsSessionClosed fails requests whose connection was dropped before the answer arrived, they can be retried
+	ErrWsSessionClosed = errors.New("WebSocket session closed before response, retry the request.")
+	// ErrRequestNotSent wraps the failures before a request was written, e.g. a client side rate limit or a done context
+	ErrRequestNotSent = errors.New("Request was not sent.")
+
+	// ErrOrderNotPlaced is a definite answer to an uncertain placement, the exchange does not have the order
+	ErrOrderNotPlaced = errors.New("Order was not placed.")
+	// ErrOrderStateUnknown is returned when the exchange could not be asked whether an order was placed
+	ErrOrderStateUnknown = errors.New("Order placement state is unknown.")
+
+	ErrOrderbookGap       = errors.New("Orderbook sequence gap.")
+	ErrOrderbookNotSynced = errors.New("Orderbook is not synced.")
 )
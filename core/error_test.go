@@ -0,0 +1,59 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestExchangeErrorMinAmount(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      *ExchangeError
+		tooSmall bool
+		min      float64
+	}{
+		{"min notional", &ExchangeError{Exchange: "okx", Category: ErrCategoryMinNotional, Min: d("5")}, true, 5},
+		{"min notional not told", NewExchangeError("binance", ErrCategoryMinNotional, "-1013", "Filter failure: NOTIONAL"), true, 0},
+		{"other category", NewExchangeError("binance", ErrCategoryRateLimited, "-1003", "too many requests"), false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := fmt.Errorf("place order: %w", tt.err)
+			var small OrderAmountTooSmall
+			if errors.As(err, &small) != tt.tooSmall {
+				t.Fatalf("errors.As(OrderAmountTooSmall) = %v, want %v", !tt.tooSmall, tt.tooSmall)
+			}
+			if tt.tooSmall && small.MinAmount() != tt.min {
+				t.Errorf("MinAmount() = %v, want %v", small.MinAmount(), tt.min)
+			}
+			var exErr *ExchangeError
+			if !errors.As(err, &exErr) || exErr != tt.err {
+				t.Error("errors.As(*ExchangeError) lost the error")
+			}
+		})
+	}
+}
+
+func TestPlacementUncertain(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"rejected", NewExchangeError("binance", ErrCategoryInsufficientBalance, "-2010", "insufficient balance"), false},
+		{"server error", NewExchangeError("binance", ErrCategoryUnknown, "-1001", "internal error").WithStatus(503), true},
+		{"timeout waiting for the answer", fmt.Errorf("timeout waiting for WS response: %w", context.DeadlineExceeded), true},
+		{"deadline before the write", fmt.Errorf("%w: %w", ErrRequestNotSent, context.DeadlineExceeded), false},
+		{"client side rate limit", fmt.Errorf("%w: %w", ErrRequestNotSent, NewExchangeError("binance", ErrCategoryRateLimited, "", "retry")), false},
+		{"not connected", ErrWsNotConnected, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PlacementUncertain(fmt.Errorf("place order: %w", tt.err)); got != tt.want {
+				t.Errorf("PlacementUncertain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package core
+
+import "context"
+
+// SubscribeFills subscribes to the order events of c and passes on the executions only,
+// one fill per trade. The channel closes when the order event channel does or ctx is done.
+func SubscribeFills(ctx context.Context, c PrivateClient, symbols []string, errHandler func(err error)) (<-chan OrderFill, error) {
+	events, err := c.SubscribeOrderEvents(ctx, symbols, errHandler)
+	if err != nil {
+		return nil, err
+	}
+	fills := make(chan OrderFill, 100)
+	go func() {
+		defer close(fills)
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+				if !event.IsFill() {
+					continue
+				}
+				select {
+				case fills <- event.Fill():
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return fills, nil
+}
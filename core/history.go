@@ -0,0 +1,114 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// OrderHistoryLookback is how far back FetchOrderHistory and FetchFills look when since is
+// zero
+var OrderHistoryLookback = 7 * 24 * time.Hour
+
+// OrderPageFunc fetches at most limit orders created in [start, end], in any order
+type OrderPageFunc func(start, end time.Time, limit int) ([]OrderResponseFull, error)
+
+// FillPageFunc fetches at most limit fills executed in [start, end], in any order
+type FillPageFunc func(start, end time.Time, limit int) ([]OrderFill, error)
+
+// PageOrders fetches the closed orders created in [since, until] oldest first. The range is
+// split into spans of at most window for exchanges limiting the span of a request, and a
+// span that fills a page of pageLimit orders is halved until it does not, a pageLimit of
+// zero is for page funcs that follow the pages of the exchange themselves. A zero until
+// means now, a zero since OrderHistoryLookback before until. Open orders are left out, they
+// are listed by FetchOpenOrders.
+func PageOrders(since, until time.Time, window time.Duration, pageLimit int, page OrderPageFunc) ([]OrderResponseFull, error) {
+	orders, err := pageSpans(since, until, window, pageLimit, page)
+	if err != nil {
+		return nil, fmt.Errorf("order history: %w", err)
+	}
+	seen := make(map[string]bool)
+	out := orders[:0]
+	for _, order := range orders {
+		if order.Status.IsOpen() || seen[order.OrderID] {
+			continue
+		}
+		seen[order.OrderID] = true
+		out = append(out, order)
+	}
+	SortOrders(out)
+	return out, nil
+}
+
+// PageFills fetches the fills executed in [since, until] oldest first, paged like
+// PageOrders
+func PageFills(since, until time.Time, window time.Duration, pageLimit int, page FillPageFunc) ([]OrderFill, error) {
+	fills, err := pageSpans(since, until, window, pageLimit, page)
+	if err != nil {
+		return nil, fmt.Errorf("fills: %w", err)
+	}
+	seen := make(map[string]bool)
+	out := fills[:0]
+	for _, fill := range fills {
+		if seen[fill.TradeID] {
+			continue
+		}
+		seen[fill.TradeID] = true
+		out = append(out, fill)
+	}
+	SortFills(out)
+	return out, nil
+}
+
+// pageSpans collects the pages of [since, until], see PageOrders
+func pageSpans[T any](since, until time.Time, window time.Duration, pageLimit int, page func(start, end time.Time, limit int) ([]T, error)) ([]T, error) {
+	if until.IsZero() {
+		until = time.Now()
+	}
+	if since.IsZero() {
+		since = until.Add(-OrderHistoryLookback)
+	}
+	// exchanges take millisecond times, so do the spans
+	since, until = since.Truncate(time.Millisecond), until.Truncate(time.Millisecond)
+	if since.After(until) {
+		return nil, fmt.Errorf("since %s is after until %s", since, until)
+	}
+	var out []T
+	var fetch func(start, end time.Time) error
+	fetch = func(start, end time.Time) error {
+		items, err := page(start, end, pageLimit)
+		if err != nil {
+			return err
+		}
+		// a full page may be missing items, unless the span cannot be split any further
+		if pageLimit > 0 && len(items) >= pageLimit && end.After(start) {
+			mid := start.Add(end.Sub(start) / 2).Truncate(time.Millisecond)
+			if err := fetch(start, mid); err != nil {
+				return err
+			}
+			return fetch(mid.Add(time.Millisecond), end)
+		}
+		out = append(out, items...)
+		return nil
+	}
+	for start := since; !start.After(until); start = start.Add(window + time.Millisecond) {
+		end := start.Add(window)
+		if end.After(until) {
+			end = until
+		}
+		if err := fetch(start, end); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// SortOrders sorts orders oldest first
+func SortOrders(orders []OrderResponseFull) {
+	sort.SliceStable(orders, func(i, j int) bool { return orders[i].CreateTime.Before(orders[j].CreateTime) })
+}
+
+// SortFills sorts fills oldest first
+func SortFills(fills []OrderFill) {
+	sort.SliceStable(fills, func(i, j int) bool { return fills[i].TradeTime.Before(fills[j].TradeTime) })
+}
@@ -0,0 +1,119 @@
+package core
+
+import (
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// fillPages is a FillPageFunc over fills, it compares millisecond times like the exchanges
+// and returns the newest limit fills of a span
+func fillPages(fills []OrderFill) FillPageFunc {
+	return func(start, end time.Time, limit int) ([]OrderFill, error) {
+		var page []OrderFill
+		for _, fill := range fills {
+			t := fill.TradeTime.Truncate(time.Millisecond)
+			if !t.Before(start) && !t.After(end) {
+				page = append(page, fill)
+			}
+		}
+		sort.Slice(page, func(i, j int) bool { return page[i].TradeTime.After(page[j].TradeTime) })
+		if limit > 0 && len(page) > limit {
+			page = page[:limit]
+		}
+		return page, nil
+	}
+}
+
+func TestPageFills(t *testing.T) {
+	since := time.UnixMilli(1700000000000)
+	until := since.Add(time.Hour)
+	fill := func(id int, at time.Time) OrderFill {
+		return OrderFill{TradeID: strconv.Itoa(id), TradeTime: at}
+	}
+	var fills []OrderFill
+	// on the bounds of the range and of the windows
+	for _, at := range []time.Time{since, until, since.Add(10 * time.Minute), since.Add(10*time.Minute + time.Millisecond)} {
+		fills = append(fills, fill(len(fills), at))
+	}
+	// a burst filling many pages, up to a page in one millisecond and in two adjacent ones
+	burst := since.Add(25 * time.Minute)
+	for i := 0; i < 40; i++ {
+		fills = append(fills, fill(len(fills), burst.Add(time.Duration(i)*time.Second)))
+	}
+	for i := 0; i < 5; i++ {
+		fills = append(fills, fill(len(fills), burst.Add(-time.Minute)))
+		fills = append(fills, fill(len(fills), burst.Add(-time.Minute+time.Millisecond)))
+	}
+	// outside the range, sub millisecond times are compared in milliseconds
+	outside := []OrderFill{
+		fill(1000, since.Add(-time.Millisecond)),
+		fill(1001, until.Add(time.Millisecond)),
+	}
+
+	tests := []struct {
+		name      string
+		window    time.Duration
+		pageLimit int
+	}{
+		{name: "one span, exchange pages", window: time.Hour},
+		{name: "windows", window: 10 * time.Minute},
+		{name: "windows split on full pages", window: 10 * time.Minute, pageLimit: 5},
+		{name: "one span split on full pages", window: time.Hour, pageLimit: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PageFills(since.Add(500*time.Microsecond), until.Add(500*time.Microsecond), tt.window, tt.pageLimit,
+				fillPages(append(fills, outside...)))
+			if err != nil {
+				t.Fatalf("PageFills() error = %v", err)
+			}
+			seen := make(map[string]int)
+			for i, f := range got {
+				seen[f.TradeID]++
+				if i > 0 && f.TradeTime.Before(got[i-1].TradeTime) {
+					t.Errorf("fill %s out of order", f.TradeID)
+				}
+			}
+			for _, f := range fills {
+				if seen[f.TradeID] != 1 {
+					t.Errorf("fill %s at %s returned %d times", f.TradeID, f.TradeTime.Sub(since), seen[f.TradeID])
+				}
+			}
+			if len(got) != len(fills) {
+				t.Errorf("got %d fills, want %d", len(got), len(fills))
+			}
+		})
+	}
+}
+
+func TestPageFillsAdjacentMilliseconds(t *testing.T) {
+	// a full page in each of two milliseconds, the span of both is split once more
+	at := time.UnixMilli(1700000000000)
+	var fills []OrderFill
+	for i := 0; i < 10; i++ {
+		fills = append(fills, OrderFill{TradeID: strconv.Itoa(i), TradeTime: at.Add(time.Duration(i%2) * time.Millisecond)})
+	}
+	got, err := PageFills(at, at.Add(time.Millisecond), time.Hour, 5, fillPages(fills))
+	if err != nil || len(got) != len(fills) {
+		t.Errorf("PageFills() = %d fills, %v, want %d", len(got), err, len(fills))
+	}
+}
+
+func TestPageOrdersDedupe(t *testing.T) {
+	since := time.UnixMilli(1700000000000)
+	order := func(id string, status OrderStatus) OrderResponseFull {
+		return OrderResponseFull{OrderResponse: OrderResponse{OrderID: id, Status: status, CreateTime: since}}
+	}
+	// exchanges that take the end of a span as exclusive or round it list orders twice
+	got, err := PageOrders(since, since.Add(time.Minute), 10*time.Second, 0, func(start, end time.Time, limit int) ([]OrderResponseFull, error) {
+		return []OrderResponseFull{order("1", OrderStatusFilled), order("2", OrderStatusOpen)}, nil
+	})
+	if err != nil {
+		t.Fatalf("PageOrders() error = %v", err)
+	}
+	if len(got) != 1 || got[0].OrderID != "1" {
+		t.Errorf("PageOrders() = %+v, want order 1 once and no open order", got)
+	}
+}
@@ -2,13 +2,24 @@ package core
 
 import (
 	"context"
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
 type PublicClient interface {
 	SubscribeQuotes(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]chan Quote, error)
-	//	SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan Orderbook, error)
+	SubscribeOrderbook(ctx context.Context, symbols []string, depth int, errHandler func(err error)) (map[string]<-chan Orderbook, error)
+	// FetchQuotes returns the top of book of symbols, of all symbols of the market when it is
+	// empty. Symbols that failed, e.g. were delisted, are left out of the quotes and reported
+	// in a SymbolErrors returned with them.
 	FetchQuotes(symbols []string) (map[string]Quote, error)
+	// FetchTickers returns the last price and 24h statistics of symbols, of all symbols of
+	// the market when it is empty
+	FetchTickers(symbols []string) (map[string]Ticker, error)
+	FetchCandles(symbol string, interval CandleInterval, start, end time.Time, limit int) ([]Candle, error)
+	SubscribeCandles(ctx context.Context, symbols []string, interval CandleInterval, errHandler func(err error)) (map[string]<-chan Candle, error)
+	SubscribeTrades(ctx context.Context, symbols []string, errHandler func(err error)) (map[string]<-chan Trade, error)
 
 	ToSymbol(asset, quote string) string
 	ToAsset(symbol string) string
@@ -22,14 +33,38 @@ type PrivateClient interface {
 
 	FetchBalance(asset string, includeLocked bool, futuresPosition bool) (decimal.Decimal, error)
 	FetchOrder(symbol, orderId string) (*OrderResponseFull, error)
+	// FetchOrderByClientID looks an order up by its client order id, an ExchangeError of
+	// ErrCategoryOrderNotFound when the exchange does not have it
+	FetchOrderByClientID(symbol, clientOrderID string) (*OrderResponseFull, error)
+	// FetchOpenOrders lists the open orders of symbol, of all symbols when it is empty
+	FetchOpenOrders(symbol string) ([]OrderResponseFull, error)
+	// FetchOrderHistory lists the closed orders of symbol created in [since, until] oldest
+	// first, see PageOrders for the defaults. Together with FetchOpenOrders it rebuilds the
+	// order state after a restart.
+	FetchOrderHistory(symbol string, since, until time.Time) ([]OrderResponseFull, error)
+	// FetchFills lists the executions of own orders on symbol in [since, until] oldest
+	// first, with the defaults of FetchOrderHistory
+	FetchFills(symbol string, since, until time.Time) ([]OrderFill, error)
+	// FetchOrderFills lists the executions of one order oldest first
+	FetchOrderFills(symbol, orderId string) ([]OrderFill, error)
 
 	LimitBuy(symbol string, quantity, price decimal.Decimal, tif string) (*OrderResponse, error)
 	LimitSell(symbol string, quantity, price decimal.Decimal, tif string) (*OrderResponse, error)
 	MarketBuy(symbol string, quoteQuantity decimal.Decimal) (*OrderResponse, error)
 	MarketSell(symbol string, quantity decimal.Decimal) (*OrderResponse, error)
-	//StopLossSell(symbol string, quantity, triggerPrice decimal.Decimal) (*OrderResponse, error)
-	//TakeProfitSell(symbol string, quantity, triggerPrice decimal.Decimal) (*OrderResponse, error)
+	// PlaceOrder places any limit or market order, ctx bounds the wait for the exchange.
+	// When the answer is lost the order is looked up by its client order id, so the result
+	// is the placed order or an error, ErrOrderNotPlaced when it is sure there is no order.
+	PlaceOrder(ctx context.Context, req OrderRequest) (*OrderResponse, error)
+	// PlaceOrders places orders in native batches where the exchange has them and as
+	// concurrent single orders otherwise. Results are in the order of reqs, the error is a
+	// *BatchError when some orders failed.
+	PlaceOrders(ctx context.Context, reqs []OrderRequest) ([]OrderResult, error)
 	CancelOrder(symbol, orderId string) (*OrderResponse, error)
+	// CancelOrders cancels orders of one symbol like PlaceOrders places them
+	CancelOrders(ctx context.Context, symbol string, orderIds []string) ([]OrderResult, error)
+	// AmendOrder changes quantity and price of an open limit order, natively where possible
+	AmendOrder(symbol, orderId string, newQty, newPrice decimal.Decimal) (*AmendResult, error)
 	CancelAll(symbol string) error
 
 	// Real-time subscription methods for private data
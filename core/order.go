@@ -0,0 +1,166 @@
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type OrderType string
+
+const (
+	OrderTypeLimit  OrderType = "LIMIT"
+	OrderTypeMarket OrderType = "MARKET"
+)
+
+// STPMode is the self-trade prevention mode, which side of a self-match is cancelled
+type STPMode string
+
+const (
+	STPNone        STPMode = "" // exchange default
+	STPExpireTaker STPMode = "EXPIRE_TAKER"
+	STPExpireMaker STPMode = "EXPIRE_MAKER"
+	STPExpireBoth  STPMode = "EXPIRE_BOTH"
+)
+
+// OrderRequest is an order for PrivateClient.PlaceOrder. Market orders take either
+// Quantity in base or QuoteQuantity, limit orders always Quantity and Price.
+type OrderRequest struct {
+	Symbol        string
+	Side          OrderSide
+	Type          OrderType
+	Quantity      decimal.Decimal
+	QuoteQuantity decimal.Decimal
+	Price         decimal.Decimal
+	TimeInForce   TimeInForce // limit orders only, GTC when empty
+	PostOnly      bool        // limit orders only, rejected instead of taking liquidity
+	ReduceOnly    bool        // futures only
+	ClientOrderID string      // generated when empty, reuse it to retry without a duplicate order
+	STPMode       STPMode
+}
+
+// clientOrderIDPrefix marks ids generated by this library
+const clientOrderIDPrefix = "qx"
+
+// NewClientOrderID returns a unique client order id that every exchange accepts, at most
+// 32 alphanumeric characters
+func NewClientOrderID() string {
+	var b [6]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("client order id: %v", err))
+	}
+	return clientOrderIDPrefix + strconv.FormatInt(time.Now().UnixNano(), 36) + hex.EncodeToString(b[:])
+}
+
+// Prepare validates r and fills the defaults, a ClientOrderID and the limit TimeInForce.
+// Adapters call it first in PlaceOrder.
+func (r *OrderRequest) Prepare() error {
+	if r.Symbol == "" {
+		return fmt.Errorf("order needs a symbol")
+	}
+	if r.Side != OrderSideBuy && r.Side != OrderSideSell {
+		return fmt.Errorf("invalid order side %q", r.Side)
+	}
+	switch r.Type {
+	case OrderTypeLimit:
+		if !r.Quantity.IsPositive() || !r.Price.IsPositive() {
+			return fmt.Errorf("limit order needs a positive quantity and price, got %s @ %s", r.Quantity, r.Price)
+		}
+		if !r.QuoteQuantity.IsZero() {
+			return fmt.Errorf("limit order cannot have a quote quantity")
+		}
+		if r.TimeInForce == "" {
+			r.TimeInForce = TimeInForceGTC
+		}
+		if r.PostOnly && r.TimeInForce != TimeInForceGTC {
+			return fmt.Errorf("post-only order cannot be %s", r.TimeInForce)
+		}
+	case OrderTypeMarket:
+		if r.Quantity.IsPositive() == r.QuoteQuantity.IsPositive() {
+			return fmt.Errorf("market order needs exactly one positive quantity or quote quantity, got %s and %s", r.Quantity, r.QuoteQuantity)
+		}
+		if r.Quantity.IsNegative() || r.QuoteQuantity.IsNegative() {
+			return fmt.Errorf("market order quantity cannot be negative")
+		}
+		if !r.Price.IsZero() || r.TimeInForce != "" || r.PostOnly {
+			return fmt.Errorf("market order cannot have a price, time in force or post-only")
+		}
+	default:
+		return fmt.Errorf("invalid order type %q", r.Type)
+	}
+	switch r.STPMode {
+	case STPNone, STPExpireTaker, STPExpireMaker, STPExpireBoth:
+	default:
+		return fmt.Errorf("invalid self-trade prevention mode %q", r.STPMode)
+	}
+	if r.ClientOrderID == "" {
+		r.ClientOrderID = NewClientOrderID()
+	}
+	return nil
+}
+
+// ErrUnsupportedOrder is returned for an order option the exchange does not have
+func ErrUnsupportedOrder(exchange, option string) error {
+	return fmt.Errorf("%s: unsupported order option: %s", exchange, option)
+}
+
+// PlacementSettleTime is how long after a failed placement the order may still reach the
+// exchange, longer than the receive windows of the signed requests
+var PlacementSettleTime = 10 * time.Second
+
+// PlacementUncertain reports whether a PlaceOrder error leaves open whether the order was
+// placed, that is the request may have been sent but no definite answer came back
+func PlacementUncertain(err error) bool {
+	if err == nil || errors.Is(err, ErrRequestNotSent) {
+		return false
+	}
+	var exErr *ExchangeError
+	if errors.As(err, &exErr) {
+		// the exchange answered, only an unknown execution or a server error is no answer
+		return exErr.Category == ErrCategoryExecutionUnknown || exErr.Status >= 500
+	}
+	return !errors.Is(err, ErrWsNotConnected) && !errors.Is(err, ErrWsClosed)
+}
+
+// ClientOrderFetcher looks orders up by client order id, see PrivateClient
+type ClientOrderFetcher interface {
+	FetchOrderByClientID(symbol, clientOrderID string) (*OrderResponseFull, error)
+}
+
+// ResolvePlacement turns an uncertain PlaceOrder error into a definite answer by looking
+// the order up by req.ClientOrderID. It returns the order when the exchange has it and an
+// ErrOrderNotPlaced error once it still does not after PlacementSettleTime. If the lookups
+// keep failing the error wraps ErrOrderStateUnknown. Certain errors are returned as is.
+// Adapters call it on the send error of PlaceOrder, it deliberately outlives the ctx of
+// the placement.
+func ResolvePlacement(c ClientOrderFetcher, req OrderRequest, placeErr error) (*OrderResponse, error) {
+	if !PlacementUncertain(placeErr) || req.ClientOrderID == "" {
+		return nil, placeErr
+	}
+	deadline := time.Now().Add(PlacementSettleTime)
+	backoff := NewBackoff(250*time.Millisecond, 2*time.Second)
+	for {
+		order, err := c.FetchOrderByClientID(req.Symbol, req.ClientOrderID)
+		if err == nil {
+			resp := order.OrderResponse
+			return &resp, nil
+		}
+		notFound := ErrorCategoryOf(err) == ErrCategoryOrderNotFound
+		if !time.Now().Before(deadline) {
+			if notFound {
+				return nil, fmt.Errorf("%w: %s %s: %v", ErrOrderNotPlaced, req.Symbol, req.ClientOrderID, placeErr)
+			}
+			return nil, fmt.Errorf("%w: %s %s: %v, lookup: %v", ErrOrderStateUnknown, req.Symbol, req.ClientOrderID, placeErr, err)
+		}
+		wait := backoff.Next()
+		if left := time.Until(deadline); wait > left {
+			wait = left
+		}
+		time.Sleep(wait)
+	}
+}
@@ -0,0 +1,304 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// LocalOrderbook keeps an in-memory orderbook in sync from a snapshot followed by diff updates.
+// Sequence numbers are exchange specific, adapters map them so that a diff covering
+// [firstSeq, lastSeq] directly follows the book when firstSeq == LastSeq()+1.
+type LocalOrderbook struct {
+	Symbol string
+
+	mu      sync.Mutex
+	depth   int
+	bids    map[string]OrderbookEntry
+	asks    map[string]OrderbookEntry
+	lastSeq int64
+	synced  bool
+}
+
+func NewLocalOrderbook(symbol string, depth int) *LocalOrderbook {
+	return &LocalOrderbook{
+		Symbol: symbol,
+		depth:  depth,
+		bids:   make(map[string]OrderbookEntry),
+		asks:   make(map[string]OrderbookEntry),
+	}
+}
+
+// ApplySnapshot replaces the whole book and marks it as synced at seq.
+func (b *LocalOrderbook) ApplySnapshot(seq int64, bids, asks []OrderbookEntry) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bids = make(map[string]OrderbookEntry, len(bids))
+	b.asks = make(map[string]OrderbookEntry, len(asks))
+	applyLevels(b.bids, bids)
+	applyLevels(b.asks, asks)
+	b.lastSeq = seq
+	b.synced = true
+}
+
+// ApplyDiff applies a diff update covering the sequence range [firstSeq, lastSeq].
+// It reports whether the diff was applied, stale updates are ignored. ErrOrderbookGap is
+// returned when an update was missed, the book is then out of sync until the next ApplySnapshot.
+func (b *LocalOrderbook) ApplyDiff(firstSeq, lastSeq int64, bids, asks []OrderbookEntry) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.synced {
+		return false, ErrOrderbookNotSynced
+	}
+	if lastSeq <= b.lastSeq {
+		return false, nil
+	}
+	if firstSeq > b.lastSeq+1 {
+		b.synced = false
+		return false, ErrOrderbookGap
+	}
+	applyLevels(b.bids, bids)
+	applyLevels(b.asks, asks)
+	b.lastSeq = lastSeq
+	return true, nil
+}
+
+// ApplySeqReset applies a diff after which the exchange restarted its sequence at seq, lower
+// than the sequence of the book. prevSeq must be the sequence of the book, else the book is out
+// of sync and ErrOrderbookGap is returned.
+func (b *LocalOrderbook) ApplySeqReset(prevSeq, seq int64, bids, asks []OrderbookEntry) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !b.synced {
+		return false, ErrOrderbookNotSynced
+	}
+	if prevSeq != b.lastSeq {
+		b.synced = false
+		return false, ErrOrderbookGap
+	}
+	applyLevels(b.bids, bids)
+	applyLevels(b.asks, asks)
+	b.lastSeq = seq
+	return true, nil
+}
+
+// Invalidate marks the book as out of sync so that diffs are rejected until a new snapshot.
+func (b *LocalOrderbook) Invalidate() {
+	b.mu.Lock()
+	b.synced = false
+	b.mu.Unlock()
+}
+
+func (b *LocalOrderbook) Synced() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.synced
+}
+
+func (b *LocalOrderbook) LastSeq() int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lastSeq
+}
+
+// Orderbook returns a sorted copy of the book truncated to depth levels.
+func (b *LocalOrderbook) Orderbook() Orderbook {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return Orderbook{
+		Symbol: b.Symbol,
+		Bids:   sortedLevels(b.bids, b.depth, true),
+		Asks:   sortedLevels(b.asks, b.depth, false),
+	}
+}
+
+// SnapshotFunc fetches a snapshot of a book, seq is the sequence the snapshot is at
+type SnapshotFunc func() (seq int64, bids, asks []OrderbookEntry, err error)
+
+// snapshotRetry is the pause after a failed snapshot, a rate limited snapshot must not be
+// retried on every diff
+const snapshotRetry = 5 * time.Second
+
+// maxPendingDiffs bounds the diffs buffered while a snapshot loads, the oldest are dropped
+// first. They are older than a snapshot that took this long, else the book resyncs on the gap.
+const maxPendingDiffs = 1000
+
+// BookSyncer keeps a LocalOrderbook in sync from a diff stream whose snapshots come from
+// another source, e.g. a REST depth request. Snapshots are loaded outside the stream
+// goroutine, diffs arriving meanwhile are buffered, up to maxPendingDiffs, and applied on top
+// of the snapshot.
+type BookSyncer struct {
+	Book *LocalOrderbook
+
+	fetch      SnapshotFunc
+	onUpdate   func(ob Orderbook)
+	errHandler func(err error)
+
+	mu       sync.Mutex
+	loading  bool
+	pending  []bookDiff
+	failedAt time.Time
+	closed   bool
+}
+
+type bookDiff struct {
+	firstSeq, lastSeq int64
+	bids, asks        []OrderbookEntry
+}
+
+// NewBookSyncer returns a syncer of book, onUpdate receives the book after every change
+func NewBookSyncer(book *LocalOrderbook, fetch SnapshotFunc, onUpdate func(ob Orderbook), errHandler func(err error)) *BookSyncer {
+	if errHandler == nil {
+		errHandler = func(err error) {}
+	}
+	return &BookSyncer{Book: book, fetch: fetch, onUpdate: onUpdate, errHandler: errHandler}
+}
+
+// Diff handles a diff of the stream covering [firstSeq, lastSeq], see LocalOrderbook.ApplyDiff.
+// A book that is not synced is loaded from a new snapshot.
+func (s *BookSyncer) Diff(firstSeq, lastSeq int64, bids, asks []OrderbookEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return
+	}
+	diff := bookDiff{firstSeq: firstSeq, lastSeq: lastSeq, bids: bids, asks: asks}
+	if s.loading {
+		if len(s.pending) >= maxPendingDiffs {
+			s.pending = append(s.pending[:0], s.pending[1:]...)
+		}
+		s.pending = append(s.pending, diff)
+		return
+	}
+	if !s.Book.Synced() {
+		s.load(diff)
+		return
+	}
+	applied, err := s.Book.ApplyDiff(firstSeq, lastSeq, bids, asks)
+	if err != nil {
+		s.errHandler(fmt.Errorf("%s orderbook resync: %w", s.Book.Symbol, err))
+		s.load(diff)
+		return
+	}
+	if applied {
+		s.onUpdate(s.Book.Orderbook())
+	}
+}
+
+// Reset marks the book as out of sync, e.g. after a reconnect, the next diff loads a snapshot
+func (s *BookSyncer) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.Book.Invalidate()
+	s.pending = nil
+}
+
+// Close stops the updates, onUpdate is not called once Close returned
+func (s *BookSyncer) Close() {
+	s.mu.Lock()
+	s.closed = true
+	s.pending = nil
+	s.mu.Unlock()
+}
+
+// load starts loading a snapshot with diff as the first buffered diff, called with s.mu held
+func (s *BookSyncer) load(diff bookDiff) {
+	s.Book.Invalidate()
+	if time.Since(s.failedAt) < snapshotRetry {
+		return
+	}
+	s.loading = true
+	s.pending = []bookDiff{diff}
+	go func() {
+		seq, bids, asks, err := s.fetch()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.loading = false
+		pending := s.pending
+		s.pending = nil
+		if s.closed {
+			return
+		}
+		if err != nil {
+			s.failedAt = time.Now()
+			s.errHandler(fmt.Errorf("%s orderbook snapshot: %w", s.Book.Symbol, err))
+			return
+		}
+		s.Book.ApplySnapshot(seq, bids, asks)
+		for _, d := range pending {
+			if _, err := s.Book.ApplyDiff(d.firstSeq, d.lastSeq, d.bids, d.asks); err != nil {
+				s.errHandler(fmt.Errorf("%s orderbook resync: %w", s.Book.Symbol, err))
+				return // the next diff loads another snapshot
+			}
+		}
+		s.onUpdate(s.Book.Orderbook())
+	}()
+}
+
+// applyLevels sets absolute quantities per price level, a zero quantity removes the level.
+func applyLevels(side map[string]OrderbookEntry, levels []OrderbookEntry) {
+	for _, lv := range levels {
+		key := lv.Price.String()
+		if lv.Quantity.IsZero() {
+			delete(side, key)
+			continue
+		}
+		side[key] = lv
+	}
+}
+
+func sortedLevels(side map[string]OrderbookEntry, depth int, desc bool) []OrderbookEntry {
+	out := make([]OrderbookEntry, 0, len(side))
+	for _, lv := range side {
+		out = append(out, lv)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if desc {
+			return out[i].Price.GreaterThan(out[j].Price)
+		}
+		return out[i].Price.LessThan(out[j].Price)
+	})
+	if depth > 0 && len(out) > depth {
+		out = out[:depth]
+	}
+	for i := range out {
+		out[i].Level = i + 1
+	}
+	return out
+}
+
+// ParseOrderbookLevels converts [price, qty] string pairs to orderbook entries.
+func ParseOrderbookLevels(levels [][]string) []OrderbookEntry {
+	out := make([]OrderbookEntry, 0, len(levels))
+	for _, lv := range levels {
+		if len(lv) < 2 {
+			continue
+		}
+		price, err := decimal.NewFromString(lv[0])
+		if err != nil {
+			continue
+		}
+		out = append(out, OrderbookEntry{Price: price, Quantity: ParseStringDecimal(lv[1])})
+	}
+	return out
+}
+
+// SendOrderbook pushes the latest book to ch, replacing a stale pending book if the channel is full.
+func SendOrderbook(ch chan Orderbook, ob Orderbook) {
+	select {
+	case ch <- ob:
+		return
+	default:
+	}
+	select {
+	case <-ch:
+	default:
+	}
+	select {
+	case ch <- ob:
+	default:
+	}
+}
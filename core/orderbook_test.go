@@ -0,0 +1,125 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func levels(pairs ...string) []OrderbookEntry {
+	var out []OrderbookEntry
+	for i := 0; i+1 < len(pairs); i += 2 {
+		out = append(out, OrderbookEntry{Price: d(pairs[i]), Quantity: d(pairs[i+1])})
+	}
+	return out
+}
+
+func TestBookSyncer(t *testing.T) {
+	release := make(chan struct{})
+	updates := make(chan Orderbook, 10)
+	syncer := NewBookSyncer(NewLocalOrderbook("BTCUSDT", 10), func() (int64, []OrderbookEntry, []OrderbookEntry, error) {
+		<-release
+		return 10, levels("100", "1"), levels("101", "1"), nil
+	}, func(ob Orderbook) { updates <- ob }, nil)
+
+	// the snapshot loads in the background, diffs are buffered meanwhile
+	syncer.Diff(9, 11, levels("100", "2"), nil)
+	syncer.Diff(12, 12, levels("99", "3"), nil)
+	if syncer.Book.Synced() {
+		t.Fatal("book synced before the snapshot")
+	}
+	close(release)
+
+	select {
+	case ob := <-updates:
+		if len(ob.Bids) != 2 || !ob.Bids[0].Quantity.Equal(d("2")) || !ob.Bids[1].Price.Equal(d("99")) {
+			t.Errorf("book after the snapshot = %+v", ob.Bids)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no update after the snapshot")
+	}
+	if seq := syncer.Book.LastSeq(); seq != 12 {
+		t.Errorf("LastSeq() = %d, want 12", seq)
+	}
+
+	syncer.Diff(13, 13, nil, levels("101", "0"))
+	if ob := <-updates; len(ob.Asks) != 0 {
+		t.Errorf("asks = %+v, want none", ob.Asks)
+	}
+}
+
+func TestBookSyncerPendingCap(t *testing.T) {
+	release := make(chan struct{})
+	updates := make(chan Orderbook, 1)
+	syncer := NewBookSyncer(NewLocalOrderbook("BTCUSDT", 10), func() (int64, []OrderbookEntry, []OrderbookEntry, error) {
+		<-release
+		return 600, levels("100", "1"), nil, nil
+	}, func(ob Orderbook) { updates <- ob }, nil)
+
+	// a slow snapshot keeps only the latest diffs
+	for seq := int64(1); seq <= maxPendingDiffs+500; seq++ {
+		syncer.Diff(seq, seq, levels("99", "1"), nil)
+	}
+	syncer.mu.Lock()
+	pending := len(syncer.pending)
+	syncer.mu.Unlock()
+	if pending != maxPendingDiffs {
+		t.Errorf("pending diffs = %d, want %d", pending, maxPendingDiffs)
+	}
+	close(release)
+
+	select {
+	case <-updates:
+	case <-time.After(time.Second):
+		t.Fatal("no update after the snapshot")
+	}
+	if seq := syncer.Book.LastSeq(); seq != maxPendingDiffs+500 {
+		t.Errorf("LastSeq() = %d, want %d", seq, maxPendingDiffs+500)
+	}
+}
+
+func TestBookSyncerSnapshotError(t *testing.T) {
+	fetches := 0
+	errs := make(chan error, 10)
+	syncer := NewBookSyncer(NewLocalOrderbook("BTCUSDT", 10), func() (int64, []OrderbookEntry, []OrderbookEntry, error) {
+		fetches++
+		return 0, nil, nil, errors.New("status 429")
+	}, func(ob Orderbook) { t.Error("update without a snapshot") }, func(err error) { errs <- err })
+
+	syncer.Diff(1, 1, levels("100", "1"), nil)
+	select {
+	case <-errs:
+	case <-time.After(time.Second):
+		t.Fatal("snapshot error not reported")
+	}
+	// a failed snapshot is not retried on every diff
+	syncer.Diff(2, 2, levels("100", "2"), nil)
+	syncer.mu.Lock()
+	loading := syncer.loading
+	syncer.mu.Unlock()
+	if syncer.Book.Synced() || loading || fetches != 1 {
+		t.Errorf("synced %v, loading %v, fetches %d after a failed snapshot", syncer.Book.Synced(), loading, fetches)
+	}
+}
+
+func TestApplySeqReset(t *testing.T) {
+	book := NewLocalOrderbook("BTC-USDT", 10)
+	book.ApplySnapshot(100, levels("100", "1"), levels("101", "1"))
+
+	if _, err := book.ApplySeqReset(99, 5, levels("100", "2"), nil); !errors.Is(err, ErrOrderbookGap) {
+		t.Fatalf("ApplySeqReset() from another sequence error = %v, want ErrOrderbookGap", err)
+	}
+
+	book.ApplySnapshot(100, levels("100", "1"), levels("101", "1"))
+	if applied, err := book.ApplySeqReset(100, 5, levels("100", "2"), nil); !applied || err != nil {
+		t.Fatalf("ApplySeqReset() = %v, %v", applied, err)
+	}
+	// the diffs continue from the reset sequence
+	if applied, err := book.ApplyDiff(6, 6, nil, levels("101", "3")); !applied || err != nil {
+		t.Fatalf("ApplyDiff() after the reset = %v, %v", applied, err)
+	}
+	ob := book.Orderbook()
+	if !ob.Bids[0].Quantity.Equal(d("2")) || !ob.Asks[0].Quantity.Equal(d("3")) {
+		t.Errorf("book = %+v", ob)
+	}
+}
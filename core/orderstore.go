@@ -0,0 +1,346 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// OrderStore keeps the latest known state of own orders, fed by private order events and
+// reconciled against REST snapshots. Updates only move an order forward: a final status
+// stays as it is, the executed quantity never shrinks and without an execution an open
+// order does not fall back from PENDING_CANCEL or PARTIALLY_FILLED, so late, duplicated
+// or replayed updates are ignored. While it is synced the store answers FetchOrder
+// without a request, after a stream gap open orders are fetched again until Reconcile
+// caught up with what was missed.
+//
+// Only the binance spot client feeds a store of its own and answers its FetchOrder from it.
+// For the other venues the caller creates one and feeds it with Track, its FetchOrder then
+// stands in for the one of the client.
+type OrderStore struct {
+	// MaxAge bounds how long an open order is answered locally after its last update,
+	// zero trusts it as long as the store is synced
+	MaxAge time.Duration
+	// Live reports whether the event feed is connected, nil when the caller invalidates
+	// the store on disconnects itself
+	Live func() bool
+
+	fetchOrder func(symbol, orderId string) (*OrderResponseFull, error)
+	fetchOpen  func(symbol string) ([]OrderResponseFull, error)
+
+	mu     sync.Mutex
+	orders map[string]*storedOrder
+	synced bool
+	feeds  []chan OrderResponseFull
+}
+
+type storedOrder struct {
+	order   OrderResponseFull
+	updated time.Time
+}
+
+// NewOrderStore returns an unsynced store fetching snapshots with fetchOrder and fetchOpen,
+// usually the FetchOrder and FetchOpenOrders of a PrivateClient
+func NewOrderStore(fetchOrder func(symbol, orderId string) (*OrderResponseFull, error), fetchOpen func(symbol string) ([]OrderResponseFull, error)) *OrderStore {
+	return &OrderStore{
+		fetchOrder: fetchOrder,
+		fetchOpen:  fetchOpen,
+		orders:     make(map[string]*storedOrder),
+	}
+}
+
+// ApplyEvent merges an order event and reports whether the order changed
+func (s *OrderStore) ApplyEvent(e OrderEvent) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	next := OrderResponseFull{}
+	if cur, ok := s.orders[e.OrderID]; ok {
+		next = cur.order
+	}
+	next.OrderID = e.OrderID
+	next.Status = e.Status
+	next.ExecutedQty = e.ExecutedQty
+	next.UpdateTime = e.UpdateTime
+	if e.ClientOrderID != "" {
+		next.ClientOrderID = e.ClientOrderID
+	}
+	if e.Symbol != "" {
+		next.Symbol = e.Symbol
+	}
+	if e.Side != "" {
+		next.Side = e.Side
+	}
+	if e.Reason != "" {
+		next.Reason = e.Reason
+	}
+	if e.TimeInForce != "" {
+		next.Tif = e.TimeInForce
+	}
+	if !e.CreateTime.IsZero() {
+		next.CreateTime = e.CreateTime
+	}
+	if !e.Price.IsZero() {
+		next.Price = e.Price
+	}
+	if !e.Quantity.IsZero() {
+		next.Quantity = e.Quantity
+	}
+	if !e.AvgPrice.IsZero() {
+		next.AvgPrice = e.AvgPrice
+	}
+	if !e.Commission.IsZero() {
+		next.Commission = e.Commission
+		next.CommissionAsset = e.CommissionAsset
+	}
+	return s.apply(next)
+}
+
+// ApplySnapshot merges an order fetched from the exchange and reports whether it changed
+func (s *OrderStore) ApplySnapshot(order OrderResponseFull) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.apply(order)
+}
+
+// apply stores next unless it would move the order back, s.mu is held
+func (s *OrderStore) apply(next OrderResponseFull) bool {
+	cur, ok := s.orders[next.OrderID]
+	if ok {
+		if next.ExecutedQty.LessThan(cur.order.ExecutedQty) {
+			return false // stale
+		}
+		if cur.order.Status.IsFinal() && next.Status != cur.order.Status {
+			return false // final orders keep their status
+		}
+		if next.ExecutedQty.Equal(cur.order.ExecutedQty) && statusRank(next.Status) < statusRank(cur.order.Status) {
+			return false
+		}
+		cur.updated = time.Now()
+		if !orderChanged(cur.order, next) {
+			return false
+		}
+		cur.order = next
+	} else {
+		s.orders[next.OrderID] = &storedOrder{order: next, updated: time.Now()}
+	}
+	for _, feed := range s.feeds {
+		select {
+		case feed <- next:
+		default:
+			// feed full, drop the change
+		}
+	}
+	return true
+}
+
+// statusRank orders the statuses along the lifecycle
+func statusRank(status OrderStatus) int {
+	switch status {
+	case OrderStatusOpen:
+		return 0
+	case OrderStatusPartiallyFilled:
+		return 1
+	case OrderStatusPendingCancel:
+		return 2
+	default:
+		return 3
+	}
+}
+
+func orderChanged(cur, next OrderResponseFull) bool {
+	return cur.Status != next.Status ||
+		!cur.ExecutedQty.Equal(next.ExecutedQty) ||
+		!cur.AvgPrice.Equal(next.AvgPrice) ||
+		!cur.Commission.Equal(next.Commission) ||
+		!cur.Price.Equal(next.Price) ||
+		!cur.Quantity.Equal(next.Quantity)
+}
+
+// Order returns the stored state of an order, fresh or not
+func (s *OrderStore) Order(orderId string) (*OrderResponseFull, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.orders[orderId]
+	if !ok {
+		return nil, false
+	}
+	order := stored.order
+	return &order, true
+}
+
+// Fresh returns the stored state of an order if it can be trusted without a request, a
+// final order always and an open one while the store is synced and within MaxAge
+func (s *OrderStore) Fresh(orderId string) (*OrderResponseFull, bool) {
+	live := s.Live == nil || s.Live()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stored, ok := s.orders[orderId]
+	if !ok {
+		return nil, false
+	}
+	if stored.order.Status.IsOpen() {
+		if !s.synced || !live || (s.MaxAge > 0 && time.Since(stored.updated) > s.MaxAge) {
+			return nil, false
+		}
+	}
+	order := stored.order
+	return &order, true
+}
+
+// FetchOrder answers from the store when the order is fresh and fetches and stores it
+// otherwise. The result is the merged state, which may be ahead of the fetched snapshot.
+func (s *OrderStore) FetchOrder(symbol, orderId string) (*OrderResponseFull, error) {
+	if order, ok := s.Fresh(orderId); ok {
+		return order, nil
+	}
+	order, err := s.fetchOrder(symbol, orderId)
+	if err != nil {
+		return nil, err
+	}
+	s.ApplySnapshot(*order)
+	if merged, ok := s.Order(order.OrderID); ok {
+		return merged, nil
+	}
+	return order, nil
+}
+
+// OpenOrders returns the stored open orders of symbol, of all symbols when it is empty,
+// oldest first
+func (s *OrderStore) OpenOrders(symbol string) []OrderResponseFull {
+	s.mu.Lock()
+	var orders []OrderResponseFull
+	for _, stored := range s.orders {
+		if stored.order.Status.IsOpen() && (symbol == "" || stored.order.Symbol == symbol) {
+			orders = append(orders, stored.order)
+		}
+	}
+	s.mu.Unlock()
+	SortOrders(orders)
+	return orders
+}
+
+// Invalidate marks the store as out of sync, e.g. on a disconnect of the event feed, until
+// the next Reconcile
+func (s *OrderStore) Invalidate() {
+	s.mu.Lock()
+	s.synced = false
+	s.mu.Unlock()
+}
+
+func (s *OrderStore) Synced() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.synced
+}
+
+// Reconcile catches up with updates the event feed missed: it stores the open orders of the
+// exchange and fetches each order the store holds as open that is no longer among them.
+// The store is synced once it succeeded. Call it after the feed is subscribed again, so
+// that nothing falls between the snapshot and the events.
+func (s *OrderStore) Reconcile() error {
+	open, err := s.fetchOpen("")
+	if err != nil {
+		return fmt.Errorf("order store reconcile: %w", err)
+	}
+	listed := make(map[string]bool, len(open))
+	for _, order := range open {
+		listed[order.OrderID] = true
+		s.ApplySnapshot(order)
+	}
+	var errs []error
+	for _, order := range s.OpenOrders("") {
+		if listed[order.OrderID] {
+			continue
+		}
+		// finished while the feed was down
+		fetched, err := s.fetchOrder(order.Symbol, order.OrderID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %w", order.Symbol, order.OrderID, err))
+			continue
+		}
+		s.ApplySnapshot(*fetched)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("order store reconcile: %w", errors.Join(errs...))
+	}
+	s.mu.Lock()
+	s.synced = true
+	s.mu.Unlock()
+	return nil
+}
+
+// Prune forgets the final orders without an update for age
+func (s *OrderStore) Prune(age time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, stored := range s.orders {
+		if stored.order.Status.IsFinal() && time.Since(stored.updated) > age {
+			delete(s.orders, id)
+		}
+	}
+}
+
+// Changes returns a feed of the orders as they change, closed when ctx is done. Changes
+// are dropped if the receiver falls behind.
+func (s *OrderStore) Changes(ctx context.Context) <-chan OrderResponseFull {
+	ch := make(chan OrderResponseFull, 100)
+	s.mu.Lock()
+	s.feeds = append(s.feeds, ch)
+	s.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, feed := range s.feeds {
+			if feed == ch {
+				s.feeds = append(s.feeds[:i], s.feeds[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// Track feeds the store from the order events of c and reconciles it right away and after
+// every stream gap. Other stream errors are passed to errHandler.
+func (s *OrderStore) Track(ctx context.Context, c PrivateClient, symbols []string, errHandler func(err error)) error {
+	events, err := c.SubscribeOrderEvents(ctx, symbols, func(err error) {
+		if errors.Is(err, ErrStreamGap) {
+			s.Invalidate()
+			go s.reconcile(errHandler)
+		}
+		if errHandler != nil {
+			errHandler(err)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	go func() {
+		for {
+			select {
+			case event, ok := <-events:
+				if !ok {
+					s.Invalidate()
+					return
+				}
+				s.ApplyEvent(event)
+			case <-ctx.Done():
+				s.Invalidate()
+				return
+			}
+		}
+	}()
+	s.reconcile(errHandler)
+	return nil
+}
+
+// reconcile runs Reconcile and reports its failure to errHandler
+func (s *OrderStore) reconcile(errHandler func(err error)) {
+	if err := s.Reconcile(); err != nil && errHandler != nil {
+		errHandler(err)
+	}
+}
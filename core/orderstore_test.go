@@ -0,0 +1,194 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestOrderStoreApplyEvent(t *testing.T) {
+	store := NewOrderStore(nil, nil)
+	created := time.UnixMilli(1700000000000)
+
+	store.ApplyEvent(OrderEvent{OrderID: "1", Symbol: "BTCUSDT", Side: OrderSideBuy, Status: OrderStatusOpen,
+		Price: d("100"), Quantity: d("2"), TimeInForce: TimeInForceIOC, CreateTime: created, UpdateTime: created})
+	// a fill without the order fields keeps them
+	store.ApplyEvent(OrderEvent{OrderID: "1", Status: OrderStatusPartiallyFilled, ExecutedQty: d("1"),
+		LastQty: d("1"), LastPrice: d("99"), UpdateTime: created.Add(time.Second)})
+	// a late update does not move the order back
+	if store.ApplyEvent(OrderEvent{OrderID: "1", Status: OrderStatusOpen, UpdateTime: created}) {
+		t.Error("stale event applied")
+	}
+
+	order, ok := store.Order("1")
+	if !ok {
+		t.Fatal("order not stored")
+	}
+	if order.Tif != TimeInForceIOC || !order.CreateTime.Equal(created) {
+		t.Errorf("Tif %q, CreateTime %s, want IOC, %s", order.Tif, order.CreateTime, created)
+	}
+	if order.Status != OrderStatusPartiallyFilled || !order.ExecutedQty.Equal(d("1")) || !order.Price.Equal(d("100")) {
+		t.Errorf("order = %+v", order)
+	}
+}
+
+func TestOrderStoreFresh(t *testing.T) {
+	open := OrderEvent{OrderID: "1", Symbol: "BTCUSDT", Status: OrderStatusOpen, Quantity: d("1")}
+	filled := OrderEvent{OrderID: "1", Symbol: "BTCUSDT", Status: OrderStatusFilled, ExecutedQty: d("1")}
+	tests := []struct {
+		name   string
+		event  OrderEvent
+		synced bool
+		live   bool
+		maxAge time.Duration
+		age    time.Duration
+		want   bool
+	}{
+		{name: "open while synced", event: open, synced: true, live: true, want: true},
+		{name: "open while not synced", event: open, live: true},
+		{name: "open while the feed is down", event: open, synced: true},
+		{name: "open within MaxAge", event: open, synced: true, live: true, maxAge: time.Minute, age: time.Second, want: true},
+		{name: "open beyond MaxAge", event: open, synced: true, live: true, maxAge: time.Minute, age: time.Hour},
+		{name: "final while not synced", event: filled, maxAge: time.Minute, age: time.Hour, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewOrderStore(nil, nil)
+			store.MaxAge = tt.maxAge
+			store.Live = func() bool { return tt.live }
+			store.ApplyEvent(tt.event)
+			store.orders["1"].updated = time.Now().Add(-tt.age)
+			store.synced = tt.synced
+
+			if _, ok := store.Fresh("1"); ok != tt.want {
+				t.Errorf("Fresh() = %v, want %v", ok, tt.want)
+			}
+		})
+	}
+	if _, ok := NewOrderStore(nil, nil).Fresh("1"); ok {
+		t.Error("Fresh() of an unknown order")
+	}
+}
+
+func TestOrderStoreReconcile(t *testing.T) {
+	tests := []struct {
+		name       string
+		openErr    error
+		fetchErr   error
+		wantSynced bool
+		wantStatus OrderStatus // of order 2, open in the store but not listed by the exchange
+	}{
+		{name: "missing order fetched", wantSynced: true, wantStatus: OrderStatusFilled},
+		{name: "open orders failed", openErr: errors.New("down"), wantStatus: OrderStatusOpen},
+		{name: "missing order failed", fetchErr: errors.New("down"), wantStatus: OrderStatusOpen},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var fetched []string
+			store := NewOrderStore(func(symbol, orderId string) (*OrderResponseFull, error) {
+				fetched = append(fetched, orderId)
+				if tt.fetchErr != nil {
+					return nil, tt.fetchErr
+				}
+				return &OrderResponseFull{OrderResponse: OrderResponse{OrderID: orderId, Symbol: symbol, Status: OrderStatusFilled},
+					ExecutedQty: d("1")}, nil
+			}, func(symbol string) ([]OrderResponseFull, error) {
+				if tt.openErr != nil {
+					return nil, tt.openErr
+				}
+				return []OrderResponseFull{{OrderResponse: OrderResponse{OrderID: "1", Symbol: "BTCUSDT", Status: OrderStatusOpen}}}, nil
+			})
+			store.ApplyEvent(OrderEvent{OrderID: "1", Symbol: "BTCUSDT", Status: OrderStatusOpen})
+			store.ApplyEvent(OrderEvent{OrderID: "2", Symbol: "BTCUSDT", Status: OrderStatusOpen})
+
+			err := store.Reconcile()
+			if (err == nil) != tt.wantSynced || store.Synced() != tt.wantSynced {
+				t.Errorf("Reconcile() error = %v, Synced() = %v, want synced %v", err, store.Synced(), tt.wantSynced)
+			}
+			if tt.openErr == nil && (len(fetched) != 1 || fetched[0] != "2") {
+				t.Errorf("fetched %v, want only the missing order 2", fetched)
+			}
+			if order, _ := store.Order("2"); order.Status != tt.wantStatus {
+				t.Errorf("order 2 is %s, want %s", order.Status, tt.wantStatus)
+			}
+		})
+	}
+}
+
+// orderFeed is a PrivateClient streaming the order events sent to ch
+type orderFeed struct {
+	PrivateClient
+	ch         chan OrderEvent
+	errHandler chan func(err error)
+}
+
+func (f orderFeed) SubscribeOrderEvents(ctx context.Context, symbols []string, errHandler func(err error)) (<-chan OrderEvent, error) {
+	f.errHandler <- errHandler
+	return f.ch, nil
+}
+
+func TestOrderStoreTrack(t *testing.T) {
+	var reconciles atomic.Int32
+	store := NewOrderStore(func(symbol, orderId string) (*OrderResponseFull, error) {
+		return &OrderResponseFull{OrderResponse: OrderResponse{OrderID: orderId, Symbol: symbol, Status: OrderStatusCanceled}}, nil
+	}, func(symbol string) ([]OrderResponseFull, error) {
+		reconciles.Add(1)
+		return nil, nil
+	})
+	feed := orderFeed{ch: make(chan OrderEvent), errHandler: make(chan func(err error), 1)}
+	errs := make(chan error, 1)
+	if err := store.Track(context.Background(), feed, nil, func(err error) { errs <- err }); err != nil {
+		t.Fatalf("Track() error = %v", err)
+	}
+	streamErr := <-feed.errHandler
+	if !store.Synced() || reconciles.Load() != 1 {
+		t.Fatalf("Synced() = %v, reconciles %d after Track", store.Synced(), reconciles.Load())
+	}
+
+	feed.ch <- OrderEvent{OrderID: "1", Symbol: "BTCUSDT", Status: OrderStatusOpen}
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if _, ok := store.Fresh("1"); ok {
+			break
+		}
+	}
+	if _, ok := store.Fresh("1"); !ok {
+		t.Fatal("event not applied")
+	}
+
+	// a gap invalidates the store until it is reconciled again
+	streamErr(&StreamGapError{From: time.Now(), To: time.Now()})
+	if err := <-errs; !errors.Is(err, ErrStreamGap) {
+		t.Errorf("errHandler got %v, want the gap", err)
+	}
+	for deadline := time.Now().Add(time.Second); reconciles.Load() < 2 && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+	}
+	if n := reconciles.Load(); n != 2 {
+		t.Errorf("reconciles = %d after a gap, want 2", n)
+	}
+
+	// a closed stream leaves the store unsynced
+	close(feed.ch)
+	for deadline := time.Now().Add(time.Second); store.Synced() && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+	}
+	if store.Synced() {
+		t.Error("store synced after the stream closed")
+	}
+}
+
+func TestOrderStorePrune(t *testing.T) {
+	store := NewOrderStore(nil, nil)
+	store.ApplyEvent(OrderEvent{OrderID: "open", Status: OrderStatusOpen})
+	store.ApplyEvent(OrderEvent{OrderID: "old", Status: OrderStatusFilled, ExecutedQty: d("1")})
+	store.ApplyEvent(OrderEvent{OrderID: "recent", Status: OrderStatusCanceled})
+	store.orders["open"].updated = time.Now().Add(-2 * time.Hour)
+	store.orders["old"].updated = time.Now().Add(-2 * time.Hour)
+
+	store.Prune(time.Hour)
+	for id, want := range map[string]bool{"open": true, "old": false, "recent": true} {
+		if _, ok := store.Order(id); ok != want {
+			t.Errorf("order %s kept %v, want %v", id, ok, want)
+		}
+	}
+}
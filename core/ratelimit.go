@@ -0,0 +1,284 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RateCost is the weight a request consumes in one rate limit category
+type RateCost struct {
+	Category RateLimitCategory
+	Weight   int64
+}
+
+type rateBucket struct {
+	limit    RateLimit
+	tokens   float64
+	perSec   float64 // refill rate
+	lastFill time.Time
+}
+
+func newRateBucket(rl RateLimit, now time.Time) *rateBucket {
+	b := &rateBucket{
+		limit:    rl,
+		tokens:   float64(rl.Limit - rl.Count),
+		perSec:   float64(rl.Limit) / rl.Interval.Seconds(),
+		lastFill: now,
+	}
+	if b.tokens < 0 {
+		b.tokens = 0
+	}
+	return b
+}
+
+func (b *rateBucket) refill(now time.Time) {
+	elapsed := now.Sub(b.lastFill).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+	b.tokens = math.Min(float64(b.limit.Limit), b.tokens+elapsed*b.perSec)
+	b.lastFill = now
+}
+
+// waitFor returns how long until weight tokens are available
+func (b *rateBucket) waitFor(weight int64) time.Duration {
+	missing := float64(weight) - b.tokens
+	if missing <= 0 {
+		return 0
+	}
+	return time.Duration(missing / b.perSec * float64(time.Second))
+}
+
+// RateLimiter enforces exchange rate limits on the client side with a token bucket per
+// RateLimit. Usage reported by the exchange is fed back with Observe so that requests
+// sent from other processes on the same IP or account are accounted for too.
+type RateLimiter struct {
+	exchange string
+
+	mu          sync.Mutex
+	buckets     []*rateBucket
+	pausedUntil time.Time
+	maxWait     time.Duration // negative waits without bound
+}
+
+func NewRateLimiter(exchange string, limits []RateLimit) *RateLimiter {
+	l := &RateLimiter{exchange: exchange, maxWait: -1}
+	l.SetLimits(limits)
+	return l
+}
+
+// SetLimits replaces the enforced limits, e.g. with MarketRule.RateLimits fetched from the exchange
+func (l *RateLimiter) SetLimits(limits []RateLimit) {
+	now := time.Now()
+	buckets := make([]*rateBucket, 0, len(limits))
+	for _, rl := range limits {
+		if rl.Limit <= 0 || rl.Interval <= 0 {
+			continue
+		}
+		buckets = append(buckets, newRateBucket(rl, now))
+	}
+	l.mu.Lock()
+	l.buckets = buckets
+	l.mu.Unlock()
+}
+
+// SetMaxWait makes Wait fail fast with a rate limited error instead of blocking longer than d.
+// Zero never blocks, a negative duration blocks as long as needed.
+func (l *RateLimiter) SetMaxWait(d time.Duration) {
+	l.mu.Lock()
+	l.maxWait = d
+	l.mu.Unlock()
+}
+
+// Wait blocks until every cost fits into its buckets and consumes them. It fails when ctx
+// is done, a cost can never fit, or the wait would exceed the max wait.
+func (l *RateLimiter) Wait(ctx context.Context, costs ...RateCost) error {
+	for {
+		l.mu.Lock()
+		now := time.Now()
+		wait := l.pausedUntil.Sub(now)
+		for _, cost := range costs {
+			for _, b := range l.buckets {
+				if b.limit.Category != cost.Category {
+					continue
+				}
+				if cost.Weight > b.limit.Limit {
+					l.mu.Unlock()
+					return NewExchangeError(l.exchange, ErrCategoryRateLimited, "",
+						fmt.Sprintf("request weight %d exceeds %s limit %d", cost.Weight, cost.Category, b.limit.Limit))
+				}
+				b.refill(now)
+				if w := b.waitFor(cost.Weight); w > wait {
+					wait = w
+				}
+			}
+		}
+		if wait <= 0 {
+			for _, cost := range costs {
+				for _, b := range l.buckets {
+					if b.limit.Category == cost.Category {
+						b.tokens -= float64(cost.Weight)
+					}
+				}
+			}
+			l.mu.Unlock()
+			return nil
+		}
+		maxWait := l.maxWait
+		l.mu.Unlock()
+
+		if maxWait >= 0 && wait > maxWait {
+			return NewExchangeError(l.exchange, ErrCategoryRateLimited, "",
+				fmt.Sprintf("client side rate limit reached, retry in %s", wait.Round(time.Millisecond)))
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+			return NewExchangeError(l.exchange, ErrCategoryRateLimited, "",
+				fmt.Sprintf("client side rate limit reached, retry in %s exceeds deadline", wait.Round(time.Millisecond)))
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// Observe syncs the bucket of category and interval with the usage reported by the exchange
+func (l *RateLimiter) Observe(category RateLimitCategory, interval time.Duration, used int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for _, b := range l.buckets {
+		if b.limit.Category != category || b.limit.Interval != interval {
+			continue
+		}
+		b.refill(now)
+		if left := float64(b.limit.Limit - used); left < b.tokens {
+			b.tokens = math.Max(left, 0)
+		}
+	}
+}
+
+// ObserveRemaining syncs the bucket of category and interval with the weight the exchange
+// reports left, for exchanges reporting what is left instead of what is used
+func (l *RateLimiter) ObserveRemaining(category RateLimitCategory, interval time.Duration, remaining int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	for _, b := range l.buckets {
+		if b.limit.Category != category || b.limit.Interval != interval {
+			continue
+		}
+		b.refill(now)
+		if left := float64(remaining); left < b.tokens {
+			b.tokens = math.Max(left, 0)
+		}
+	}
+}
+
+// Pause blocks every request until t, used when the exchange asks to back off (e.g. HTTP 429 Retry-After)
+func (l *RateLimiter) Pause(until time.Time) {
+	l.mu.Lock()
+	if until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+	l.mu.Unlock()
+}
+
+// ObserveHeader feeds Binance style usage headers (X-MBX-USED-WEIGHT-1M, X-MBX-ORDER-COUNT-10S)
+// and Retry-After into the limiter
+func (l *RateLimiter) ObserveHeader(h http.Header) {
+	for key, values := range h {
+		if len(values) == 0 {
+			continue
+		}
+		upper := strings.ToUpper(key)
+		var category RateLimitCategory
+		var suffix string
+		switch {
+		case strings.HasPrefix(upper, "X-MBX-USED-WEIGHT-"):
+			category, suffix = RateLimitRequest, strings.TrimPrefix(upper, "X-MBX-USED-WEIGHT-")
+		case strings.HasPrefix(upper, "X-MBX-ORDER-COUNT-"):
+			category, suffix = RateLimitOrder, strings.TrimPrefix(upper, "X-MBX-ORDER-COUNT-")
+		case upper == "RETRY-AFTER":
+			if secs, err := strconv.ParseInt(values[0], 10, 64); err == nil {
+				l.Pause(time.Now().Add(time.Duration(secs) * time.Second))
+			}
+			continue
+		default:
+			continue
+		}
+		interval, ok := parseRateInterval(suffix)
+		if !ok {
+			continue
+		}
+		used, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		l.Observe(category, interval, used)
+	}
+}
+
+// parseRateInterval parses interval suffixes like 10S, 1M, 1H and 1D
+func parseRateInterval(s string) (time.Duration, bool) {
+	if len(s) < 2 {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(s[:len(s)-1], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	switch s[len(s)-1] {
+	case 'S':
+		return time.Duration(n) * time.Second, true
+	case 'M':
+		return time.Duration(n) * time.Minute, true
+	case 'H':
+		return time.Duration(n) * time.Hour, true
+	case 'D':
+		return time.Duration(n) * 24 * time.Hour, true
+	}
+	return 0, false
+}
+
+// LimitedTransport is an http.RoundTripper that waits on Limiter before every request and
+// feeds the answers back into it, for REST clients and SDKs that take an *http.Client
+type LimitedTransport struct {
+	Base    http.RoundTripper // http.DefaultTransport when nil
+	Limiter *RateLimiter
+	Cost    func(req *http.Request) []RateCost  // weights of a request, one REQUEST when nil
+	Observe func(l *RateLimiter, h http.Header) // syncs the limiter with the answer, ObserveHeader when nil
+}
+
+func (t *LimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	costs := []RateCost{{Category: RateLimitRequest, Weight: 1}}
+	if t.Cost != nil {
+		costs = t.Cost(req)
+	}
+	if err := t.Limiter.Wait(req.Context(), costs...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrRequestNotSent, err)
+	}
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	resp, err := base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if t.Observe != nil {
+		t.Observe(t.Limiter, resp.Header)
+	} else {
+		t.Limiter.ObserveHeader(resp.Header)
+	}
+	return resp, nil
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestLimitedTransport(t *testing.T) {
+	// the exchange also counts the requests of other clients
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Remaining", "1")
+	}))
+	defer srv.Close()
+
+	limiter := NewRateLimiter("test", []RateLimit{{Category: RateLimitRequest, Interval: time.Hour, Limit: 10}})
+	limiter.SetMaxWait(0)
+	client := &http.Client{Transport: &LimitedTransport{
+		Limiter: limiter,
+		Observe: func(l *RateLimiter, h http.Header) {
+			left, _ := strconv.ParseInt(h.Get("X-Remaining"), 10, 64)
+			l.ObserveRemaining(RateLimitRequest, time.Hour, left)
+		},
+	}}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	resp.Body.Close()
+	resp, err = client.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+	resp.Body.Close()
+	if _, err := client.Get(srv.URL); ErrorCategoryOf(err) != ErrCategoryRateLimited {
+		t.Errorf("third request error = %v, want rate limited", err)
+	}
+}
@@ -0,0 +1,279 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Tick returns the price tick of r at price
+func (r MarketRule) Tick(price decimal.Decimal) decimal.Decimal {
+	if r.TickFunc != nil {
+		return r.TickFunc(price)
+	}
+	return r.TickSize
+}
+
+// RoundPrice rounds price to a tick of rule on the passive side, down for a buy and up for
+// a sell, so the order never trades at a worse price than asked. Without a tick the price
+// is returned as is.
+func RoundPrice(rule MarketRule, price decimal.Decimal, side OrderSide) decimal.Decimal {
+	// a tick table may have another tick at the rounded price, which is then rounded again
+	for i := 0; i < 2; i++ {
+		tick := rule.Tick(price)
+		if !tick.IsPositive() {
+			break
+		}
+		steps := price.Div(tick)
+		if side == OrderSideSell {
+			steps = steps.Ceil()
+		} else {
+			steps = steps.Floor()
+		}
+		rounded := steps.Mul(tick)
+		if rounded.Equal(price) {
+			break
+		}
+		price = rounded
+	}
+	return price
+}
+
+// RoundQty rounds qty down to a step of rule, so the order is never larger than asked.
+// Without a step the quantity is returned as is.
+func RoundQty(rule MarketRule, qty decimal.Decimal) decimal.Decimal {
+	if !rule.StepSize.IsPositive() {
+		return qty
+	}
+	return qty.Div(rule.StepSize).Floor().Mul(rule.StepSize)
+}
+
+// NormalizeOrder rounds the price and quantity of req to rule, see RoundPrice and RoundQty,
+// and validates the result. Call it before PlaceOrder so the exchange does not reject the
+// order for its filters.
+func NormalizeOrder(rule MarketRule, req *OrderRequest) error {
+	roundOrder(rule, req)
+	return ValidateOrder(rule, *req)
+}
+
+// roundOrder rounds the price and quantity of req to rule
+func roundOrder(rule MarketRule, req *OrderRequest) {
+	if req.Type == OrderTypeLimit {
+		req.Price = RoundPrice(rule, req.Price, req.Side)
+	}
+	if req.Quantity.IsPositive() {
+		req.Quantity = RoundQty(rule, req.Quantity)
+	}
+}
+
+// ValidateOrder checks the price, quantity and value of req against the filters of rule,
+// limits that are zero are not checked. An order below the min quantity or notional fails
+// with an *OrderTooSmallError. The value of a market order with a base quantity is not known
+// here, ValidateOrderAt checks it and the price band.
+func ValidateOrder(rule MarketRule, req OrderRequest) error {
+	return validateOrder(rule, req, decimal.Zero)
+}
+
+// ValidateOrderAt validates req like ValidateOrder at ref, the reference price of the exchange,
+// which also checks the price band of a limit order and the value of a market order
+func ValidateOrderAt(rule MarketRule, req OrderRequest, ref decimal.Decimal) error {
+	if err := validateOrder(rule, req, ref); err != nil {
+		return err
+	}
+	if req.Type != OrderTypeLimit || !ref.IsPositive() {
+		return nil
+	}
+	band := rule.BuyPriceBand
+	if req.Side == OrderSideSell {
+		band = rule.SellPriceBand
+	}
+	if band.Up.IsPositive() && req.Price.GreaterThan(ref.Mul(band.Up)) {
+		return fmt.Errorf("%s: price %s is above %s times the reference price %s", req.Symbol, req.Price, band.Up, ref)
+	}
+	if band.Down.IsPositive() && req.Price.LessThan(ref.Mul(band.Down)) {
+		return fmt.Errorf("%s: price %s is below %s times the reference price %s", req.Symbol, req.Price, band.Down, ref)
+	}
+	return nil
+}
+
+// validateOrder checks req, valuing a market order with a base quantity at ref if positive
+func validateOrder(rule MarketRule, req OrderRequest, ref decimal.Decimal) error {
+	if rule.Symbol != "" && req.Symbol != rule.Symbol {
+		return fmt.Errorf("order for %s validated against the rule of %s", req.Symbol, rule.Symbol)
+	}
+	if req.Type == OrderTypeLimit {
+		price := req.Price
+		if !RoundPrice(rule, price, OrderSideBuy).Equal(price) {
+			return fmt.Errorf("%s: price %s is not a multiple of the tick %s", req.Symbol, price, rule.Tick(price))
+		}
+		if rule.MinPrice.IsPositive() && price.LessThan(rule.MinPrice) {
+			return fmt.Errorf("%s: price %s is below the min price %s", req.Symbol, price, rule.MinPrice)
+		}
+		if rule.MaxPrice.IsPositive() && price.GreaterThan(rule.MaxPrice) {
+			return fmt.Errorf("%s: price %s is above the max price %s", req.Symbol, price, rule.MaxPrice)
+		}
+	}
+	if qty := req.Quantity; req.QuoteQuantity.IsZero() && !qty.IsPositive() {
+		// rounded down to nothing
+		return &OrderTooSmallError{Symbol: req.Symbol, Amount: qty, Min: decimal.Max(rule.MinQty, rule.StepSize)}
+	} else if qty.IsPositive() {
+		if !RoundQty(rule, qty).Equal(qty) {
+			return fmt.Errorf("%s: quantity %s is not a multiple of the step %s", req.Symbol, qty, rule.StepSize)
+		}
+		if rule.MinQty.IsPositive() && qty.LessThan(rule.MinQty) {
+			return &OrderTooSmallError{Symbol: req.Symbol, Amount: qty, Min: rule.MinQty}
+		}
+		if rule.MaxQty.IsPositive() && qty.GreaterThan(rule.MaxQty) {
+			return fmt.Errorf("%s: quantity %s is above the max quantity %s", req.Symbol, qty, rule.MaxQty)
+		}
+		if req.Type == OrderTypeMarket && rule.MarketMaxQty.IsPositive() && qty.GreaterThan(rule.MarketMaxQty) {
+			return fmt.Errorf("%s: quantity %s is above the max market order quantity %s", req.Symbol, qty, rule.MarketMaxQty)
+		}
+	}
+	var notional decimal.Decimal
+	switch {
+	case req.Type == OrderTypeLimit:
+		notional = req.Price.Mul(req.Quantity)
+	case req.QuoteQuantity.IsPositive():
+		notional = req.QuoteQuantity
+	default:
+		notional = ref.Mul(req.Quantity)
+	}
+	if !notional.IsPositive() {
+		return nil // not known
+	}
+	if rule.MinNotional.IsPositive() && notional.LessThan(rule.MinNotional) {
+		return &OrderTooSmallError{Symbol: req.Symbol, Notional: true, Amount: notional, Min: rule.MinNotional}
+	}
+	if rule.MaxNotional.IsPositive() && notional.GreaterThan(rule.MaxNotional) {
+		return fmt.Errorf("%s: order value %s is above the max notional %s", req.Symbol, notional, rule.MaxNotional)
+	}
+	return nil
+}
+
+// RuleCache keeps the market rules of symbols for the placement path of an adapter. A rule is
+// fetched on the first order of its symbol and again in the background once it is older
+// than the ttl, placement only waits for the first fetch of a symbol.
+type RuleCache struct {
+	fetch func(symbol string) ([]MarketRule, error)
+	ttl   time.Duration
+
+	mu       sync.Mutex
+	rules    map[string]cachedRule
+	tried    map[string]time.Time  // last failed fetch of a symbol, or one that returned no rule for it
+	inflight map[string]*ruleFetch // symbol : running fetch
+}
+
+type cachedRule struct {
+	rule    MarketRule
+	fetched time.Time
+}
+
+type ruleFetch struct {
+	done chan struct{}
+	err  error
+}
+
+// DefaultRuleTTL is the age after which the adapters fetch a cached rule again
+const DefaultRuleTTL = time.Hour
+
+// ruleRetry bounds the fetches for a symbol whose last fetch failed or returned no rule
+const ruleRetry = time.Minute
+
+// NewRuleCache returns a cache fetching rules with fetch, which returns the rules of symbol
+// and may return those of other symbols with them, e.g. of every symbol of the exchange
+func NewRuleCache(fetch func(symbol string) ([]MarketRule, error), ttl time.Duration) *RuleCache {
+	return &RuleCache{
+		fetch:    fetch,
+		ttl:      ttl,
+		rules:    make(map[string]cachedRule),
+		tried:    make(map[string]time.Time),
+		inflight: make(map[string]*ruleFetch),
+	}
+}
+
+// Rule returns the rule of symbol. A symbol without a rule waits for its fetch, a concurrent
+// call joins the running one. A rule older than the ttl is returned as is and fetched again
+// in the background, it is kept when that fetch fails.
+func (c *RuleCache) Rule(symbol string) (MarketRule, error) {
+	c.mu.Lock()
+	cached, ok := c.rules[symbol]
+	if ok && time.Since(cached.fetched) < c.ttl {
+		c.mu.Unlock()
+		return cached.rule, nil
+	}
+	f, running := c.inflight[symbol]
+	if !running {
+		if time.Since(c.tried[symbol]) < ruleRetry {
+			c.mu.Unlock()
+			if ok {
+				return cached.rule, nil
+			}
+			return MarketRule{}, fmt.Errorf("no market rule for %s", symbol)
+		}
+		f = &ruleFetch{done: make(chan struct{})}
+		c.inflight[symbol] = f
+		go c.load(symbol, f)
+	}
+	c.mu.Unlock()
+	if ok {
+		return cached.rule, nil
+	}
+
+	<-f.done
+	c.mu.Lock()
+	cached, ok = c.rules[symbol]
+	c.mu.Unlock()
+	if ok {
+		return cached.rule, nil
+	}
+	if f.err != nil {
+		return MarketRule{}, fmt.Errorf("fetch market rule of %s: %w", symbol, f.err)
+	}
+	return MarketRule{}, fmt.Errorf("no market rule for %s", symbol)
+}
+
+// load fetches the rules of symbol outside the lock and completes f
+func (c *RuleCache) load(symbol string, f *ruleFetch) {
+	rules, err := c.fetch(symbol)
+	now := time.Now()
+	c.mu.Lock()
+	for _, rule := range rules {
+		c.rules[rule.Symbol] = cachedRule{rule: rule, fetched: now}
+	}
+	// a failed fetch or one without the rule of symbol is not repeated right away
+	if err != nil || !c.rules[symbol].fetched.Equal(now) {
+		c.tried[symbol] = now
+	}
+	f.err = err
+	delete(c.inflight, symbol)
+	c.mu.Unlock()
+	close(f.done)
+}
+
+// Prepare prepares req like OrderRequest.Prepare, then rounds it to the rule of its symbol and
+// validates it with ValidateOrder. Price bands and the value of market orders with a base
+// quantity need a reference price and are left to the exchange, like orders of symbols
+// without a rule, e.g. because the fetch failed. A nil cache only prepares.
+func (c *RuleCache) Prepare(req *OrderRequest) error {
+	if err := req.Prepare(); err != nil {
+		return err
+	}
+	if c == nil {
+		return nil
+	}
+	rule, err := c.Rule(req.Symbol)
+	if err != nil {
+		return nil
+	}
+	roundOrder(rule, req)
+	return ValidateOrder(rule, *req)
+}
+
+// PlaceBatches is core.PlaceBatches with the orders prepared by Prepare
+func (c *RuleCache) PlaceBatches(ctx context.Context, reqs []OrderRequest, size int, place PlaceBatchFunc) ([]OrderResult, error) {
+	return placeBatches(ctx, reqs, size, c.Prepare, place)
+}
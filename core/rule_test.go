@@ -0,0 +1,388 @@
+package core
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+// tableTick is a price dependent tick table like the KRW market of upbit
+func tableTick(price decimal.Decimal) decimal.Decimal {
+	switch {
+	case price.GreaterThanOrEqual(d("1000")):
+		return d("5")
+	case price.GreaterThanOrEqual(d("100")):
+		return d("1")
+	default:
+		return d("0.1")
+	}
+}
+
+func TestTick(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  MarketRule
+		price string
+		want  string
+	}{
+		{"fixed", MarketRule{TickSize: d("0.01")}, "123.456", "0.01"},
+		{"table low", MarketRule{TickSize: d("0.01"), TickFunc: tableTick}, "99.9", "0.1"},
+		{"table mid", MarketRule{TickSize: d("0.01"), TickFunc: tableTick}, "100", "1"},
+		{"table high", MarketRule{TickSize: d("0.01"), TickFunc: tableTick}, "2500", "5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Tick(d(tt.price)); !got.Equal(d(tt.want)) {
+				t.Errorf("Tick(%s) = %s, want %s", tt.price, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRoundPrice(t *testing.T) {
+	fixed := MarketRule{TickSize: d("0.05")}
+	table := MarketRule{TickFunc: tableTick}
+	tests := []struct {
+		name  string
+		rule  MarketRule
+		price string
+		side  OrderSide
+		want  string
+	}{
+		{"buy down", fixed, "10.07", OrderSideBuy, "10.05"},
+		{"sell up", fixed, "10.07", OrderSideSell, "10.1"},
+		{"on tick", fixed, "10.05", OrderSideSell, "10.05"},
+		{"no tick", MarketRule{}, "10.07", OrderSideBuy, "10.07"},
+		{"table buy", table, "1234", OrderSideBuy, "1230"},
+		{"table sell", table, "1234", OrderSideSell, "1235"},
+		// 99.95 rounds up to 100, where the tick is 1
+		{"table sell across", table, "99.95", OrderSideSell, "100"},
+		// 999.5 rounds up to 1000, where the tick is 5
+		{"table sell into larger tick", table, "999.5", OrderSideSell, "1000"},
+		{"table buy below boundary", table, "1002", OrderSideBuy, "1000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := RoundPrice(tt.rule, d(tt.price), tt.side)
+			if !got.Equal(d(tt.want)) {
+				t.Errorf("RoundPrice(%s, %s) = %s, want %s", tt.price, tt.side, got, tt.want)
+			}
+			if tt.rule.Tick(got).IsPositive() && !RoundPrice(tt.rule, got, OrderSideBuy).Equal(got) {
+				t.Errorf("RoundPrice(%s, %s) = %s is not on a tick", tt.price, tt.side, got)
+			}
+		})
+	}
+}
+
+func TestRoundQty(t *testing.T) {
+	tests := []struct {
+		name string
+		step string
+		qty  string
+		want string
+	}{
+		{"down", "0.001", "1.23456", "1.234"},
+		{"on step", "0.001", "1.234", "1.234"},
+		{"below step", "0.01", "0.009", "0"},
+		{"integer step", "5", "12", "10"},
+		{"no step", "0", "1.23456", "1.23456"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := MarketRule{StepSize: d(tt.step)}
+			if got := RoundQty(rule, d(tt.qty)); !got.Equal(d(tt.want)) {
+				t.Errorf("RoundQty(%s) = %s, want %s", tt.qty, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeOrder(t *testing.T) {
+	rule := MarketRule{
+		Symbol:   "BTCUSDT",
+		TickSize: d("0.1"),
+		StepSize: d("0.001"),
+		MinQty:   d("0.001"),
+		MaxQty:   d("100"),
+		MinPrice: d("1"),
+		MaxPrice: d("1000000"),
+	}
+	tests := []struct {
+		name      string
+		req       OrderRequest
+		wantPrice string
+		wantQty   string
+		wantErr   bool
+		tooSmall  bool
+	}{
+		{
+			name:      "limit buy",
+			req:       OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("50000.17"), Quantity: d("0.12345")},
+			wantPrice: "50000.1", wantQty: "0.123",
+		},
+		{
+			name:      "limit sell",
+			req:       OrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: OrderTypeLimit, Price: d("50000.11"), Quantity: d("0.12345")},
+			wantPrice: "50000.2", wantQty: "0.123",
+		},
+		{
+			name:    "market qty",
+			req:     OrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: OrderTypeMarket, Quantity: d("1.0009")},
+			wantQty: "1",
+		},
+		{
+			name:     "rounded below min qty",
+			req:      OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("50000"), Quantity: d("0.0009")},
+			wantErr:  true,
+			tooSmall: true,
+		},
+		{
+			name:    "above max qty",
+			req:     OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("50000"), Quantity: d("101")},
+			wantErr: true,
+		},
+		{
+			name:    "below min price",
+			req:     OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("0.5"), Quantity: d("1")},
+			wantErr: true,
+		},
+		{
+			name:    "other symbol",
+			req:     OrderRequest{Symbol: "ETHUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("3000"), Quantity: d("1")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.req
+			err := NormalizeOrder(rule, &req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NormalizeOrder() error = %v, want error %v", err, tt.wantErr)
+			}
+			var small OrderAmountTooSmall
+			if tt.tooSmall && !errors.As(err, &small) {
+				t.Errorf("NormalizeOrder() error = %v, want OrderAmountTooSmall", err)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tt.wantPrice != "" && !req.Price.Equal(d(tt.wantPrice)) {
+				t.Errorf("price = %s, want %s", req.Price, tt.wantPrice)
+			}
+			if !req.Quantity.Equal(d(tt.wantQty)) {
+				t.Errorf("quantity = %s, want %s", req.Quantity, tt.wantQty)
+			}
+		})
+	}
+}
+
+func TestRuleCache(t *testing.T) {
+	var fetches atomic.Int32
+	var fail atomic.Bool
+	cache := NewRuleCache(func(symbol string) ([]MarketRule, error) {
+		fetches.Add(1)
+		if fail.Load() {
+			return nil, errors.New("down")
+		}
+		return []MarketRule{
+			{Symbol: "BTCUSDT", TickSize: d("0.1"), StepSize: d("0.001"), BuyPriceBand: PriceBand{Up: d("1.05")}},
+			{Symbol: "ETHUSDT", TickSize: d("0.01"), StepSize: d("0.01")},
+		}, nil
+	}, time.Hour)
+
+	if _, err := cache.Rule("BTCUSDT"); err != nil {
+		t.Fatalf("Rule() error = %v", err)
+	}
+	if _, err := cache.Rule("ETHUSDT"); err != nil {
+		t.Fatalf("Rule() error = %v", err)
+	}
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("fetches = %d, want 1 for the rules fetched together", n)
+	}
+
+	// a symbol without a rule is not fetched again right away
+	if _, err := cache.Rule("XRPUSDT"); err == nil {
+		t.Error("Rule() of an unknown symbol succeeded")
+	}
+	if _, err := cache.Rule("XRPUSDT"); err == nil {
+		t.Error("Rule() of an unknown symbol succeeded")
+	}
+	if n := fetches.Load(); n != 2 {
+		t.Errorf("fetches = %d, want 2", n)
+	}
+
+	// a stale rule is returned at once and kept when the refresh fails
+	cache.mu.Lock()
+	cache.rules["BTCUSDT"] = cachedRule{rule: cache.rules["BTCUSDT"].rule, fetched: time.Now().Add(-2 * time.Hour)}
+	cache.mu.Unlock()
+	fail.Store(true)
+	rule, err := cache.Rule("BTCUSDT")
+	if err != nil || !rule.TickSize.Equal(d("0.1")) {
+		t.Errorf("Rule() of a stale rule = %v, %v", rule, err)
+	}
+	waitRuleFetches(t, cache)
+	rule, err = cache.Rule("BTCUSDT")
+	if err != nil || !rule.TickSize.Equal(d("0.1")) {
+		t.Errorf("Rule() after a failed refresh = %v, %v", rule, err)
+	}
+	if n := fetches.Load(); n != 3 {
+		t.Errorf("fetches = %d, want 3", n)
+	}
+
+	req := OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("100.17"), Quantity: d("1.2345")}
+	if err := cache.Prepare(&req); err != nil {
+		t.Fatalf("Prepare() error = %v", err)
+	}
+	if !req.Price.Equal(d("100.1")) || !req.Quantity.Equal(d("1.234")) || req.ClientOrderID == "" {
+		t.Errorf("Prepare() = %s @ %s id %q", req.Quantity, req.Price, req.ClientOrderID)
+	}
+
+	// price bands need a reference price and are left to the exchange
+	req = OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("1000000"), Quantity: d("1")}
+	if err := cache.Prepare(&req); err != nil {
+		t.Errorf("Prepare() of a buy far above the last price = %v", err)
+	}
+
+	// orders of symbols without a rule are left to the exchange
+	req = OrderRequest{Symbol: "XRPUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("0.51234"), Quantity: d("10")}
+	if err := cache.Prepare(&req); err != nil || !req.Price.Equal(d("0.51234")) {
+		t.Errorf("Prepare() without a rule = %s, %v", req.Price, err)
+	}
+
+	var none *RuleCache
+	req = OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeLimit, Price: d("100.17"), Quantity: d("1")}
+	if err := none.Prepare(&req); err != nil || !req.Price.Equal(d("100.17")) {
+		t.Errorf("Prepare() of a nil cache = %s, %v", req.Price, err)
+	}
+}
+
+func TestRuleCacheConcurrentFetch(t *testing.T) {
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	cache := NewRuleCache(func(symbol string) ([]MarketRule, error) {
+		fetches.Add(1)
+		<-release
+		return []MarketRule{{Symbol: "BTCUSDT", TickSize: d("0.1"), StepSize: d("0.001")}}, nil
+	}, time.Hour)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Rule("BTCUSDT"); err != nil {
+				t.Errorf("Rule() error = %v", err)
+			}
+		}()
+	}
+	// the cache is not locked during the fetch
+	for deadline := time.Now().Add(time.Second); fetches.Load() == 0 && time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+	}
+	done := make(chan struct{})
+	go func() {
+		cache.mu.Lock()
+		cache.mu.Unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("cache locked during the fetch")
+	}
+	close(release)
+	wg.Wait()
+	if n := fetches.Load(); n != 1 {
+		t.Errorf("fetches = %d, want 1 for concurrent calls", n)
+	}
+}
+
+// waitRuleFetches waits for the background fetches of cache
+func waitRuleFetches(t *testing.T, cache *RuleCache) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		cache.mu.Lock()
+		n := len(cache.inflight)
+		cache.mu.Unlock()
+		if n == 0 {
+			return
+		}
+	}
+	t.Fatal("rule fetch not done")
+}
+
+func TestValidateOrderAt(t *testing.T) {
+	rule := MarketRule{
+		Symbol:        "BTCUSDT",
+		TickSize:      d("0.1"),
+		StepSize:      d("0.001"),
+		MinNotional:   d("10"),
+		MaxNotional:   d("100000"),
+		MarketMaxQty:  d("5"),
+		BuyPriceBand:  PriceBand{Up: d("1.05")},
+		SellPriceBand: PriceBand{Down: d("0.95")},
+	}
+	limit := func(side OrderSide, price, qty string) OrderRequest {
+		return OrderRequest{Symbol: "BTCUSDT", Side: side, Type: OrderTypeLimit, Price: d(price), Quantity: d(qty)}
+	}
+	tests := []struct {
+		name     string
+		req      OrderRequest
+		ref      string
+		wantErr  bool
+		tooSmall bool
+	}{
+		{name: "limit ok", req: limit(OrderSideBuy, "100", "1"), ref: "100"},
+		{name: "limit below min notional", req: limit(OrderSideBuy, "100", "0.05"), ref: "0", wantErr: true, tooSmall: true},
+		{name: "limit above max notional", req: limit(OrderSideBuy, "100", "1001"), ref: "0", wantErr: true},
+		{name: "buy within band", req: limit(OrderSideBuy, "105", "1"), ref: "100"},
+		{name: "buy above band", req: limit(OrderSideBuy, "105.1", "1"), ref: "100", wantErr: true},
+		{name: "buy below ref", req: limit(OrderSideBuy, "50", "1"), ref: "100"},
+		{name: "sell within band", req: limit(OrderSideSell, "95", "1"), ref: "100"},
+		{name: "sell below band", req: limit(OrderSideSell, "94.9", "1"), ref: "100", wantErr: true},
+		{name: "band without ref", req: limit(OrderSideSell, "50", "1"), ref: "0"},
+		{
+			name: "market quote below min notional",
+			req:  OrderRequest{Symbol: "BTCUSDT", Side: OrderSideBuy, Type: OrderTypeMarket, QuoteQuantity: d("5")},
+			ref:  "0", wantErr: true, tooSmall: true,
+		},
+		{
+			name: "market qty valued at ref",
+			req:  OrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: OrderTypeMarket, Quantity: d("0.05")},
+			ref:  "100", wantErr: true, tooSmall: true,
+		},
+		{
+			name: "market qty without ref",
+			req:  OrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: OrderTypeMarket, Quantity: d("0.05")},
+			ref:  "0",
+		},
+		{
+			name: "market above market max qty",
+			req:  OrderRequest{Symbol: "BTCUSDT", Side: OrderSideSell, Type: OrderTypeMarket, Quantity: d("6")},
+			ref:  "0", wantErr: true,
+		},
+		{name: "limit not bound by market max qty", req: limit(OrderSideSell, "100", "6"), ref: "100"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateOrderAt(rule, tt.req, d(tt.ref))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateOrderAt() error = %v, want error %v", err, tt.wantErr)
+			}
+			var small OrderAmountTooSmall
+			if errors.As(err, &small) != tt.tooSmall {
+				t.Fatalf("ValidateOrderAt() error = %v, want OrderAmountTooSmall %v", err, tt.tooSmall)
+			}
+			if tt.tooSmall && small.MinAmount() != 10 {
+				t.Errorf("MinAmount() = %v, want 10", small.MinAmount())
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// StreamSubscribeFunc sends the subscription requests on a freshly dialed connection.
+// It is replayed on every reconnect.
+type StreamSubscribeFunc func(s *Stream) error
+
+// StreamMessageHandler handles a single message read from the stream.
+type StreamMessageHandler func(msg []byte)
+
+var ErrStreamGap = errors.New("stream reconnected, messages may have been missed")
+
+// StreamGapError is reported to the error handler once a dropped stream is live again.
+// Messages between From and To were not received.
+type StreamGapError struct {
+	From  time.Time
+	To    time.Time
+	Cause error
+}
+
+func (e *StreamGapError) Error() string {
+	return fmt.Sprintf("%v: gap %s (%v)", ErrStreamGap, e.To.Sub(e.From).Round(time.Millisecond), e.Cause)
+}
+
+func (e *StreamGapError) Unwrap() error {
+	return ErrStreamGap
+}
+
+// Stream is a public market data websocket that reconnects with backoff and replays its
+// subscriptions. Consumers keep their channels across reconnects and close them on Done.
+type Stream struct {
+	url        string
+	subscribe  StreamSubscribeFunc
+	handler    StreamMessageHandler
+	errHandler func(err error)
+
+	// OnReconnect is called after the subscriptions were replayed, e.g. to resync local state
+	OnReconnect func()
+	// PingMessage is sent as a text frame every PingInterval for venues with application level pings
+	PingMessage  []byte
+	PingInterval time.Duration
+	// ReadTimeout drops the connection when nothing was read for the given duration (0 disables)
+	ReadTimeout time.Duration
+	Backoff     *Backoff
+
+	wsMu sync.Mutex
+	ws   *websocket.Conn
+	done chan struct{}
+}
+
+func NewStream(url string, subscribe StreamSubscribeFunc, handler StreamMessageHandler, errHandler func(err error)) *Stream {
+	return &Stream{
+		url:        url,
+		subscribe:  subscribe,
+		handler:    handler,
+		errHandler: errHandler,
+		Backoff:    NewBackoff(500*time.Millisecond, 30*time.Second),
+		done:       make(chan struct{}),
+	}
+}
+
+// Start dials and subscribes once, an error is returned if that fails.
+// Afterwards the stream runs until ctx is done, reconnecting on any read error.
+func (s *Stream) Start(ctx context.Context) error {
+	if err := s.dial(); err != nil {
+		return err
+	}
+	go s.run(ctx)
+	return nil
+}
+
+// Done is closed once the stream stopped for good
+func (s *Stream) Done() <-chan struct{} {
+	return s.done
+}
+
+// WriteJSON sends a message on the current connection
+func (s *Stream) WriteJSON(v interface{}) error {
+	s.wsMu.Lock()
+	defer s.wsMu.Unlock()
+	if s.ws == nil {
+		return fmt.Errorf("stream is not connected")
+	}
+	return s.ws.WriteJSON(v)
+}
+
+func (s *Stream) writeMessage(messageType int, data []byte) error {
+	s.wsMu.Lock()
+	defer s.wsMu.Unlock()
+	if s.ws == nil {
+		return fmt.Errorf("stream is not connected")
+	}
+	return s.ws.WriteMessage(messageType, data)
+}
+
+func (s *Stream) dial() error {
+	ws, _, err := websocket.DefaultDialer.Dial(s.url, nil)
+	if err != nil {
+		return err
+	}
+	ws.SetPingHandler(func(pingData string) error {
+		s.wsMu.Lock()
+		defer s.wsMu.Unlock()
+		return ws.WriteControl(
+			websocket.PongMessage,
+			[]byte(pingData),
+			time.Now().Add(10*time.Second),
+		)
+	})
+	s.wsMu.Lock()
+	s.ws = ws
+	s.wsMu.Unlock()
+
+	if s.subscribe != nil {
+		if err := s.subscribe(s); err != nil {
+			ws.Close()
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Stream) run(ctx context.Context) {
+	defer close(s.done)
+	for {
+		err := s.readLoop(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		lost := time.Now()
+		s.report(fmt.Errorf("stream read error, reconnecting: %w", err))
+
+		if !s.reconnect(ctx) {
+			return
+		}
+		s.report(&StreamGapError{From: lost, To: time.Now(), Cause: err})
+		if s.OnReconnect != nil {
+			s.OnReconnect()
+		}
+	}
+}
+
+func (s *Stream) readLoop(ctx context.Context) error {
+	s.wsMu.Lock()
+	ws := s.ws
+	s.wsMu.Unlock()
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		var tick <-chan time.Time
+		if s.PingMessage != nil && s.PingInterval > 0 {
+			ticker := time.NewTicker(s.PingInterval)
+			defer ticker.Stop()
+			tick = ticker.C
+		}
+		for {
+			select {
+			case <-ctx.Done():
+				ws.Close()
+				return
+			case <-stop:
+				return
+			case <-tick:
+				s.writeMessage(websocket.TextMessage, s.PingMessage)
+			}
+		}
+	}()
+
+	for {
+		if s.ReadTimeout > 0 {
+			ws.SetReadDeadline(time.Now().Add(s.ReadTimeout))
+		}
+		_, msg, err := ws.ReadMessage()
+		if err != nil {
+			ws.Close()
+			return err
+		}
+		s.handler(msg)
+	}
+}
+
+func (s *Stream) reconnect(ctx context.Context) bool {
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(s.Backoff.Next()):
+		}
+		if err := s.dial(); err != nil {
+			s.report(fmt.Errorf("stream reconnect failed: %w", err))
+			continue
+		}
+		s.Backoff.Reset()
+		return true
+	}
+}
+
+func (s *Stream) report(err error) {
+	if s.errHandler != nil {
+		s.errHandler(err)
+	}
+}
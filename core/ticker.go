@@ -0,0 +1,38 @@
+package core
+
+import "github.com/shopspring/decimal"
+
+// TickerMap keys tickers by symbol, keeping those of symbols only unless it is empty
+func TickerMap(tickers []Ticker, symbols []string) map[string]Ticker {
+	want := make(map[string]bool, len(symbols))
+	for _, s := range symbols {
+		want[s] = true
+	}
+	out := make(map[string]Ticker, len(tickers))
+	for _, t := range tickers {
+		if len(symbols) == 0 || want[t.Symbol] {
+			out[t.Symbol] = t
+		}
+	}
+	return out
+}
+
+// ChangePercent returns the change of last against open in percent, zero without an open
+func ChangePercent(open, last decimal.Decimal) decimal.Decimal {
+	if open.IsZero() {
+		return decimal.Zero
+	}
+	return last.Sub(open).Div(open).Mul(decimal.NewFromInt(100))
+}
+
+// Quote returns the top of book of t
+func (t Ticker) Quote() Quote {
+	return Quote{
+		Symbol:   t.Symbol,
+		BidPrice: t.BidPrice,
+		BidQty:   t.BidQty,
+		AskPrice: t.AskPrice,
+		AskQty:   t.AskQty,
+		Time:     t.Time,
+	}
+}
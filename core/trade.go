@@ -0,0 +1,12 @@
+package core
+
+import "context"
+
+// SendTrade pushes a trade to ch. Trades are never dropped, a slow consumer blocks the
+// stream until the trade is received or ctx is done, so adapters buffer trade channels.
+func SendTrade(ctx context.Context, ch chan Trade, t Trade) {
+	select {
+	case ch <- t:
+	case <-ctx.Done():
+	}
+}
@@ -6,15 +6,45 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// OrderStatus is the lifecycle state of an order. OPEN, PARTIALLY_FILLED and
+// PENDING_CANCEL orders may still execute, the others are final. A cancelled or expired
+// order may have executed in part, see ExecutedQty.
 type OrderStatus string
 
 const (
-	OrderStatusOpen     OrderStatus = "OPEN"
-	OrderStatusFilled   OrderStatus = "FILLED"
-	OrderStatusCanceled OrderStatus = "CANCELLED"
-	OrderStatusError    OrderStatus = "ERROR"
+	OrderStatusOpen            OrderStatus = "OPEN"             // accepted, nothing executed
+	OrderStatusPartiallyFilled OrderStatus = "PARTIALLY_FILLED" // accepted, executed in part
+	OrderStatusPendingCancel   OrderStatus = "PENDING_CANCEL"   // cancel requested, not confirmed
+	OrderStatusFilled          OrderStatus = "FILLED"
+	OrderStatusCanceled        OrderStatus = "CANCELLED" // by the user or on their request
+	OrderStatusExpired         OrderStatus = "EXPIRED"   // by time in force, self-trade prevention or the exchange
+	OrderStatusRejected        OrderStatus = "REJECTED"  // not accepted by the matching engine
+	OrderStatusError           OrderStatus = "ERROR"     // unknown exchange state
 )
 
+// IsOpen reports whether an order in status s may still execute
+func (s OrderStatus) IsOpen() bool {
+	switch s {
+	case OrderStatusOpen, OrderStatusPartiallyFilled, OrderStatusPendingCancel:
+		return true
+	}
+	return false
+}
+
+// IsFinal reports whether status s is final
+func (s OrderStatus) IsFinal() bool {
+	return !s.IsOpen()
+}
+
+// OpenStatus returns OPEN or PARTIALLY_FILLED by the executed quantity, for exchanges that
+// report both as one state
+func OpenStatus(executedQty decimal.Decimal) OrderStatus {
+	if executedQty.IsPositive() {
+		return OrderStatusPartiallyFilled
+	}
+	return OrderStatusOpen
+}
+
 type RateLimitCategory string
 
 const (
@@ -38,9 +68,12 @@ const (
 	OrderSideSell OrderSide = "SELL"
 )
 
-// OrderEvent represents real-time order updates via websocket
+// OrderEvent represents real-time order updates via websocket. ExecutedQty and AvgPrice are
+// cumulative over the order, the Last fields describe the execution an event reports and
+// are zero on events without one.
 type OrderEvent struct {
 	OrderID         string          `json:"order_id"`
+	ClientOrderID   string          `json:"client_order_id,omitempty"`
 	Symbol          string          `json:"symbol"`
 	Side            OrderSide       `json:"side"`
 	OrderType       string          `json:"order_type"`
@@ -54,6 +87,34 @@ type OrderEvent struct {
 	UpdateTime      time.Time       `json:"update_time"`
 	TradeID         string          `json:"trade_id,omitempty"`
 	IsMaker         bool            `json:"is_maker,omitempty"`
+	Reason          string          `json:"reason,omitempty"` // see OrderResponse
+	LastQty         decimal.Decimal `json:"last_qty"`
+	LastPrice       decimal.Decimal `json:"last_price"`
+	LastCommission  decimal.Decimal `json:"last_commission"`         // in CommissionAsset
+	TimeInForce     TimeInForce     `json:"time_in_force,omitempty"` // empty when the stream does not carry it
+	CreateTime      time.Time       `json:"create_time,omitempty"`   // zero when the stream does not carry it
+}
+
+// IsFill reports whether the event reports an execution
+func (e OrderEvent) IsFill() bool {
+	return e.LastQty.IsPositive()
+}
+
+// Fill returns the execution of a fill event
+func (e OrderEvent) Fill() OrderFill {
+	return OrderFill{
+		TradeID:   e.TradeID,
+		OrderID:   e.OrderID,
+		Symbol:    e.Symbol,
+		Side:      e.Side,
+		Price:     e.LastPrice,
+		Quantity:  e.LastQty,
+		Fee:       e.LastCommission,
+		FeeAsset:  e.CommissionAsset,
+		IsMaker:   e.IsMaker,
+		TradeTime: e.UpdateTime,
+		IsBuyer:   e.Side == OrderSideBuy,
+	}
 }
 
 // BalanceEvent represents real-time balance updates via websocket
@@ -67,6 +128,7 @@ type BalanceEvent struct {
 
 type OrderResponse struct {
 	OrderID         string
+	ClientOrderID   string
 	Symbol          string
 	Side            OrderSide
 	Tif             TimeInForce
@@ -74,7 +136,9 @@ type OrderResponse struct {
 	Price           decimal.Decimal
 	Quantity        decimal.Decimal
 	IsQuoteQuantity bool
+	PostOnly        bool // a limit order rejected instead of taking liquidity, false when not known
 	CreateTime      time.Time
+	Reason          string // exchange reason or code of a reject, expiry or cancel, empty when it gives none
 }
 
 type OrderResponseFull struct {
@@ -126,7 +190,22 @@ type MarketRule struct {
 	MaxQty         decimal.Decimal
 	TickSize       decimal.Decimal // price tick size
 	StepSize       decimal.Decimal // quantity step size
+	MinNotional    decimal.Decimal // min order value in the quote asset
+	MaxNotional    decimal.Decimal // max order value in the quote asset
+	MarketMaxQty   decimal.Decimal // max quantity of a market order on top of MaxQty
+	BuyPriceBand   PriceBand       // limit price bounds of a buy around the reference price
+	SellPriceBand  PriceBand       // limit price bounds of a sell around the reference price
 	RateLimits     []RateLimit
+	// TickFunc returns the tick at a price for exchanges with a price dependent tick table,
+	// nil when TickSize holds at every price
+	TickFunc func(price decimal.Decimal) decimal.Decimal
+}
+
+// PriceBand bounds a limit price as multiples of a reference price of the exchange, e.g. the
+// average or mark price. A zero bound is not checked.
+type PriceBand struct {
+	Up   decimal.Decimal // max price / reference price
+	Down decimal.Decimal // min price / reference price
 }
 
 type Wallet struct {
@@ -136,20 +215,27 @@ type Wallet struct {
 	Total  decimal.Decimal
 }
 
+// Ticker is the top of book, last price and rolling 24h statistics of a symbol, fields an
+// exchange does not report are zero
 type Ticker struct {
-	Symbol    string
-	BidPrice  float64
-	BidQty    float64
-	AskPrice  float64
-	AskQty    float64
-	LastPrice float64
-	Volume    float64
-	Time      time.Time
+	Symbol        string
+	BidPrice      decimal.Decimal
+	BidQty        decimal.Decimal
+	AskPrice      decimal.Decimal
+	AskQty        decimal.Decimal
+	LastPrice     decimal.Decimal
+	OpenPrice     decimal.Decimal // 24h ago
+	HighPrice     decimal.Decimal
+	LowPrice      decimal.Decimal
+	Volume        decimal.Decimal // in the base asset
+	QuoteVolume   decimal.Decimal
+	ChangePercent decimal.Decimal // of LastPrice against OpenPrice, 2.5 for +2.5%
+	Time          time.Time
 }
 
 type Account struct {
-	CrossBalance   float64
-	CrossUrlProfit float64
+	CrossBalance   decimal.Decimal
+	CrossUrlProfit decimal.Decimal
 	Assets         map[string]*Wallet
 	Positions      map[string]*Position
 }
@@ -165,13 +251,13 @@ const (
 type Position struct {
 	Symbol         string
 	Side           PositionSide
-	Amount         float64
-	UrlProfit      float64
-	IsolatedMargin float64
-	Notional       float64
-	IsolatedWallet float64
-	InitialMargin  float64
-	MaintMargin    float64
+	Amount         decimal.Decimal
+	UrlProfit      decimal.Decimal
+	IsolatedMargin decimal.Decimal
+	Notional       decimal.Decimal
+	IsolatedWallet decimal.Decimal
+	InitialMargin  decimal.Decimal
+	MaintMargin    decimal.Decimal
 	UpdatedTime    time.Time
 }
 
@@ -187,14 +273,33 @@ type PositionState struct {
 	UpdatedTime      time.Time
 }
 
+// OrderFill is one execution of an own order. Fee is the amount charged in FeeAsset,
+// negative for a rebate.
 type OrderFill struct {
+	TradeID     string
+	OrderID     string
+	Symbol      string
+	Side        OrderSide
 	Price       decimal.Decimal
 	Quantity    decimal.Decimal
+	Fee         decimal.Decimal
+	FeeAsset    string
+	IsMaker     bool
 	TradeTime   time.Time
 	IsBuyer     bool
 	IsBestMatch bool
 }
 
+// Trade is a public market trade, Side is the side of the aggressor (taker)
+type Trade struct {
+	Symbol   string
+	TradeID  string
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+	Side     OrderSide
+	Time     time.Time // trade time reported by the exchange
+}
+
 type Chain string
 
 const (
@@ -3,9 +3,11 @@ package core
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -18,6 +20,33 @@ type WsExtractIDFunc func(root map[string]json.RawMessage) (id string, found boo
 type WsRequestIDFunc func(map[string]interface{}) (interface{}, bool)
 type WsExtractErrFunc func(root map[string]json.RawMessage) error
 type WsAfterConnectFunc func(*WsClient) error
+type WsRequestCostFunc func(req map[string]interface{}) []RateCost
+type WsRateUsageFunc func(root map[string]json.RawMessage, limiter *RateLimiter)
+
+// DefaultWsRequestTimeout is the request timeout of a new WsClient
+const DefaultWsRequestTimeout = 5 * time.Second
+
+// wsDrainTimeout bounds how long a rotated out session waits for its pending requests
+const wsDrainTimeout = 10 * time.Second
+
+// WsState is the connection state of a WsClient
+type WsState string
+
+const (
+	WsStateConnecting     WsState = "CONNECTING"
+	WsStateAuthenticating WsState = "AUTHENTICATING"
+	WsStateLive           WsState = "LIVE"
+	WsStateReconnecting   WsState = "RECONNECTING"
+	WsStateClosed         WsState = "CLOSED"
+)
+
+// WsStateEvent is emitted on every state transition, Err holds the cause if any
+type WsStateEvent struct {
+	From WsState
+	To   WsState
+	Err  error
+	Time time.Time
+}
 
 type wsResponse struct {
 	Root map[string]json.RawMessage
@@ -28,19 +57,63 @@ type wsConnWrapper struct {
 	*websocket.Conn
 }
 
+// wsSession is a single connection with its own pending requests, a client owns a new one
+// after every reconnect or lifetime rotation
+type wsSession struct {
+	conn    *wsConnWrapper
+	ctx     context.Context
+	cancel  context.CancelFunc
+	started time.Time
+	retired atomic.Bool // replaced by a newer session, its read errors no longer reconnect
+
+	requestsMu sync.Mutex
+	requests   map[string]chan wsResponse
+}
+
+func (s *wsSession) pending() int {
+	s.requestsMu.Lock()
+	defer s.requestsMu.Unlock()
+	return len(s.requests)
+}
+
+// failPending fails every request still waiting for an answer on this session
+func (s *wsSession) failPending(err error) {
+	s.requestsMu.Lock()
+	defer s.requestsMu.Unlock()
+	for id, ch := range s.requests {
+		select {
+		case ch <- wsResponse{Err: err}:
+		default:
+		}
+		delete(s.requests, id)
+	}
+}
+
 type WsClient struct {
 	url       string
 	headers   http.Header // custom headers for websocket connection
-	wsConn    *wsConnWrapper
+	session   *wsSession
+	events    atomic.Pointer[wsSession] // the session whose user data is delivered
 	wsMu      sync.Mutex
 	connected bool
-	Ctx       context.Context
-	cancel    context.CancelFunc
-	started   time.Time
+	Ctx       context.Context // session context, cancelled when the current connection is dropped
+	parentCtx context.Context
 	lifeTime  time.Duration
 
-	requestsMu   sync.Mutex
-	requests     map[string]chan wsResponse
+	requestTimeout time.Duration // applied to requests without a deadline
+
+	stateMu      sync.Mutex
+	state        WsState
+	stateChanged chan struct{} // closed and replaced on every transition
+	stateSubs    []chan WsStateEvent
+	reconnecting bool
+	backoff      *Backoff
+	errHandler   func(err error) // connection failures, see SetErrHandler
+
+	limiter   *RateLimiter
+	rateCost  WsRequestCostFunc // weights of a request, one REQUEST if nil
+	rateUsage WsRateUsageFunc   // feeds exchange reported usage from responses into the limiter
+
 	getRequestID WsRequestIDFunc // returns id value (any type) and true if set
 
 	authFn          WsAuthFunc         // exchange-specific auth
@@ -67,6 +140,10 @@ func NewWsClient(url string, lifeTime time.Duration, authFn WsAuthFunc,
 		extractID:       extractID,
 		extractErr:      extractErr,
 		afterConnect:    afterConnect,
+		state:           WsStateClosed,
+		stateChanged:    make(chan struct{}),
+		backoff:         NewBackoff(time.Second, 30*time.Second),
+		requestTimeout:  DefaultWsRequestTimeout,
 	}
 }
 
@@ -87,72 +164,370 @@ func NewWsClientWithHeaders(url string, lifeTime time.Duration, headers http.Hea
 		extractID:       extractID,
 		extractErr:      extractErr,
 		afterConnect:    afterConnect,
+		state:           WsStateClosed,
+		stateChanged:    make(chan struct{}),
+		backoff:         NewBackoff(time.Second, 30*time.Second),
+		requestTimeout:  DefaultWsRequestTimeout,
 	}
 }
 
 func (c *WsClient) Connect(ctx context.Context) (int64, error) {
-	c.connected = true
-	c.Ctx, c.cancel = context.WithCancel(ctx)
+	c.parentCtx = ctx
+	c.setState(WsStateConnecting, nil)
+	delta, err := c.openSession(true)
+	if err != nil {
+		c.setState(WsStateClosed, err)
+		return 0, err
+	}
+	return delta, nil
+}
 
+// Context returns the context passed to Connect, it outlives reconnects and rotations
+func (c *WsClient) Context() context.Context {
+	return c.parentCtx
+}
+
+// openSession dials, authenticates and switches in a new connection. The previous session is
+// left open so that a rotation can drain it, announce reports the transitions as state events.
+func (c *WsClient) openSession(announce bool) (int64, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(c.parentCtx, RateCost{Category: RateLimitConnection, Weight: 1}); err != nil {
+			return 0, err
+		}
+	}
 	ws, _, err := websocket.DefaultDialer.Dial(c.url, c.headers)
 	if err != nil {
 		return 0, err
 	}
-	c.wsMu = sync.Mutex{}
-	c.wsMu.Lock()
-	c.wsConn = &wsConnWrapper{ws}
-	c.started = time.Now()
-	c.wsMu.Unlock()
+	ctx, cancel := context.WithCancel(c.parentCtx)
+	s := &wsSession{
+		conn:     &wsConnWrapper{ws},
+		ctx:      ctx,
+		cancel:   cancel,
+		started:  time.Now(),
+		requests: make(map[string]chan wsResponse),
+	}
 
 	var delta int64
 	if c.authFn != nil {
+		if announce {
+			c.setStateOpen(WsStateAuthenticating, nil)
+		}
 		delta, err = c.authFn(ws)
 		if err != nil {
+			c.closeSession(s, err)
 			return 0, err
 		}
 	}
+	c.pingPongHandler(ws)
 
-	c.requestsMu = sync.Mutex{}
-	c.requestsMu.Lock()
-	c.requests = make(map[string]chan wsResponse)
-	c.requestsMu.Unlock()
+	c.wsMu.Lock()
+	prev := c.session
+	c.session = s
+	c.Ctx = ctx
+	c.connected = true
+	c.wsMu.Unlock()
+	// on a rotation the old session delivers the user data until the new one has subscribed,
+	// then the new one takes over, so that only one of them delivers at a time
+	rotating := prev != nil && prev.ctx.Err() == nil
+	if !rotating {
+		c.events.Store(s)
+	}
 
-	go c.wsMainHandler()
-	go c.pingPongHandler()
-	//go c.sessionLifetimeWatcher()
+	go c.wsMainHandler(s)
 
 	if c.afterConnect != nil {
 		if err := c.afterConnect(c); err != nil {
+			c.wsMu.Lock()
+			if prev != nil && prev.ctx.Err() == nil {
+				c.session, c.Ctx = prev, prev.ctx // keep serving on the old connection
+			} else {
+				c.session, c.connected = nil, false
+			}
+			c.wsMu.Unlock()
+			c.closeSession(s, err)
 			return 0, err
 		}
 	}
+	c.events.Store(s)
+	if prev != nil {
+		prev.retired.Store(true)
+	}
 
+	// a Close while connecting is not overwritten
+	open := c.State() != WsStateClosed
+	if announce {
+		open = c.setStateOpen(WsStateLive, nil)
+	}
+	if !open {
+		c.closeSession(s, ErrWsClosed)
+		return 0, ErrWsClosed
+	}
+	if c.lifeTime > 0 {
+		go c.sessionLifetimeWatcher(s)
+	}
 	return delta, nil
 }
 
-func (c *WsClient) Reconnect() {
-	c.Close()
-	time.Sleep(3 * time.Second)
-	c.Connect(c.Ctx)
+// closeSession drops the connection of s and fails its pending requests with ErrWsSessionClosed
+func (c *WsClient) closeSession(s *wsSession, cause error) {
+	s.cancel()
+	c.wsMu.Lock()
+	if c.session == s {
+		c.session = nil
+		c.connected = false
+	}
+	s.conn.Close()
+	c.wsMu.Unlock()
+	s.failPending(fmt.Errorf("%w: %v", ErrWsSessionClosed, cause))
 }
 
-func (c *WsClient) Close() error {
+// closeCurrent drops the current connection, if any
+func (c *WsClient) closeCurrent(cause error) {
 	c.wsMu.Lock()
-	defer c.wsMu.Unlock()
-	if c.wsConn != nil {
-		c.wsConn.Close()
-		c.wsConn = nil
+	s := c.session
+	c.wsMu.Unlock()
+	if s != nil {
+		c.closeSession(s, cause)
+	}
+}
+
+// Reconnect drops the current connection and dials again with exponential backoff until
+// the client is live, closed or the context passed to Connect is done
+func (c *WsClient) Reconnect() {
+	c.stateMu.Lock()
+	if c.reconnecting || c.state == WsStateClosed {
+		c.stateMu.Unlock()
+		return
 	}
-	if c.cancel != nil {
-		c.cancel()
+	c.reconnecting = true
+	c.stateMu.Unlock()
+	defer func() {
+		c.stateMu.Lock()
+		c.reconnecting = false
+		c.stateMu.Unlock()
+	}()
+
+	c.closeCurrent(errors.New("reconnecting"))
+	if !c.setStateOpen(WsStateReconnecting, nil) {
+		return
 	}
+	for {
+		select {
+		case <-c.parentCtx.Done():
+			c.setState(WsStateClosed, c.parentCtx.Err())
+			return
+		case <-time.After(c.backoff.Next()):
+		}
+		if !c.setStateOpen(WsStateConnecting, nil) {
+			return
+		}
+		if _, err := c.openSession(true); err != nil {
+			if errors.Is(err, ErrWsClosed) || !c.setStateOpen(WsStateReconnecting, err) {
+				return
+			}
+			c.ReportError(fmt.Errorf("ws reconnect failed: %w", err))
+			continue
+		}
+		c.backoff.Reset()
+		return
+	}
+}
+
+// rotate opens a new connection before the old one reaches its lifetime (make-before-break).
+// Requests already sent on the old connection keep their answers until it is drained.
+func (c *WsClient) rotate(old *wsSession) error {
+	c.stateMu.Lock()
+	if c.reconnecting || c.state != WsStateLive {
+		c.stateMu.Unlock()
+		return nil
+	}
+	c.reconnecting = true
+	c.stateMu.Unlock()
+	defer func() {
+		c.stateMu.Lock()
+		c.reconnecting = false
+		c.stateMu.Unlock()
+	}()
+
+	if _, err := c.openSession(false); err != nil {
+		return err
+	}
+	go c.drainSession(old)
 	return nil
 }
 
-func (c *WsClient) SendRequest(req map[string]interface{}) (map[string]json.RawMessage, error) {
-	if !c.connected {
-		return nil, fmt.Errorf("WebSocket is not connected.")
+// drainSession closes a rotated out session once its pending requests are answered
+func (c *WsClient) drainSession(s *wsSession) {
+	deadline := time.NewTimer(wsDrainTimeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for s.pending() > 0 {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-deadline.C:
+			c.closeSession(s, errors.New("session rotated"))
+			return
+		case <-ticker.C:
+		}
+	}
+	c.closeSession(s, errors.New("session rotated"))
+}
+
+func (c *WsClient) Close() error {
+	c.setState(WsStateClosed, nil)
+	c.closeCurrent(ErrWsClosed)
+	return nil
+}
+
+// SetErrHandler sets the handler of the failures the client recovers from itself: read
+// errors, failed reconnects and rotations. They are dropped while it is nil.
+func (c *WsClient) SetErrHandler(errHandler func(err error)) {
+	c.stateMu.Lock()
+	c.errHandler = errHandler
+	c.stateMu.Unlock()
+}
+
+// ReportError passes err to the handler set with SetErrHandler, for failures of the
+// exchange specific callbacks that have no caller to return them to
+func (c *WsClient) ReportError(err error) {
+	c.stateMu.Lock()
+	errHandler := c.errHandler
+	c.stateMu.Unlock()
+	if errHandler != nil {
+		errHandler(err)
+	}
+}
+
+// SetReconnectBackoff sets the bounds of the exponential reconnect backoff
+func (c *WsClient) SetReconnectBackoff(min, max time.Duration) {
+	c.backoff = NewBackoff(min, max)
+}
+
+// State returns the current connection state
+func (c *WsClient) State() WsState {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	return c.state
+}
+
+// SubscribeState returns a channel of state transitions, closed when ctx is done.
+// Events are dropped if the receiver falls behind.
+func (c *WsClient) SubscribeState(ctx context.Context) <-chan WsStateEvent {
+	ch := make(chan WsStateEvent, 16)
+	c.stateMu.Lock()
+	c.stateSubs = append(c.stateSubs, ch)
+	c.stateMu.Unlock()
+	go func() {
+		<-ctx.Done()
+		c.stateMu.Lock()
+		defer c.stateMu.Unlock()
+		for i, sub := range c.stateSubs {
+			if sub == ch {
+				c.stateSubs = append(c.stateSubs[:i], c.stateSubs[i+1:]...)
+				break
+			}
+		}
+		close(ch)
+	}()
+	return ch
+}
+
+// WatchGaps reports a StreamGapError to errHandler each time the client is live again after
+// a reconnect, events pushed in between are lost. Rotations overlap and leave no gap.
+func (c *WsClient) WatchGaps(ctx context.Context, errHandler func(err error)) {
+	if errHandler == nil {
+		return
+	}
+	states := c.SubscribeState(ctx)
+	go func() {
+		var lost time.Time
+		var cause error
+		for ev := range states {
+			switch ev.To {
+			case WsStateReconnecting:
+				if lost.IsZero() {
+					lost, cause = ev.Time, errors.New("websocket reconnected")
+				}
+				if ev.Err != nil {
+					cause = ev.Err
+				}
+			case WsStateLive:
+				if !lost.IsZero() {
+					errHandler(&StreamGapError{From: lost, To: ev.Time, Cause: cause})
+					lost, cause = time.Time{}, nil
+				}
+			}
+		}
+	}()
+}
+
+// Wait blocks until the client is live. It fails when ctx is done or the client is closed.
+func (c *WsClient) Wait(ctx context.Context) error {
+	for {
+		c.stateMu.Lock()
+		state, changed := c.state, c.stateChanged
+		c.stateMu.Unlock()
+		switch state {
+		case WsStateLive:
+			return nil
+		case WsStateClosed:
+			return ErrWsClosed
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+func (c *WsClient) setState(to WsState, err error) {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	c.transition(to, err)
+}
+
+// setStateOpen is setState unless the client was closed meanwhile, it reports whether the
+// client is still open
+func (c *WsClient) setStateOpen(to WsState, err error) bool {
+	c.stateMu.Lock()
+	defer c.stateMu.Unlock()
+	if c.state == WsStateClosed {
+		return false
+	}
+	c.transition(to, err)
+	return true
+}
+
+// transition moves to the state to, the caller holds stateMu
+func (c *WsClient) transition(to WsState, err error) {
+	if c.state == to && err == nil {
+		return
 	}
+	ev := WsStateEvent{From: c.state, To: to, Err: err, Time: time.Now()}
+	c.state = to
+	close(c.stateChanged)
+	c.stateChanged = make(chan struct{})
+	for _, sub := range c.stateSubs {
+		select {
+		case sub <- ev:
+		default:
+		}
+	}
+}
+
+// SendRequest sends req and waits for its answer within the default request timeout
+func (c *WsClient) SendRequest(req map[string]interface{}) (map[string]json.RawMessage, error) {
+	return c.SendRequestCtx(context.Background(), req)
+}
+
+// SendRequestCtx sends req and waits for its answer until ctx is done. The default request
+// timeout applies when ctx has no deadline. Failures before req is written, e.g. ctx done
+// while waiting on the rate limiter, wrap ErrRequestNotSent.
+func (c *WsClient) SendRequestCtx(ctx context.Context, req map[string]interface{}) (map[string]json.RawMessage, error) {
 	var id interface{}
 	var ok bool
 
@@ -160,17 +535,49 @@ func (c *WsClient) SendRequest(req map[string]interface{}) (map[string]json.RawM
 		id, ok = c.getRequestID(req)
 	}
 	if !ok {
-		return nil, fmt.Errorf("WsClient: failed to set request ID (missing or IDGenFunc not set)")
+		return nil, fmt.Errorf("%w: WsClient: failed to set request ID (missing or IDGenFunc not set)", ErrRequestNotSent)
+	}
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline && c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+	if c.limiter != nil {
+		costs := []RateCost{{Category: RateLimitRequest, Weight: 1}}
+		if c.rateCost != nil {
+			costs = c.rateCost(req)
+		}
+		if err := c.limiter.Wait(ctx, costs...); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrRequestNotSent, err)
+		}
 	}
 
 	idStr := fmt.Sprint(id) // used for map key (channel lookup)
 	respCh := make(chan wsResponse, 1)
-	c.requestsMu.Lock()
-	c.requests[idStr] = respCh
-	c.requestsMu.Unlock()
 
 	c.wsMu.Lock()
-	err := c.wsConn.WriteJSON(req)
+	s := c.session
+	if s == nil {
+		c.wsMu.Unlock()
+		return nil, ErrWsNotConnected
+	}
+	// the wait for the lock may have outlasted ctx
+	if err := ctx.Err(); err != nil {
+		c.wsMu.Unlock()
+		return nil, fmt.Errorf("%w: %w", ErrRequestNotSent, err)
+	}
+	s.requestsMu.Lock()
+	s.requests[idStr] = respCh
+	s.requestsMu.Unlock()
+	defer func() {
+		s.requestsMu.Lock()
+		delete(s.requests, idStr)
+		s.requestsMu.Unlock()
+	}()
+	deadline, _ := ctx.Deadline()
+	s.conn.SetWriteDeadline(deadline)
+	err := s.conn.WriteJSON(req)
+	s.conn.SetWriteDeadline(time.Time{})
 	c.wsMu.Unlock()
 	if err != nil {
 		return nil, err
@@ -181,50 +588,76 @@ func (c *WsClient) SendRequest(req map[string]interface{}) (map[string]json.RawM
 			return nil, resp.Err
 		}
 		return resp.Root, nil
-	case <-time.After(5 * time.Second):
-		return nil, fmt.Errorf("timeout waiting for WS response")
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timeout waiting for WS response: %w", ctx.Err())
 	}
 }
 
+// SetRateLimiter makes every request and reconnect wait on limiter. cost returns the weights
+// of a request and usage syncs the limiter with responses, both may be nil.
+func (c *WsClient) SetRateLimiter(limiter *RateLimiter, cost WsRequestCostFunc, usage WsRateUsageFunc) {
+	c.limiter = limiter
+	c.rateCost = cost
+	c.rateUsage = usage
+}
+
+// RateLimiter returns the limiter set with SetRateLimiter, nil if none
+func (c *WsClient) RateLimiter() *RateLimiter {
+	return c.limiter
+}
+
+// SetRequestTimeout sets the default timeout of requests sent without a deadline
+func (c *WsClient) SetRequestTimeout(timeout time.Duration) {
+	c.requestTimeout = timeout
+}
+
 // SendMessage sends a message without expecting a response (for subscriptions)
 func (c *WsClient) SendMessage(msg interface{}) error {
-	if !c.connected {
-		return fmt.Errorf("WebSocket is not connected.")
-	}
-
 	c.wsMu.Lock()
 	defer c.wsMu.Unlock()
-
-	return c.wsConn.WriteJSON(msg)
+	if c.session == nil {
+		return ErrWsNotConnected
+	}
+	return c.session.conn.WriteJSON(msg)
 }
 
-func (c *WsClient) wsMainHandler() {
+func (c *WsClient) wsMainHandler(s *wsSession) {
 	for {
 		select {
-		case <-c.Ctx.Done():
+		case <-s.ctx.Done():
 			return
 		default:
 		}
-		_, msg, err := c.wsConn.ReadMessage()
+		_, msg, err := s.conn.ReadMessage()
 		if err != nil {
-			fmt.Printf("[wsclient] WS read error: %v\n", err)
-			c.Reconnect()
+			if s.ctx.Err() != nil {
+				return // session closed on purpose
+			}
+			c.ReportError(fmt.Errorf("ws read error: %w", err))
+			retired := s.retired.Load()
+			c.closeSession(s, err)
+			if !retired {
+				c.Reconnect()
+			}
 			return
 		}
 		var root map[string]json.RawMessage
 		if err := json.Unmarshal(msg, &root); err != nil {
-			fmt.Printf("[wsclient] WS json unmarshal error: %v\n", err)
+			c.ReportError(fmt.Errorf("ws json unmarshal error: %w", err))
 			continue
 		}
 
 		if c.extractID != nil {
 			if id, found := c.extractID(root); found && id != "" {
-				c.requestsMu.Lock()
-				ch, ok := c.requests[id]
+				if c.limiter != nil && c.rateUsage != nil {
+					c.rateUsage(root, c.limiter)
+				}
+				s.requestsMu.Lock()
+				ch, ok := s.requests[id]
 				if ok {
-					delete(c.requests, id)
+					delete(s.requests, id)
 				}
-				c.requestsMu.Unlock()
+				s.requestsMu.Unlock()
 				if ok && ch != nil {
 					err := c.extractErr(root)
 					if err != nil {
@@ -241,6 +674,10 @@ func (c *WsClient) wsMainHandler() {
 				continue
 			}
 		}
+		// Only one session delivers user data, see openSession
+		if c.events.Load() != s {
+			continue
+		}
 		// Let the user handler deal with user-data/events
 		if c.userDataHandler != nil {
 			c.userDataHandler(msg)
@@ -248,26 +685,40 @@ func (c *WsClient) wsMainHandler() {
 	}
 }
 
-func (c *WsClient) pingPongHandler() {
-	c.wsMu.Lock()
-	c.wsConn.Conn.SetPingHandler(func(pingData string) error {
-		return c.wsConn.Conn.WriteControl(
+func (c *WsClient) pingPongHandler(ws *websocket.Conn) {
+	ws.SetPingHandler(func(pingData string) error {
+		return ws.WriteControl(
 			websocket.PongMessage,
 			[]byte(pingData),
 			time.Now().Add(10*time.Second),
 		)
 	})
-	c.wsMu.Unlock()
 }
 
-func (c *WsClient) sessionLifetimeWatcher() {
+// sessionLifetimeWatcher rotates the session before the exchange drops it, a failed
+// rotation is retried with backoff while the old connection is still usable
+func (c *WsClient) sessionLifetimeWatcher(s *wsSession) {
 	timer := time.NewTimer(c.lifeTime)
 	defer timer.Stop()
-	select {
-	case <-c.Ctx.Done():
+	backoff := NewBackoff(time.Second, time.Minute)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if err := c.rotate(s); err != nil {
+			if errors.Is(err, ErrWsClosed) {
+				return
+			}
+			c.ReportError(fmt.Errorf("ws session rotation failed: %w", err))
+			if s.ctx.Err() != nil {
+				c.Reconnect() // the old connection died meanwhile
+				return
+			}
+			timer.Reset(backoff.Next())
+			continue
+		}
 		return
-	case <-timer.C:
-		fmt.Println("[wsclient] WS session lifetime reached, reconnecting...")
-		c.Reconnect()
 	}
 }